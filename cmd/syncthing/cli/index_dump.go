@@ -152,6 +152,16 @@ func indexDump(*cli.Context) error {
 			od.Unmarshal(it.Value())
 			fmt.Printf("[pendingDevice] D:%v V:%v\n", device, od)
 
+		case db.KeyTypeDirHash:
+			folder := binary.BigEndian.Uint32(key[1:])
+			name := nulString(key[1+4:])
+			fmt.Printf("[dirhash] F:%d N:%q V:%x\n", folder, name, it.Value())
+
+		case db.KeyTypeDirMtime:
+			folder := binary.BigEndian.Uint32(key[1:])
+			name := nulString(key[1+4:])
+			fmt.Printf("[dirmtime] F:%d N:%q V:%x\n", folder, name, it.Value())
+
 		default:
 			fmt.Printf("[??? %d]\n  %x\n  %x\n", key[0], key, it.Value())
 		}
@@ -82,6 +82,12 @@ func indexDumpSize(*cli.Context) error {
 		case db.KeyTypeVirtualMtime:
 			ele.key = fmt.Sprintf("MTIME:%s", key[1:])
 
+		case db.KeyTypeDirHash:
+			ele.key = fmt.Sprintf("DIRHASH:%s", key[1:])
+
+		case db.KeyTypeDirMtime:
+			ele.key = fmt.Sprintf("DIRMTIME:%s", key[1:])
+
 		case db.KeyTypeFolderIdx:
 			id := binary.BigEndian.Uint32(key[1:])
 			ele.key = fmt.Sprintf("FOLDERIDX:%d", id)
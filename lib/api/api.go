@@ -60,8 +60,8 @@ import (
 )
 
 const (
-	DefaultEventMask      = events.AllEvents &^ events.LocalChangeDetected &^ events.RemoteChangeDetected
-	DiskEventMask         = events.LocalChangeDetected | events.RemoteChangeDetected
+	DefaultEventMask      = events.AllEvents &^ events.LocalChangeDetected &^ events.RemoteChangeDetected &^ events.RemoteChangeSummary &^ events.LocalRenameDetected
+	DiskEventMask         = events.LocalChangeDetected | events.RemoteChangeDetected | events.RemoteChangeSummary | events.LocalRenameDetected
 	EventSubBufferSize    = 1000
 	defaultEventTimeout   = time.Minute
 	httpsCertLifetimeDays = 820
@@ -240,46 +240,74 @@ func (s *service) Serve(ctx context.Context) error {
 	restMux := httprouter.New()
 
 	// The GET handlers
-	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/devices", s.getPendingDevices) // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/folders", s.getPendingFolders) // [device]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/completion", s.getDBCompletion)             // [device] [folder]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/file", s.getDBFile)                         // folder file
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores", s.getDBIgnores)                   // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/need", s.getDBNeed)                         // folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/remoteneed", s.getDBRemoteNeed)             // device folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/localchanged", s.getDBLocalChanged)         // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/status", s.getDBStatus)                     // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/browse", s.getDBBrowse)                     // folder [prefix] [dirsonly] [levels]
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)         // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)             // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)         // folder (deprecated)
-	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                     // [since] [limit] [timeout] [events]
-	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                 // [since] [limit] [timeout]
-	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/deviceid", s.getDeviceID)                  // id
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/lang", s.getLang)                          // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/report", s.getReport)                      // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/random/string", s.getRandomString)         // [length]
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/browse", s.getSystemBrowse)             // current
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/connections", s.getSystemConnections)   // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/discovery", s.getSystemDiscovery)       // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/error", s.getSystemError)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/ping", s.restPing)                      // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/status", s.getSystemStatus)             // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/upgrade", s.getSystemUpgrade)           // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/version", s.getSystemVersion)           // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/debug", s.getSystemDebug)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/log", s.getSystemLog)                   // [since]
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/log.txt", s.getSystemLogTxt)            // [since]
+	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/devices", s.getPendingDevices)   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/folders", s.getPendingFolders)   // [device]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/completion", s.getDBCompletion)               // [device] [folder]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/file", s.getDBFile)                           // folder file
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores", s.getDBIgnores)                     // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores/test", s.getDBIgnoresTest)            // folder path
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/need", s.getDBNeed)                           // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/remoteneed", s.getDBRemoteNeed)               // device folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/localchanged", s.getDBLocalChanged)           // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/status", s.getDBStatus)                       // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/sizes", s.getDBSizes)                         // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/browse", s.getDBBrowse)                       // folder [prefix] [dirsonly] [levels]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/compare", s.getDBCompare)                     // folder other [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/changes", s.getDBChanges)                     // folder [since] [limit]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/modifiedby", s.getDBModifiedBy)               // folder device [prefix]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/rehash", s.getDBRehash)                       // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/localindex", s.getDBLocalIndex)               // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/scan/dryrun", s.getDBScanDryRun)              // folder [sub...]
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)           // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)               // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)           // folder (deprecated)
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/skippedsymlinks", s.getSkippedSymlinks)   // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/chronicconflicts", s.getChronicConflicts) // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullplan", s.getFolderPullPlan)           // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/tempfiles", s.getFolderTempFiles)         // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/indexstatus", s.getFolderIndexStatus)     // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/concurrency", s.getPullConcurrency)       // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/health", s.getFolderHealth)               // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/retries", s.getFolderRetries)             // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/audit", s.getFolderAudit)                 // folder [since] [until] [prefix] [device] [limit]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                       // [since] [limit] [timeout] [events]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                   // [since] [limit] [timeout]
+	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)                 // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)                 // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/deviceid", s.getDeviceID)                    // id
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/lang", s.getLang)                            // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/report", s.getReport)                        // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/random/string", s.getRandomString)           // [length]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/browse", s.getSystemBrowse)               // current
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/connections", s.getSystemConnections)     // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/discovery", s.getSystemDiscovery)         // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/error", s.getSystemError)                 // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/ping", s.restPing)                        // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/status", s.getSystemStatus)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/upgrade", s.getSystemUpgrade)             // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/version", s.getSystemVersion)             // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/debug", s.getSystemDebug)                 // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/log", s.getSystemLog)                     // [since]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/log.txt", s.getSystemLogTxt)              // [since]
 
 	// The POST handlers
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/prio", s.postDBPrio)                          // folder file [perpage] [page]
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores", s.postDBIgnores)                    // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignoreremove", s.postDBIgnoreRemove)          // folder <body>
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/override", s.postDBOverride)                  // folder
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                      // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                          // folder [sub...] [delay]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/repairmtimes", s.postDBRepairMtimes)          // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/acknowledgeempty", s.postDBAckEmpty)          // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/pause", s.postDBPause)                        // folder pull
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                          // folder [sub...] [delay] [force] [hashers]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/rehash", s.postDBRehash)                      // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/rehash/cancel", s.postDBRehashCancel)         // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/localindex", s.postDBLocalIndex)              // folder [spotcheck] <body>
 	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)   // folder <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/retries", s.postFolderRetries)            // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/errors/retry", s.postFolderErrorRetry)    // folder file
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/errors/clear", s.postFolderErrorClear)    // folder file
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/watch/restart", s.postFolderWatchRestart) // folder
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                // <body>
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)     // -
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                        // -
@@ -766,6 +794,16 @@ func (s *service) getDBStatus(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *service) getDBSizes(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	dist, err := s.model.SizeDistribution(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, dist)
+}
+
 func (s *service) postDBOverride(w http.ResponseWriter, r *http.Request) {
 	var qs = r.URL.Query()
 	var folder = qs.Get("folder")
@@ -778,6 +816,42 @@ func (s *service) postDBRevert(w http.ResponseWriter, r *http.Request) {
 	go s.model.Revert(folder)
 }
 
+func (s *service) postDBRepairMtimes(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	removed, err := s.model.RepairMtimes(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sendJSON(w, map[string]int{
+		"removed": removed,
+	})
+}
+
+func (s *service) postDBAckEmpty(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	if err := s.model.AcknowledgeEmptyPath(folder); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *service) postDBPause(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	paused, err := strconv.ParseBool(qs.Get("pull"))
+	if err != nil {
+		http.Error(w, "invalid pull parameter", http.StatusBadRequest)
+		return
+	}
+	if err := s.model.SetPullPaused(folder, paused); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+}
+
 func getPagingParams(qs url.Values) (int, int) {
 	page, err := strconv.Atoi(qs.Get("page"))
 	if err != nil || page < 1 {
@@ -839,6 +913,106 @@ func (s *service) getDBRemoteNeed(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (s *service) getDBCompare(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	folder := qs.Get("folder")
+	other := qs.Get("other")
+
+	page, perpage := getPagingParams(qs)
+
+	diffs, err := s.model.CompareFolders(folder, other, page, perpage)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	sendJSON(w, map[string]interface{}{
+		"differences": diffs,
+		"page":        page,
+		"perpage":     perpage,
+	})
+}
+
+func (s *service) getDBChanges(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	folder := qs.Get("folder")
+	since, err := strconv.ParseInt(qs.Get("since"), 10, 64)
+	if err != nil || since < 0 {
+		since = 0
+	}
+	limit, err := strconv.Atoi(qs.Get("limit"))
+	if err != nil || limit < 1 {
+		limit = 1 << 16
+	}
+
+	changes, err := s.model.LocalChangesSince(folder, since, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	files := make([]jsonFileInfo, len(changes.Files))
+	for i, f := range changes.Files {
+		files[i] = jsonFileInfo(f)
+	}
+	sendJSON(w, map[string]interface{}{
+		"indexID":  changes.IndexID,
+		"sequence": changes.Sequence,
+		"reset":    changes.Reset,
+		"files":    files,
+	})
+}
+
+func (s *service) getDBModifiedBy(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	folder := qs.Get("folder")
+	prefix := qs.Get("prefix")
+	// Accept both full device IDs and the short IDs used in modifiedBy.
+	by := qs.Get("device")
+	if id, err := protocol.DeviceIDFromString(by); err == nil {
+		by = id.Short().String()
+	}
+
+	// Results are streamed as a JSON array, as there may be a lot of them.
+	started := false
+	enc := json.NewEncoder(w)
+	var encErr error
+	n := 0
+	err := s.model.FilesModifiedBy(folder, by, prefix, func(f db.FileInfoTruncated) bool {
+		if !started {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.Write([]byte("["))
+			started = true
+		} else {
+			w.Write([]byte(","))
+		}
+		if encErr = enc.Encode(jsonFileInfoTrunc(f)); encErr != nil {
+			return false
+		}
+		n++
+		if n%1000 == 0 {
+			w.(http.Flusher).Flush()
+		}
+		return true
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if encErr != nil {
+		l.Debugln("streaming files modified by", by, encErr)
+		return
+	}
+	if !started {
+		sendJSON(w, []jsonFileInfoTrunc{})
+		return
+	}
+	w.Write([]byte("]\n"))
+}
+
 func (s *service) getDBLocalChanged(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 
@@ -859,6 +1033,28 @@ func (s *service) getDBLocalChanged(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (s *service) getDBScanDryRun(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	files, err := s.model.ScanDryRun(folder, qs["sub"])
+	if err != nil {
+		status := http.StatusInternalServerError
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	jsonFiles := make([]jsonFileInfo, len(files))
+	for i, f := range files {
+		jsonFiles[i] = jsonFileInfo(f)
+	}
+	sendJSON(w, map[string]interface{}{
+		"folder": folder,
+		"files":  jsonFiles,
+	})
+}
+
 func (s *service) getSystemConnections(w http.ResponseWriter, r *http.Request) {
 	sendJSON(w, s.model.ConnectionStats())
 }
@@ -1266,6 +1462,25 @@ func (s *service) getDBIgnores(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (s *service) getDBIgnoresTest(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	ignored, pattern, err := s.model.IsPathIgnored(qs.Get("folder"), qs.Get("path"))
+	if err != nil {
+		errStatus := http.StatusBadRequest
+		if isFolderNotFound(err) {
+			errStatus = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), errStatus)
+		return
+	}
+
+	sendJSON(w, map[string]interface{}{
+		"ignored": ignored,
+		"pattern": pattern,
+	})
+}
+
 func (s *service) postDBIgnores(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 
@@ -1292,6 +1507,24 @@ func (s *service) postDBIgnores(w http.ResponseWriter, r *http.Request) {
 	s.getDBIgnores(w, r)
 }
 
+func (s *service) postDBIgnoreRemove(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	var data map[string][]string
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := s.model.IgnoreAndRemoveLocally(qs.Get("folder"), data["paths"])
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+
+	sendJSON(w, res)
+}
+
 func (s *service) getIndexEvents(w http.ResponseWriter, r *http.Request) {
 	mask := s.getEventMask(r.URL.Query().Get("events"))
 	sub := s.getEventSub(mask)
@@ -1477,7 +1710,23 @@ func (s *service) postDBScan(w http.ResponseWriter, r *http.Request) {
 	folder := qs.Get("folder")
 	if folder != "" {
 		subs := qs["sub"]
-		err := s.model.ScanFolderSubdirs(folder, subs)
+		var err error
+		if hashersStr := qs.Get("hashers"); hashersStr != "" {
+			hashers, convErr := strconv.Atoi(hashersStr)
+			if convErr != nil {
+				http.Error(w, convErr.Error(), http.StatusBadRequest)
+				return
+			}
+			err = s.model.ScanFolderSubdirsWithHashers(folder, subs, hashers)
+		} else if force, _ := strconv.ParseBool(qs.Get("force")); force {
+			err = s.model.ForceScanFolderSubdirs(folder, subs)
+		} else {
+			err = s.model.ScanFolderSubdirs(folder, subs)
+		}
+		if errors.Is(err, model.ErrHashersOutOfRange) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		if err != nil {
 			http.Error(w, err.Error(), 500)
 			return
@@ -1485,7 +1734,11 @@ func (s *service) postDBScan(w http.ResponseWriter, r *http.Request) {
 		nextStr := qs.Get("next")
 		next, err := strconv.Atoi(nextStr)
 		if err == nil {
-			s.model.DelayScan(folder, time.Duration(next)*time.Second)
+			if reason := qs.Get("reason"); reason != "" {
+				s.model.DelayScanWithReason(folder, time.Duration(next)*time.Second, reason)
+			} else {
+				s.model.DelayScan(folder, time.Duration(next)*time.Second)
+			}
 		}
 	} else {
 		errors := s.model.ScanFolders()
@@ -1497,6 +1750,87 @@ func (s *service) postDBScan(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *service) getDBRehash(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	status, err := s.model.RehashStatus(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, status)
+}
+
+func (s *service) postDBRehash(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	if err := s.model.RehashFolder(folder); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.getDBRehash(w, r)
+}
+
+func (s *service) postDBRehashCancel(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	if err := s.model.CancelRehash(folder); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.getDBRehash(w, r)
+}
+
+func (s *service) getDBLocalIndex(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	cfg, ok := s.cfg.Folders()[folder]
+	if !ok {
+		http.Error(w, model.ErrFolderMissing.Error(), http.StatusNotFound)
+		return
+	}
+	if cfg.Paused {
+		http.Error(w, model.ErrFolderPaused.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Once streaming has started the status can't be changed anymore.
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.stindex"`, folder))
+	if err := s.model.ExportLocalIndex(folder, w); err != nil {
+		l.Warnf("Exporting local index of folder %q: %v", folder, err)
+	}
+}
+
+func (s *service) postDBLocalIndex(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	spotCheck, err := strconv.Atoi(qs.Get("spotcheck"))
+	if err != nil {
+		spotCheck = 0
+	}
+
+	// The index is read twice when importing, thus buffer it.
+	fd, err := ioutil.TempFile("", "syncthing-index-")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer os.Remove(fd.Name())
+	defer fd.Close()
+	if _, err := io.Copy(fd, r.Body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if _, err := fd.Seek(0, io.SeekStart); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	imported, err := s.model.ImportLocalIndex(folder, fd, spotCheck)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sendJSON(w, map[string]int{"imported": imported})
+}
+
 func (s *service) postDBPrio(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
@@ -1582,6 +1916,164 @@ func (s *service) postFolderVersionsRestore(w http.ResponseWriter, r *http.Reque
 	sendJSON(w, errorStringMap(ferr))
 }
 
+func (s *service) getChronicConflicts(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	conflicts, err := s.model.ChronicConflicts(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"folder":    folder,
+		"conflicts": conflicts,
+	})
+}
+
+func (s *service) getFolderAudit(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	folder := qs.Get("folder")
+	q := model.AuditQuery{
+		Prefix:     qs.Get("prefix"),
+		ModifiedBy: qs.Get("device"),
+	}
+	// Accept both full device IDs and the short IDs used in modifiedBy.
+	if id, err := protocol.DeviceIDFromString(q.ModifiedBy); err == nil {
+		q.ModifiedBy = id.Short().String()
+	}
+	if since, err := time.Parse(time.RFC3339, qs.Get("since")); err == nil {
+		q.Since = since
+	}
+	if until, err := time.Parse(time.RFC3339, qs.Get("until")); err == nil {
+		q.Until = until
+	}
+	if limit, err := strconv.Atoi(qs.Get("limit")); err == nil && limit > 0 {
+		q.Limit = limit
+	}
+
+	entries, err := s.model.AuditEntries(folder, q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"folder":  folder,
+		"entries": entries,
+	})
+}
+
+func (s *service) getFolderPullPlan(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	plan, err := s.model.PullPlan(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, plan)
+}
+
+func (s *service) getFolderTempFiles(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	files, err := s.model.TempFiles(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"folder": folder,
+		"files":  files,
+	})
+}
+
+func (s *service) getSkippedSymlinks(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	paths, err := s.model.SkippedSymlinks(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"folder":   folder,
+		"symlinks": paths,
+	})
+}
+
+func (s *service) getFolderIndexStatus(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	status, err := s.model.IndexExchangeStatus(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"folder":  folder,
+		"devices": status,
+	})
+}
+
+func (s *service) getPullConcurrency(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	concurrency, err := s.model.PullConcurrency(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, concurrency)
+}
+
+func (s *service) getFolderHealth(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	health, err := s.model.FolderHealth(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, health)
+}
+
+func (s *service) getFolderRetries(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	retries, err := s.model.PullRetries(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, retries)
+}
+
+func (s *service) postFolderRetries(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	if err := s.model.ResumePulling(folder); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	s.getFolderRetries(w, r)
+}
+
+func (s *service) postFolderErrorRetry(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	if err := s.model.RetryPullError(qs.Get("folder"), qs.Get("file")); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+}
+
+func (s *service) postFolderErrorClear(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	if err := s.model.ClearPullError(qs.Get("folder"), qs.Get("file")); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+}
+
+func (s *service) postFolderWatchRestart(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	if err := s.model.RestartWatch(r.Context(), qs.Get("folder")); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 func (s *service) getFolderErrors(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
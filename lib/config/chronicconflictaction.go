@@ -0,0 +1,38 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (a ChronicConflictAction) String() string {
+	switch a {
+	case ChronicConflictActionNotify:
+		return "notify"
+	case ChronicConflictActionIgnore:
+		return "ignore"
+	case ChronicConflictActionLastWriterWins:
+		return "lastWriterWins"
+	default:
+		return "unknown"
+	}
+}
+
+func (a ChronicConflictAction) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *ChronicConflictAction) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "notify":
+		*a = ChronicConflictActionNotify
+	case "ignore":
+		*a = ChronicConflictActionIgnore
+	case "lastWriterWins":
+		*a = ChronicConflictActionLastWriterWins
+	default:
+		*a = ChronicConflictActionNotify
+	}
+	return nil
+}
@@ -316,6 +316,10 @@ func (cfg *Configuration) prepareFolders(myID protocol.DeviceID, existingDevices
 
 		folder.prepare(myID, existingDevices)
 
+		if _, err := ParseConflictNameTemplate(folder.ConflictNameTemplate); err != nil {
+			return nil, fmt.Errorf("folder %q: %w", folder.ID, err)
+		}
+
 		existingFolders[folder.ID] = folder
 
 		for _, dev := range folder.Devices {
@@ -15,6 +15,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -86,6 +87,7 @@ func TestDefaultValues(t *testing.T) {
 			RawStunServers:          []string{"default"},
 			AnnounceLANAddresses:    true,
 			FeatureFlags:            []string{},
+			ShutdownTimeoutS:        10,
 		},
 		Defaults: Defaults{
 			Folder: FolderConfiguration{
@@ -103,10 +105,25 @@ func TestDefaultValues(t *testing.T) {
 					CleanupIntervalS: 3600,
 					Params:           map[string]string{},
 				},
-				MaxConflicts:         10,
-				WeakHashThresholdPct: 25,
-				MarkerName:           ".stfolder",
-				MaxConcurrentWrites:  2,
+				MaxConflicts:             10,
+				WeakHashThresholdPct:     25,
+				MarkerName:               ".stfolder",
+				MaxConcurrentWrites:      2,
+				FSWatcherDeleteGraceMs:   500,
+				AllowedContentSignatures: []string{},
+				ScanWindows:              []string{},
+				ChronicConflictWindowS:   86400,
+				AdaptiveScanIntervalS:    60,
+				AdaptiveScanQuietS:       600,
+				VerifyOnStartupSample:    1000,
+				AdaptivePullMaxKiB:       262144,
+				WatchErrorRescanAfter:    3,
+				AuditLogMaxEntries:       100000,
+				FullRescanEvery:          10,
+				FuzzyRenameThresholdPct:  90,
+				MaxFileErrors:            1000,
+				RenameCacheEntries:       1000,
+				ConflictNameTemplate:     ".sync-conflict-{date}-{time}-{device}{ext}",
 			},
 			Device: DeviceConfiguration{
 				Addresses:       []string{"dynamic"},
@@ -171,10 +188,25 @@ func TestDeviceConfig(t *testing.T) {
 				Versioning: VersioningConfiguration{
 					Params: map[string]string{},
 				},
-				WeakHashThresholdPct: 25,
-				MarkerName:           DefaultMarkerName,
-				JunctionsAsDirs:      true,
-				MaxConcurrentWrites:  maxConcurrentWritesDefault,
+				WeakHashThresholdPct:     25,
+				MarkerName:               DefaultMarkerName,
+				JunctionsAsDirs:          true,
+				MaxConcurrentWrites:      maxConcurrentWritesDefault,
+				FSWatcherDeleteGraceMs:   fsWatcherDeleteGraceDefaultMs,
+				AllowedContentSignatures: []string{},
+				ScanWindows:              []string{},
+				ChronicConflictWindowS:   chronicConflictWindowDefaultS,
+				AdaptiveScanIntervalS:    adaptiveScanIntervalDefaultS,
+				AdaptiveScanQuietS:       adaptiveScanQuietDefaultS,
+				VerifyOnStartupSample:    verifyOnStartupSampleDefault,
+				AdaptivePullMaxKiB:       adaptivePullMaxDefaultKiB,
+				WatchErrorRescanAfter:    watchErrorRescanAfterDefault,
+				AuditLogMaxEntries:       auditLogMaxEntriesDefault,
+				FullRescanEvery:          fullRescanEveryDefault,
+				FuzzyRenameThresholdPct:  fuzzyRenameThresholdDefault,
+				MaxFileErrors:            maxFileErrorsDefault,
+				RenameCacheEntries:       renameCacheEntriesDefault,
+				ConflictNameTemplate:     DefaultConflictNameTemplate,
 			},
 		}
 
@@ -269,6 +301,7 @@ func TestOverriddenValues(t *testing.T) {
 		StunKeepaliveMinS:       900,
 		RawStunServers:          []string{"foo"},
 		FeatureFlags:            []string{"feature"},
+		ShutdownTimeoutS:        20,
 	}
 	expectedPath := "/media/syncthing"
 
@@ -501,6 +534,27 @@ func TestFolderPath(t *testing.T) {
 	}
 }
 
+func TestFolderChangedFields(t *testing.T) {
+	from := FolderConfiguration{ID: "foo", Label: "foo", Versioning: VersioningConfiguration{Type: "simple"}}
+	to := from
+	to.Label = "bar"
+	to.Versioning.Params = map[string]string{"keep": "5"}
+	to.Paused = true
+
+	live, restart := from.ChangedFields(to)
+	if !reflect.DeepEqual(live, []string{"label"}) {
+		t.Errorf("Expected label to change live, got %v", live)
+	}
+	if !reflect.DeepEqual(restart, []string{"versioning", "paused"}) {
+		t.Errorf("Expected versioning and paused to require a restart, got %v", restart)
+	}
+
+	live, restart = from.ChangedFields(from)
+	if len(live) != 0 || len(restart) != 0 {
+		t.Errorf("Expected no changes, got %v and %v", live, restart)
+	}
+}
+
 func TestFolderCheckPath(t *testing.T) {
 	n, err := ioutil.TempDir("", "")
 	if err != nil {
@@ -558,6 +612,50 @@ func TestFolderCheckPath(t *testing.T) {
 	}
 }
 
+func TestFolderResolveRootSymlink(t *testing.T) {
+	n, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(n)
+	target := filepath.Join(n, "target")
+	if err := os.Mkdir(target, 0777); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(n, "link")
+	if runtime.GOOS == "windows" {
+		if output, err := exec.Command("cmd", "/c", "mklink", "/J", link, target).CombinedOutput(); err != nil {
+			t.Fatalf("Failed to create junction: %v %q", err, output)
+		}
+	} else if err := os.Symlink(target, link); err != nil {
+		t.Fatal(err)
+	}
+	// The temporary dir itself might be behind a link.
+	expected, err := filepath.EvalSymlinks(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           link,
+	}
+	if err := cfg.ResolveRootSymlink(); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Path != link {
+		t.Errorf("Expected the path to be kept without FollowRootSymlink, got %v", cfg.Path)
+	}
+
+	cfg.FollowRootSymlink = true
+	if err := cfg.ResolveRootSymlink(); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Path != expected {
+		t.Errorf("Expected the path to be resolved to %v, got %v", expected, cfg.Path)
+	}
+}
+
 func TestNewSaveLoad(t *testing.T) {
 	path := "testdata/temp.xml"
 	os.Remove(path)
@@ -647,6 +745,11 @@ func TestCopy(t *testing.T) {
 		// ioutil.WriteFile("b", bsCopy, 0644)
 		t.Error("Copy should be unchanged")
 	}
+
+	// Copying mustn't make an otherwise unchanged config look changed.
+	if !reflect.DeepEqual(copy.Folders, copy.Copy().Folders) {
+		t.Error("Copies of the same folders should be equal")
+	}
 }
 
 func TestPullOrder(t *testing.T) {
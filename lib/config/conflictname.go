@@ -0,0 +1,137 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultConflictNameTemplate = ".sync-conflict-{date}-{time}-{device}{ext}"
+
+	// Conflict copies named by the default template are recognized
+	// regardless of the configured one.
+	defaultConflictMarker = ".sync-conflict-"
+)
+
+// ConflictNameTemplate names conflict copies and recognizes them by name.
+type ConflictNameTemplate struct {
+	parts []string // literals and placeholders including the braces
+	re    *regexp.Regexp
+}
+
+func ParseConflictNameTemplate(s string) (ConflictNameTemplate, error) {
+	var parts []string
+	counts := make(map[string]int)
+	literal := false
+	pattern := "^.*"
+	for rest := s; rest != ""; {
+		open := strings.IndexByte(rest, '{')
+		if open != 0 {
+			lit := rest
+			if open > 0 {
+				lit = rest[:open]
+			}
+			if strings.ContainsAny(lit, `}/\*?[]`) {
+				return ConflictNameTemplate{}, fmt.Errorf("conflict name template %q: invalid characters in %q", s, lit)
+			}
+			parts = append(parts, lit)
+			pattern += regexp.QuoteMeta(lit)
+			literal = true
+			rest = rest[len(lit):]
+			continue
+		}
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return ConflictNameTemplate{}, fmt.Errorf("conflict name template %q: unterminated placeholder", s)
+		}
+		ph := rest[:end+1]
+		switch ph {
+		case "{date}":
+			pattern += `\d{8}`
+		case "{time}":
+			pattern += `\d{6}`
+		case "{device}":
+			pattern += `.*`
+		case "{ext}":
+			pattern += `(\.[^.]*)?`
+		default:
+			return ConflictNameTemplate{}, fmt.Errorf("conflict name template %q: unknown placeholder %s", s, ph)
+		}
+		counts[ph]++
+		parts = append(parts, ph)
+		rest = rest[len(ph):]
+	}
+	for _, ph := range []string{"{date}", "{time}", "{ext}"} {
+		if counts[ph] != 1 {
+			return ConflictNameTemplate{}, fmt.Errorf("conflict name template %q: must contain %s exactly once", s, ph)
+		}
+	}
+	if !literal {
+		return ConflictNameTemplate{}, fmt.Errorf("conflict name template %q: must contain fixed text to recognize conflict copies by", s)
+	}
+	return ConflictNameTemplate{
+		parts: parts,
+		re:    regexp.MustCompile(pattern + "$"),
+	}, nil
+}
+
+// ConflictNames returns the parsed conflict name template, or the default
+// one if it's invalid. Templates are validated when loading the config.
+func (f FolderConfiguration) ConflictNames() ConflictNameTemplate {
+	c, err := ParseConflictNameTemplate(f.ConflictNameTemplate)
+	if err != nil {
+		c, _ = ParseConflictNameTemplate(DefaultConflictNameTemplate)
+	}
+	return c
+}
+
+// Name returns the name of a conflict copy of the given file, made at t
+// due to a change by the given device.
+func (c ConflictNameTemplate) Name(name, device string, t time.Time) string {
+	ext := filepath.Ext(name)
+	return name[:len(name)-len(ext)] + c.expand(map[string]string{
+		"{date}":   t.Format("20060102"),
+		"{time}":   t.Format("150405"),
+		"{device}": device,
+		"{ext}":    ext,
+	})
+}
+
+// Glob returns a pattern matching the conflict copies of the given file.
+func (c ConflictNameTemplate) Glob(name string) string {
+	ext := filepath.Ext(name)
+	return name[:len(name)-len(ext)] + c.expand(map[string]string{
+		"{date}":   "????????",
+		"{time}":   "??????",
+		"{device}": "*",
+		"{ext}":    ext,
+	})
+}
+
+// IsConflict returns whether the given file is a conflict copy, named by
+// this or the default template.
+func (c ConflictNameTemplate) IsConflict(name string) bool {
+	base := filepath.Base(name)
+	return strings.Contains(base, defaultConflictMarker) || (c.re != nil && c.re.MatchString(base))
+}
+
+func (c ConflictNameTemplate) expand(values map[string]string) string {
+	var b strings.Builder
+	for _, part := range c.parts {
+		if v, ok := values[part]; ok {
+			b.WriteString(v)
+		} else {
+			b.WriteString(part)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestConflictNameTemplate(t *testing.T) {
+	now := time.Date(2021, 3, 10, 14, 5, 9, 0, time.UTC)
+
+	cases := []struct {
+		template, name, expected string
+	}{
+		{DefaultConflictNameTemplate, "dir/file.txt", "dir/file.sync-conflict-20210310-140509-ABCDEFG.txt"},
+		{DefaultConflictNameTemplate, "noext", "noext.sync-conflict-20210310-140509-ABCDEFG"},
+		{"{ext}~conflict~{date}{time}", "file.txt", "file.txt~conflict~20210310140509"},
+		{" (conflict {device} {date} {time}){ext}", "a.b.c", "a.b (conflict ABCDEFG 20210310 140509).c"},
+	}
+	for _, tc := range cases {
+		c, err := ParseConflictNameTemplate(tc.template)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name := c.Name(tc.name, "ABCDEFG", now)
+		if name != tc.expected {
+			t.Errorf("%q: expected %q, got %q", tc.template, tc.expected, name)
+		}
+		if !c.IsConflict(name) {
+			t.Errorf("%q: expected %q to be recognized as conflict", tc.template, name)
+		}
+		if c.IsConflict(tc.name) {
+			t.Errorf("%q: expected %q not to be recognized as conflict", tc.template, tc.name)
+		}
+		if ok, err := filepath.Match(c.Glob(tc.name), name); err != nil || !ok {
+			t.Errorf("%q: expected glob %q to match %q", tc.template, c.Glob(tc.name), name)
+		}
+	}
+
+	// Conflicts named by the default template are still recognized after
+	// changing it.
+	c, _ := ParseConflictNameTemplate("{ext}~conflict~{date}{time}")
+	if !c.IsConflict("file.sync-conflict-20210310-140509-ABCDEFG.txt") {
+		t.Error("Expected default conflict name to be recognized")
+	}
+}
+
+func TestConflictNameTemplateInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"{date}{time}{ext}",
+		".conflict-{date}-{time}",
+		".conflict-{date}-{date}-{time}{ext}",
+		".conflict-{date}-{time}-{user}{ext}",
+		".conflict-{date}-{time{ext}",
+		"/conflict-{date}-{time}{ext}",
+		".conflict*{date}-{time}{ext}",
+	} {
+		if _, err := ParseConflictNameTemplate(s); err == nil {
+			t.Errorf("Expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestConflictNameTemplateRejectedOnLoad(t *testing.T) {
+	cfg := Configuration{
+		Folders: []FolderConfiguration{{
+			ID:                   "default",
+			Path:                 "testdata",
+			ConflictNameTemplate: ".conflict-{date}{ext}",
+		}},
+	}
+	if err := cfg.prepare(protocol.LocalDeviceID); err == nil {
+		t.Error("Expected an invalid conflict name template to be rejected")
+	}
+}
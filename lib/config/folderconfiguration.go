@@ -9,6 +9,8 @@ package config
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"reflect"
 	"runtime"
 	"sort"
 	"strings"
@@ -32,6 +34,19 @@ const (
 	EncryptionTokenName        = "syncthing-encryption_password_token"
 	maxConcurrentWritesDefault = 2
 	maxConcurrentWritesLimit   = 64
+
+	fsWatcherDeleteGraceDefaultMs = 500
+	chronicConflictWindowDefaultS = 86400
+	adaptiveScanIntervalDefaultS  = 60
+	adaptiveScanQuietDefaultS     = 600
+	verifyOnStartupSampleDefault  = 1000
+	adaptivePullMaxDefaultKiB     = 262144
+	watchErrorRescanAfterDefault  = 3
+	auditLogMaxEntriesDefault     = 100000
+	fullRescanEveryDefault        = 10
+	fuzzyRenameThresholdDefault   = 90
+	maxFileErrorsDefault          = 1000
+	renameCacheEntriesDefault     = 1000
 )
 
 func (f FolderConfiguration) Copy() FolderConfiguration {
@@ -39,6 +54,14 @@ func (f FolderConfiguration) Copy() FolderConfiguration {
 	c.Devices = make([]FolderDeviceConfiguration, len(f.Devices))
 	copy(c.Devices, f.Devices)
 	c.Versioning = f.Versioning.Copy()
+	if f.AllowedContentSignatures != nil {
+		c.AllowedContentSignatures = make([]string, len(f.AllowedContentSignatures))
+		copy(c.AllowedContentSignatures, f.AllowedContentSignatures)
+	}
+	if f.ScanWindows != nil {
+		c.ScanWindows = make([]string, len(f.ScanWindows))
+		copy(c.ScanWindows, f.ScanWindows)
+	}
 	return c
 }
 
@@ -49,6 +72,9 @@ func (f FolderConfiguration) Filesystem() fs.Filesystem {
 	if f.FilesystemType == fs.FilesystemTypeBasic && f.JunctionsAsDirs {
 		opts = append(opts, new(fs.OptionJunctionsAsDirs))
 	}
+	if f.ScanWorkers > 1 {
+		opts = append(opts, &fs.OptionWalkWorkers{Workers: int(f.ScanWorkers)})
+	}
 	filesystem := fs.NewFilesystem(f.FilesystemType, f.Path, opts...)
 	if !f.CaseSensitiveFS {
 		filesystem = fs.NewCaseFilesystem(filesystem)
@@ -56,7 +82,13 @@ func (f FolderConfiguration) Filesystem() fs.Filesystem {
 	return filesystem
 }
 
+// ModTimeWindow returns the window within which modification times are
+// considered equal. A configured window applies on all platforms, otherwise
+// one is detected for FAT filesystems on Android.
 func (f FolderConfiguration) ModTimeWindow() time.Duration {
+	if f.RawModTimeWindowS < 0 {
+		return 0
+	}
 	dur := time.Duration(f.RawModTimeWindowS) * time.Second
 	if f.RawModTimeWindowS < 1 && runtime.GOOS == "android" {
 		if usage, err := disk.Usage(f.Filesystem().URI()); err != nil {
@@ -72,6 +104,16 @@ func (f FolderConfiguration) ModTimeWindow() time.Duration {
 	return dur
 }
 
+// FSWatcherDeleteGrace returns how long to wait before re-checking items
+// that look deleted during a scan triggered by the filesystem watcher. A
+// negative configured value disables the grace period.
+func (f FolderConfiguration) FSWatcherDeleteGrace() time.Duration {
+	if f.FSWatcherDeleteGraceMs < 0 {
+		return 0
+	}
+	return time.Duration(f.FSWatcherDeleteGraceMs) * time.Millisecond
+}
+
 func (f *FolderConfiguration) CreateMarker() error {
 	if err := f.CheckPath(); err != ErrMarkerMissing {
 		return err
@@ -135,6 +177,25 @@ func (f *FolderConfiguration) CheckPath() error {
 	return nil
 }
 
+// ResolveRootSymlink replaces the path with the target of the symlink or
+// junction it points at, if FollowRootSymlink is set. It does nothing for
+// filesystems other than basic or if the path isn't a link.
+func (f *FolderConfiguration) ResolveRootSymlink() error {
+	if !f.FollowRootSymlink || f.FilesystemType != fs.FilesystemTypeBasic {
+		return nil
+	}
+	path, err := fs.ExpandTilde(f.Path)
+	if err != nil {
+		return err
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return err
+	}
+	f.Path = resolved
+	return nil
+}
+
 func (f *FolderConfiguration) CreateRoot() (err error) {
 	// Directory permission bits. Will be filtered down to something
 	// sane by umask on Unixes.
@@ -169,6 +230,18 @@ func (f *FolderConfiguration) DeviceIDs() []protocol.DeviceID {
 	return deviceIDs
 }
 
+// AllowedSources returns the devices marked as allowed sources, nil if
+// blocks may be pulled from any device.
+func (f *FolderConfiguration) AllowedSources() []protocol.DeviceID {
+	var deviceIDs []protocol.DeviceID
+	for _, n := range f.Devices {
+		if n.AllowedSource {
+			deviceIDs = append(deviceIDs, n.DeviceID)
+		}
+	}
+	return deviceIDs
+}
+
 func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices map[protocol.DeviceID]bool) {
 	// Ensure that
 	// - any loose devices are not present in the wrong places
@@ -193,6 +266,10 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.FSWatcherDelayS = 10
 	}
 
+	if f.FSWatcherDeleteGraceMs == 0 {
+		f.FSWatcherDeleteGraceMs = fsWatcherDeleteGraceDefaultMs
+	}
+
 	if f.Versioning.CleanupIntervalS > MaxRescanIntervalS {
 		f.Versioning.CleanupIntervalS = MaxRescanIntervalS
 	} else if f.Versioning.CleanupIntervalS < 0 {
@@ -207,6 +284,101 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.MarkerName = DefaultMarkerName
 	}
 
+	if f.ChronicConflictThreshold < 0 {
+		f.ChronicConflictThreshold = 0
+	}
+	if f.ChronicConflictWindowS <= 0 {
+		f.ChronicConflictWindowS = chronicConflictWindowDefaultS
+	}
+
+	if f.AdaptiveScanThreshold < 0 {
+		f.AdaptiveScanThreshold = 0
+	}
+	if f.AdaptiveScanIntervalS <= 0 {
+		f.AdaptiveScanIntervalS = adaptiveScanIntervalDefaultS
+	}
+	if f.AdaptiveScanQuietS <= 0 {
+		f.AdaptiveScanQuietS = adaptiveScanQuietDefaultS
+	}
+
+	if f.AdaptivePullMaxKiB <= 0 {
+		f.AdaptivePullMaxKiB = adaptivePullMaxDefaultKiB
+	}
+
+	if f.EmptyPathGuardRatio < 0 {
+		f.EmptyPathGuardRatio = 0
+	}
+
+	if f.WatchErrorRescanAfter <= 0 {
+		f.WatchErrorRescanAfter = watchErrorRescanAfterDefault
+	}
+
+	if f.AuditLogMaxEntries <= 0 {
+		f.AuditLogMaxEntries = auditLogMaxEntriesDefault
+	}
+	if f.AuditLogMaxAgeDays < 0 {
+		f.AuditLogMaxAgeDays = 0
+	}
+
+	if f.ScanWorkers < 0 {
+		f.ScanWorkers = 0
+	}
+
+	if f.FullRescanEvery <= 0 {
+		f.FullRescanEvery = fullRescanEveryDefault
+	}
+
+	if f.FuzzyRenameThresholdPct <= 0 || f.FuzzyRenameThresholdPct > 100 {
+		f.FuzzyRenameThresholdPct = fuzzyRenameThresholdDefault
+	}
+
+	if f.MaxFileErrors <= 0 {
+		f.MaxFileErrors = maxFileErrorsDefault
+	}
+
+	if f.MaxScanReadBandwidth < 0 {
+		f.MaxScanReadBandwidth = 0
+	}
+
+	if f.MaxScanDurationM < 0 {
+		f.MaxScanDurationM = 0
+	}
+
+	if f.ConflictNameTemplate == "" {
+		f.ConflictNameTemplate = DefaultConflictNameTemplate
+	}
+
+	// Filtered into a new slice, as the given one may be shared.
+	windows := make([]string, 0, len(f.ScanWindows))
+	for _, s := range f.ScanWindows {
+		if _, err := ParseScanWindow(s); err != nil {
+			l.Warnf("Folder %v: ignoring invalid %v", f.Description(), err)
+			continue
+		}
+		windows = append(windows, s)
+	}
+	f.ScanWindows = windows
+
+	if f.RenameCacheEntries == 0 {
+		f.RenameCacheEntries = renameCacheEntriesDefault
+	}
+
+	if f.MinFileAgeS < 0 {
+		f.MinFileAgeS = 0
+	}
+
+	if f.KeepTemporariesH < 0 {
+		f.KeepTemporariesH = 0
+	}
+
+	if f.ReceiveOnlyRevertIntervalS < 0 {
+		f.ReceiveOnlyRevertIntervalS = 0
+	}
+
+	if f.VerifyOnStartupSample <= 0 {
+		f.VerifyOnStartupSample = verifyOnStartupSampleDefault
+	}
+
 	if f.MaxConcurrentWrites <= 0 {
 		f.MaxConcurrentWrites = maxConcurrentWritesDefault
 	} else if f.MaxConcurrentWrites > maxConcurrentWritesLimit {
@@ -236,6 +408,51 @@ func (f FolderConfiguration) RequiresRestartOnly() FolderConfiguration {
 	return copy
 }
 
+// ChangedFields returns the JSON names of the attributes that differ in
+// the given configuration, split into those that take effect without
+// restarting the folder and those that require a restart.
+func (f FolderConfiguration) ChangedFields(to FolderConfiguration) (live, restart []string) {
+	live, restart = []string{}, []string{}
+	fromStruct := reflect.ValueOf(f)
+	toStruct := reflect.ValueOf(to)
+	structType := fromStruct.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			// Unexported fields
+			continue
+		}
+		if reflect.DeepEqual(fromStruct.Field(i).Interface(), toStruct.Field(i).Interface()) {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.Tag.Get("restart") == "false" {
+			live = append(live, name)
+		} else {
+			restart = append(restart, name)
+		}
+	}
+	return live, restart
+}
+
+// ChronicConflictWindow returns the duration within which more than
+// ChronicConflictThreshold conflicts make a file a chronic conflict.
+func (f FolderConfiguration) ChronicConflictWindow() time.Duration {
+	return time.Duration(f.ChronicConflictWindowS) * time.Second
+}
+
+// AuditLogMaxAge returns how long entries are kept in the audit log, zero
+// meaning forever.
+func (f FolderConfiguration) AuditLogMaxAge() time.Duration {
+	return time.Duration(f.AuditLogMaxAgeDays) * 24 * time.Hour
+}
+
+// MaxScanDuration returns how long a timer scan may take, zero meaning
+// unlimited.
+func (f FolderConfiguration) MaxScanDuration() time.Duration {
+	return time.Duration(f.MaxScanDurationM) * time.Minute
+}
+
 func (f *FolderConfiguration) Device(device protocol.DeviceID) (FolderDeviceConfiguration, bool) {
 	for _, dev := range f.Devices {
 		if dev.DeviceID == device {
@@ -30,6 +30,9 @@ type FolderDeviceConfiguration struct {
 	DeviceID           github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"deviceID" xml:"id,attr"`
 	IntroducedBy       github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,2,opt,name=introduced_by,json=introducedBy,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"introducedBy" xml:"introducedBy,attr"`
 	EncryptionPassword string                                               `protobuf:"bytes,3,opt,name=encryption_password,json=encryptionPassword,proto3" json:"encryptionPassword" xml:"encryptionPassword"`
+	// Blocks are only pulled from devices marked as allowed sources, if
+	// any are, see source_fallback on the folder.
+	AllowedSource bool `protobuf:"varint,4,opt,name=allowed_source,json=allowedSource,proto3" json:"allowedSource" xml:"allowedSource,attr,omitempty"`
 }
 
 func (m *FolderDeviceConfiguration) Reset()         { *m = FolderDeviceConfiguration{} }
@@ -66,40 +69,194 @@ func (m *FolderDeviceConfiguration) XXX_DiscardUnknown() {
 var xxx_messageInfo_FolderDeviceConfiguration proto.InternalMessageInfo
 
 type FolderConfiguration struct {
-	ID                      string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id,attr" nodefault:"true"`
-	Label                   string                      `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label,attr" restart:"false"`
-	FilesystemType          fs.FilesystemType           `protobuf:"varint,3,opt,name=filesystem_type,json=filesystemType,proto3,enum=fs.FilesystemType" json:"filesystemType" xml:"filesystemType"`
-	Path                    string                      `protobuf:"bytes,4,opt,name=path,proto3" json:"path" xml:"path,attr" default:"~"`
-	Type                    FolderType                  `protobuf:"varint,5,opt,name=type,proto3,enum=config.FolderType" json:"type" xml:"type,attr"`
-	Devices                 []FolderDeviceConfiguration `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices" xml:"device"`
-	RescanIntervalS         int                         `protobuf:"varint,7,opt,name=rescan_interval_s,json=rescanIntervalS,proto3,casttype=int" json:"rescanIntervalS" xml:"rescanIntervalS,attr" default:"3600"`
-	FSWatcherEnabled        bool                        `protobuf:"varint,8,opt,name=fs_watcher_enabled,json=fsWatcherEnabled,proto3" json:"fsWatcherEnabled" xml:"fsWatcherEnabled,attr" default:"true"`
-	FSWatcherDelayS         int                         `protobuf:"varint,9,opt,name=fs_watcher_delay_s,json=fsWatcherDelayS,proto3,casttype=int" json:"fsWatcherDelayS" xml:"fsWatcherDelayS,attr" default:"10"`
-	IgnorePerms             bool                        `protobuf:"varint,10,opt,name=ignore_perms,json=ignorePerms,proto3" json:"ignorePerms" xml:"ignorePerms,attr"`
-	AutoNormalize           bool                        `protobuf:"varint,11,opt,name=auto_normalize,json=autoNormalize,proto3" json:"autoNormalize" xml:"autoNormalize,attr" default:"true"`
-	MinDiskFree             Size                        `protobuf:"bytes,12,opt,name=min_disk_free,json=minDiskFree,proto3" json:"minDiskFree" xml:"minDiskFree" default:"1 %"`
-	Versioning              VersioningConfiguration     `protobuf:"bytes,13,opt,name=versioning,proto3" json:"versioning" xml:"versioning"`
-	Copiers                 int                         `protobuf:"varint,14,opt,name=copiers,proto3,casttype=int" json:"copiers" xml:"copiers"`
-	PullerMaxPendingKiB     int                         `protobuf:"varint,15,opt,name=puller_max_pending_kib,json=pullerMaxPendingKib,proto3,casttype=int" json:"pullerMaxPendingKiB" xml:"pullerMaxPendingKiB"`
-	Hashers                 int                         `protobuf:"varint,16,opt,name=hashers,proto3,casttype=int" json:"hashers" xml:"hashers"`
-	Order                   PullOrder                   `protobuf:"varint,17,opt,name=order,proto3,enum=config.PullOrder" json:"order" xml:"order"`
-	IgnoreDelete            bool                        `protobuf:"varint,18,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
-	ScanProgressIntervalS   int                         `protobuf:"varint,19,opt,name=scan_progress_interval_s,json=scanProgressIntervalS,proto3,casttype=int" json:"scanProgressIntervalS" xml:"scanProgressIntervalS"`
-	PullerPauseS            int                         `protobuf:"varint,20,opt,name=puller_pause_s,json=pullerPauseS,proto3,casttype=int" json:"pullerPauseS" xml:"pullerPauseS"`
-	MaxConflicts            int                         `protobuf:"varint,21,opt,name=max_conflicts,json=maxConflicts,proto3,casttype=int" json:"maxConflicts" xml:"maxConflicts" default:"10"`
-	DisableSparseFiles      bool                        `protobuf:"varint,22,opt,name=disable_sparse_files,json=disableSparseFiles,proto3" json:"disableSparseFiles" xml:"disableSparseFiles"`
-	DisableTempIndexes      bool                        `protobuf:"varint,23,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
-	Paused                  bool                        `protobuf:"varint,24,opt,name=paused,proto3" json:"paused" xml:"paused"`
-	WeakHashThresholdPct    int                         `protobuf:"varint,25,opt,name=weak_hash_threshold_pct,json=weakHashThresholdPct,proto3,casttype=int" json:"weakHashThresholdPct" xml:"weakHashThresholdPct"`
-	MarkerName              string                      `protobuf:"bytes,26,opt,name=marker_name,json=markerName,proto3" json:"markerName" xml:"markerName"`
-	CopyOwnershipFromParent bool                        `protobuf:"varint,27,opt,name=copy_ownership_from_parent,json=copyOwnershipFromParent,proto3" json:"copyOwnershipFromParent" xml:"copyOwnershipFromParent"`
-	RawModTimeWindowS       int                         `protobuf:"varint,28,opt,name=mod_time_window_s,json=modTimeWindowS,proto3,casttype=int" json:"modTimeWindowS" xml:"modTimeWindowS"`
-	MaxConcurrentWrites     int                         `protobuf:"varint,29,opt,name=max_concurrent_writes,json=maxConcurrentWrites,proto3,casttype=int" json:"maxConcurrentWrites" xml:"maxConcurrentWrites" default:"2"`
-	DisableFsync            bool                        `protobuf:"varint,30,opt,name=disable_fsync,json=disableFsync,proto3" json:"disableFsync" xml:"disableFsync"`
-	BlockPullOrder          BlockPullOrder              `protobuf:"varint,31,opt,name=block_pull_order,json=blockPullOrder,proto3,enum=config.BlockPullOrder" json:"blockPullOrder" xml:"blockPullOrder"`
-	CopyRangeMethod         fs.CopyRangeMethod          `protobuf:"varint,32,opt,name=copy_range_method,json=copyRangeMethod,proto3,enum=fs.CopyRangeMethod" json:"copyRangeMethod" xml:"copyRangeMethod" default:"standard"`
-	CaseSensitiveFS         bool                        `protobuf:"varint,33,opt,name=case_sensitive_fs,json=caseSensitiveFs,proto3" json:"caseSensitiveFS" xml:"caseSensitiveFS"`
-	JunctionsAsDirs         bool                        `protobuf:"varint,34,opt,name=follow_junctions,json=followJunctions,proto3" json:"junctionsAsDirs" xml:"junctionsAsDirs"`
+	ID                       string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id,attr" nodefault:"true"`
+	Label                    string                      `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label,attr" restart:"false"`
+	FilesystemType           fs.FilesystemType           `protobuf:"varint,3,opt,name=filesystem_type,json=filesystemType,proto3,enum=fs.FilesystemType" json:"filesystemType" xml:"filesystemType"`
+	Path                     string                      `protobuf:"bytes,4,opt,name=path,proto3" json:"path" xml:"path,attr" default:"~"`
+	Type                     FolderType                  `protobuf:"varint,5,opt,name=type,proto3,enum=config.FolderType" json:"type" xml:"type,attr"`
+	Devices                  []FolderDeviceConfiguration `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices" xml:"device"`
+	RescanIntervalS          int                         `protobuf:"varint,7,opt,name=rescan_interval_s,json=rescanIntervalS,proto3,casttype=int" json:"rescanIntervalS" xml:"rescanIntervalS,attr" default:"3600"`
+	FSWatcherEnabled         bool                        `protobuf:"varint,8,opt,name=fs_watcher_enabled,json=fsWatcherEnabled,proto3" json:"fsWatcherEnabled" xml:"fsWatcherEnabled,attr" default:"true"`
+	FSWatcherDelayS          int                         `protobuf:"varint,9,opt,name=fs_watcher_delay_s,json=fsWatcherDelayS,proto3,casttype=int" json:"fsWatcherDelayS" xml:"fsWatcherDelayS,attr" default:"10"`
+	IgnorePerms              bool                        `protobuf:"varint,10,opt,name=ignore_perms,json=ignorePerms,proto3" json:"ignorePerms" xml:"ignorePerms,attr"`
+	AutoNormalize            bool                        `protobuf:"varint,11,opt,name=auto_normalize,json=autoNormalize,proto3" json:"autoNormalize" xml:"autoNormalize,attr" default:"true"`
+	MinDiskFree              Size                        `protobuf:"bytes,12,opt,name=min_disk_free,json=minDiskFree,proto3" json:"minDiskFree" xml:"minDiskFree" default:"1 %"`
+	Versioning               VersioningConfiguration     `protobuf:"bytes,13,opt,name=versioning,proto3" json:"versioning" xml:"versioning"`
+	Copiers                  int                         `protobuf:"varint,14,opt,name=copiers,proto3,casttype=int" json:"copiers" xml:"copiers"`
+	PullerMaxPendingKiB      int                         `protobuf:"varint,15,opt,name=puller_max_pending_kib,json=pullerMaxPendingKib,proto3,casttype=int" json:"pullerMaxPendingKiB" xml:"pullerMaxPendingKiB"`
+	Hashers                  int                         `protobuf:"varint,16,opt,name=hashers,proto3,casttype=int" json:"hashers" xml:"hashers"`
+	Order                    PullOrder                   `protobuf:"varint,17,opt,name=order,proto3,enum=config.PullOrder" json:"order" xml:"order"`
+	IgnoreDelete             bool                        `protobuf:"varint,18,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
+	ScanProgressIntervalS    int                         `protobuf:"varint,19,opt,name=scan_progress_interval_s,json=scanProgressIntervalS,proto3,casttype=int" json:"scanProgressIntervalS" xml:"scanProgressIntervalS"`
+	PullerPauseS             int                         `protobuf:"varint,20,opt,name=puller_pause_s,json=pullerPauseS,proto3,casttype=int" json:"pullerPauseS" xml:"pullerPauseS"`
+	MaxConflicts             int                         `protobuf:"varint,21,opt,name=max_conflicts,json=maxConflicts,proto3,casttype=int" json:"maxConflicts" xml:"maxConflicts" default:"10"`
+	DisableSparseFiles       bool                        `protobuf:"varint,22,opt,name=disable_sparse_files,json=disableSparseFiles,proto3" json:"disableSparseFiles" xml:"disableSparseFiles"`
+	DisableTempIndexes       bool                        `protobuf:"varint,23,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
+	Paused                   bool                        `protobuf:"varint,24,opt,name=paused,proto3" json:"paused" xml:"paused"`
+	WeakHashThresholdPct     int                         `protobuf:"varint,25,opt,name=weak_hash_threshold_pct,json=weakHashThresholdPct,proto3,casttype=int" json:"weakHashThresholdPct" xml:"weakHashThresholdPct"`
+	MarkerName               string                      `protobuf:"bytes,26,opt,name=marker_name,json=markerName,proto3" json:"markerName" xml:"markerName"`
+	CopyOwnershipFromParent  bool                        `protobuf:"varint,27,opt,name=copy_ownership_from_parent,json=copyOwnershipFromParent,proto3" json:"copyOwnershipFromParent" xml:"copyOwnershipFromParent"`
+	RawModTimeWindowS        int                         `protobuf:"varint,28,opt,name=mod_time_window_s,json=modTimeWindowS,proto3,casttype=int" json:"modTimeWindowS" xml:"modTimeWindowS"`
+	MaxConcurrentWrites      int                         `protobuf:"varint,29,opt,name=max_concurrent_writes,json=maxConcurrentWrites,proto3,casttype=int" json:"maxConcurrentWrites" xml:"maxConcurrentWrites" default:"2"`
+	DisableFsync             bool                        `protobuf:"varint,30,opt,name=disable_fsync,json=disableFsync,proto3" json:"disableFsync" xml:"disableFsync"`
+	BlockPullOrder           BlockPullOrder              `protobuf:"varint,31,opt,name=block_pull_order,json=blockPullOrder,proto3,enum=config.BlockPullOrder" json:"blockPullOrder" xml:"blockPullOrder"`
+	CopyRangeMethod          fs.CopyRangeMethod          `protobuf:"varint,32,opt,name=copy_range_method,json=copyRangeMethod,proto3,enum=fs.CopyRangeMethod" json:"copyRangeMethod" xml:"copyRangeMethod" default:"standard"`
+	CaseSensitiveFS          bool                        `protobuf:"varint,33,opt,name=case_sensitive_fs,json=caseSensitiveFs,proto3" json:"caseSensitiveFS" xml:"caseSensitiveFS"`
+	JunctionsAsDirs          bool                        `protobuf:"varint,34,opt,name=follow_junctions,json=followJunctions,proto3" json:"junctionsAsDirs" xml:"junctionsAsDirs"`
+	FSWatcherDeleteGraceMs   int                         `protobuf:"varint,35,opt,name=fs_watcher_delete_grace_ms,json=fsWatcherDeleteGraceMs,proto3,casttype=int" json:"fsWatcherDeleteGraceMs" xml:"fsWatcherDeleteGraceMs" default:"500"`
+	VerifyModTimes           bool                        `protobuf:"varint,36,opt,name=verify_mod_times,json=verifyModTimes,proto3" json:"verifyModTimes" xml:"verifyModTimes"`
+	AllowedContentSignatures []string                    `protobuf:"bytes,37,rep,name=allowed_content_signatures,json=allowedContentSignatures,proto3" json:"allowedContentSignatures" xml:"allowedContentSignature,omitempty"`
+	MaxScanOpenFiles         int                         `protobuf:"varint,38,opt,name=max_scan_open_files,json=maxScanOpenFiles,proto3,casttype=int" json:"maxScanOpenFiles" xml:"maxScanOpenFiles"`
+	ChronicConflictThreshold int                         `protobuf:"varint,39,opt,name=chronic_conflict_threshold,json=chronicConflictThreshold,proto3,casttype=int" json:"chronicConflictThreshold" xml:"chronicConflictThreshold"`
+	ChronicConflictWindowS   int                         `protobuf:"varint,40,opt,name=chronic_conflict_window_s,json=chronicConflictWindowS,proto3,casttype=int" json:"chronicConflictWindowS" xml:"chronicConflictWindowS" default:"86400"`
+	ChronicConflictAction    ChronicConflictAction       `protobuf:"varint,41,opt,name=chronic_conflict_action,json=chronicConflictAction,proto3,enum=config.ChronicConflictAction" json:"chronicConflictAction" xml:"chronicConflictAction"`
+	SkipUnchangedDirs        bool                        `protobuf:"varint,42,opt,name=skip_unchanged_dirs,json=skipUnchangedDirs,proto3" json:"skipUnchangedDirs" xml:"skipUnchangedDirs"`
+	WatchDiskSpace           bool                        `protobuf:"varint,43,opt,name=watch_disk_space,json=watchDiskSpace,proto3" json:"watchDiskSpace" xml:"watchDiskSpace"`
+	// Only remote deletions are pulled, while remote creations and
+	// modifications are not. The folder thus never grows due to changes on
+	// other devices, but may differ from the global state indefinitely.
+	PullDeletionsOnly bool `protobuf:"varint,44,opt,name=pull_deletions_only,json=pullDeletionsOnly,proto3" json:"pullDeletionsOnly" xml:"pullDeletionsOnly"`
+	// Command run on each pulled file before it's put in place. A non-zero
+	// exit status quarantines the file instead.
+	PostPullValidationCommand string `protobuf:"bytes,45,opt,name=post_pull_validation_command,json=postPullValidationCommand,proto3" json:"postPullValidationCommand" xml:"postPullValidationCommand"`
+	// Folders detecting at least adaptive_scan_threshold changes within a
+	// minute are rescanned every adaptive_scan_interval_s instead, until
+	// they have been quiet for adaptive_scan_quiet_s. Zero disables it.
+	AdaptiveScanThreshold int `protobuf:"varint,46,opt,name=adaptive_scan_threshold,json=adaptiveScanThreshold,proto3,casttype=int" json:"adaptiveScanThreshold" xml:"adaptiveScanThreshold"`
+	AdaptiveScanIntervalS int `protobuf:"varint,47,opt,name=adaptive_scan_interval_s,json=adaptiveScanIntervalS,proto3,casttype=int" json:"adaptiveScanIntervalS" xml:"adaptiveScanIntervalS" default:"60"`
+	AdaptiveScanQuietS    int `protobuf:"varint,48,opt,name=adaptive_scan_quiet_s,json=adaptiveScanQuietS,proto3,casttype=int" json:"adaptiveScanQuietS" xml:"adaptiveScanQuietS" default:"600"`
+	// Compare the size and modification time of a random sample of
+	// verify_on_startup_sample files against the database when the folder
+	// starts and rehash those that differ. With verify_on_startup_full all
+	// files are compared, including their block hashes.
+	VerifyOnStartup       bool `protobuf:"varint,49,opt,name=verify_on_startup,json=verifyOnStartup,proto3" json:"verifyOnStartup" xml:"verifyOnStartup"`
+	VerifyOnStartupSample int  `protobuf:"varint,50,opt,name=verify_on_startup_sample,json=verifyOnStartupSample,proto3,casttype=int" json:"verifyOnStartupSample" xml:"verifyOnStartupSample" default:"1000"`
+	VerifyOnStartupFull   bool `protobuf:"varint,51,opt,name=verify_on_startup_full,json=verifyOnStartupFull,proto3" json:"verifyOnStartupFull" xml:"verifyOnStartupFull"`
+	// Symlinks are synced as links by default. They may be skipped instead,
+	// or links to regular files synced as the file they point to.
+	SymlinkPolicy SymlinkPolicy `protobuf:"varint,52,opt,name=symlink_policy,json=symlinkPolicy,proto3,enum=config.SymlinkPolicy" json:"symlinkPolicy" xml:"symlinkPolicy"`
+	// Refuse to scan or pull when the database has at least
+	// empty_path_guard_ratio times as many items as are found on disk, as
+	// the folder path is then likely an empty mountpoint. Zero disables it.
+	EmptyPathGuardRatio int `protobuf:"varint,53,opt,name=empty_path_guard_ratio,json=emptyPathGuardRatio,proto3,casttype=int" json:"emptyPathGuardRatio" xml:"emptyPathGuardRatio"`
+	// Tune the amount of pending block requests to the observed throughput
+	// and round trip times, starting at puller_max_pending_kib and never
+	// exceeding adaptive_pull_max_kib.
+	AdaptivePullConcurrency bool `protobuf:"varint,54,opt,name=adaptive_pull_concurrency,json=adaptivePullConcurrency,proto3" json:"adaptivePullConcurrency" xml:"adaptivePullConcurrency"`
+	AdaptivePullMaxKiB      int  `protobuf:"varint,55,opt,name=adaptive_pull_max_kib,json=adaptivePullMaxKib,proto3,casttype=int" json:"adaptivePullMaxKiB" xml:"adaptivePullMaxKiB" default:"262144"`
+	// Don't check whether deleted files considered as the source of a
+	// rename are ignored. Ignored files are never rename candidates, unless
+	// the ignore patterns changed since the last full scan, in which case
+	// the check is done regardless.
+	SkipRenameIgnoreCheck bool `protobuf:"varint,56,opt,name=skip_rename_ignore_check,json=skipRenameIgnoreCheck,proto3" json:"skipRenameIgnoreCheck" xml:"skipRenameIgnoreCheck"`
+	// What to scan once the watcher recovers from an error: The entire
+	// folder, only what the watcher reported before failing, or the entire
+	// folder only after watch_error_rescan_after consecutive errors.
+	WatchErrorPolicy      WatchErrorPolicy `protobuf:"varint,57,opt,name=watch_error_policy,json=watchErrorPolicy,proto3,enum=config.WatchErrorPolicy" json:"watchErrorPolicy" xml:"watchErrorPolicy"`
+	WatchErrorRescanAfter int              `protobuf:"varint,58,opt,name=watch_error_rescan_after,json=watchErrorRescanAfter,proto3,casttype=int" json:"watchErrorRescanAfter" xml:"watchErrorRescanAfter" default:"3"`
+	// Hash the most recently modified files first when scanning, instead
+	// of in walk order.
+	HashNewestFirst bool `protobuf:"varint,59,opt,name=hash_newest_first,json=hashNewestFirst,proto3" json:"hashNewestFirst" xml:"hashNewestFirst"`
+	// Emit at most pull_events_per_s RemoteChangeDetected events per
+	// second while pulling, summarizing the remaining changes in a
+	// RemoteChangeSummary event every second. Zero means no limit.
+	PullEventsPerS int `protobuf:"varint,60,opt,name=pull_events_per_s,json=pullEventsPerS,proto3,casttype=int" json:"pullEventsPerS" xml:"pullEventsPerS"`
+	// Stop retrying to pull automatically after this many consecutive
+	// failed retries, until resumed through the API or there is nothing
+	// left to pull. Zero means retrying forever.
+	MaxPullRetries int `protobuf:"varint,61,opt,name=max_pull_retries,json=maxPullRetries,proto3,casttype=int" json:"maxPullRetries" xml:"maxPullRetries"`
+	// What to do with files that no device marked as allowed source has:
+	// Pull them from any device, or hold them until an allowed source
+	// has them.
+	SourceFallback SourceFallback `protobuf:"varint,62,opt,name=source_fallback,json=sourceFallback,proto3,enum=config.SourceFallback" json:"sourceFallback" xml:"sourceFallback"`
+	// Keep a persistent log of the changes applied from other devices,
+	// bounded to audit_log_max_entries entries that are at most
+	// audit_log_max_age_days old. Zero days means no age limit.
+	AuditLog           bool `protobuf:"varint,63,opt,name=audit_log,json=auditLog,proto3" json:"auditLog" xml:"auditLog"`
+	AuditLogMaxEntries int  `protobuf:"varint,64,opt,name=audit_log_max_entries,json=auditLogMaxEntries,proto3,casttype=int" json:"auditLogMaxEntries" xml:"auditLogMaxEntries" default:"100000"`
+	AuditLogMaxAgeDays int  `protobuf:"varint,65,opt,name=audit_log_max_age_days,json=auditLogMaxAgeDays,proto3,casttype=int" json:"auditLogMaxAgeDays" xml:"auditLogMaxAgeDays"`
+	// Stat the entries of each directory with this many concurrent
+	// workers while scanning, independent of the number of hashers. Zero
+	// or one walks sequentially.
+	ScanWorkers int `protobuf:"varint,66,opt,name=scan_workers,json=scanWorkers,proto3,casttype=int" json:"scanWorkers" xml:"scanWorkers"`
+	// On the rescan timer, only scan the subtrees of directories whose
+	// modification time changed since the last such scan, doing a full
+	// scan every full_rescan_every timer fires. Changes to the contents of
+	// existing files don't touch their directory, thus they are only
+	// picked up by the watcher or the next full scan.
+	ScanChangedDirsOnly bool `protobuf:"varint,67,opt,name=scan_changed_dirs_only,json=scanChangedDirsOnly,proto3" json:"scanChangedDirsOnly" xml:"scanChangedDirsOnly"`
+	FullRescanEvery     int  `protobuf:"varint,68,opt,name=full_rescan_every,json=fullRescanEvery,proto3,casttype=int" json:"fullRescanEvery" xml:"fullRescanEvery" default:"10"`
+	// How to detect renames while scanning: Not at all, by identical
+	// contents, or additionally by files sharing the first blocks and at
+	// least fuzzy_rename_threshold_pct percent of their blocks, e.g. a
+	// renamed log file that was appended to.
+	RenameDetectionMode     RenameDetectionMode `protobuf:"varint,69,opt,name=rename_detection_mode,json=renameDetectionMode,proto3,enum=config.RenameDetectionMode" json:"renameDetectionMode" xml:"renameDetectionMode"`
+	FuzzyRenameThresholdPct int                 `protobuf:"varint,70,opt,name=fuzzy_rename_threshold_pct,json=fuzzyRenameThresholdPct,proto3,casttype=int" json:"fuzzyRenameThresholdPct" xml:"fuzzyRenameThresholdPct" default:"90"`
+	// Limit reading files for hashing while scanning to this many KiB/s,
+	// in total across all hashers. Zero means unlimited.
+	MaxScanReadBandwidth int `protobuf:"varint,71,opt,name=max_scan_read_bandwidth,json=maxScanReadBandwidth,proto3,casttype=int" json:"maxScanReadBandwidth" xml:"maxScanReadBandwidth"`
+	// Revert the local changes of a receive only folder every this many
+	// seconds. Zero means only reverting on request.
+	ReceiveOnlyRevertIntervalS int `protobuf:"varint,72,opt,name=receive_only_revert_interval_s,json=receiveOnlyRevertIntervalS,proto3,casttype=int" json:"receiveOnlyRevertIntervalS" xml:"receiveOnlyRevertIntervalS"`
+	// Rescan exactly every rescan_interval_s instead of at a random time
+	// around it. The randomization spreads the load of many folders, so
+	// setting this on many folders with the same interval makes them scan
+	// all at once.
+	DeterministicRescan bool `protobuf:"varint,73,opt,name=deterministic_rescan,json=deterministicRescan,proto3" json:"deterministicRescan" xml:"deterministicRescan"`
+	// Don't watch ignored directories below which nothing can be
+	// unignored, even if negated patterns unignore something elsewhere.
+	WatchDropIgnoredEvents bool `protobuf:"varint,74,opt,name=watch_drop_ignored_events,json=watchDropIgnoredEvents,proto3" json:"watchDropIgnoredEvents" xml:"watchDropIgnoredEvents"`
+	// Run this command after a pull that changed something succeeded.
+	PostPullCommand string `protobuf:"bytes,75,opt,name=post_pull_command,json=postPullCommand,proto3" json:"postPullCommand" xml:"postPullCommand"`
+	// Keep at most this many scan and as many pull errors, only counting
+	// the rest.
+	MaxFileErrors int `protobuf:"varint,76,opt,name=max_file_errors,json=maxFileErrors,proto3,casttype=int" json:"maxFileErrors" xml:"maxFileErrors" default:"1000"`
+	// If the path is a symlink or junction, use its target as the folder
+	// root once the folder starts.
+	FollowRootSymlink bool `protobuf:"varint,77,opt,name=follow_root_symlink,json=followRootSymlink,proto3" json:"followRootSymlink" xml:"followRootSymlink"`
+	// Postpone timer triggered full scans while another folder is pulling
+	// with an I/O token, to not thrash spinning disks. Scans of a folder
+	// may be starved while others pull constantly.
+	DeferScanWhilePulling bool `protobuf:"varint,78,opt,name=defer_scan_while_pulling,json=deferScanWhilePulling,proto3" json:"deferScanWhilePulling" xml:"deferScanWhilePulling"`
+	// Don't check min_home_disk_free for the database if it is on the same
+	// volume as the folder, which is then covered by min_disk_free alone.
+	SkipSharedVolumeDatabaseSpaceCheck bool `protobuf:"varint,79,opt,name=skip_shared_volume_database_space_check,json=skipSharedVolumeDatabaseSpaceCheck,proto3" json:"skipSharedVolumeDatabaseSpaceCheck" xml:"skipSharedVolumeDatabaseSpaceCheck"`
+	// Before pulling, scan the local files that other devices announced
+	// changes for, to catch local modifications not yet picked up.
+	VerifyBeforePull bool `protobuf:"varint,80,opt,name=verify_before_pull,json=verifyBeforePull,proto3" json:"verifyBeforePull" xml:"verifyBeforePull"`
+	// How many content hashes of rename candidates to keep in memory during
+	// a scan, saving database lookups for files with the same contents.
+	// A negative value disables the cache.
+	RenameCacheEntries int `protobuf:"varint,81,opt,name=rename_cache_entries,json=renameCacheEntries,proto3,casttype=int" json:"renameCacheEntries" xml:"renameCacheEntries" default:"1000"`
+	// Don't scan changed files modified less than this many seconds ago, as
+	// they are likely still being written. They are scanned once old enough.
+	MinFileAgeS int `protobuf:"varint,82,opt,name=min_file_age_s,json=minFileAgeS,proto3,casttype=int" json:"minFileAgeS" xml:"minFileAgeS"`
+	// Hours to keep temporary files for, overriding the global
+	// keep_temporaries_h unless zero.
+	KeepTemporariesH int `protobuf:"varint,83,opt,name=keep_temporaries_h,json=keepTemporariesH,proto3,casttype=int" json:"keepTemporariesH" xml:"keepTemporariesH"`
+	// Adapt the pause before retrying a failed pull to the rate it
+	// achieved relative to the receive rate limit, if there is one.
+	AdaptivePullPause bool `protobuf:"varint,84,opt,name=adaptive_pull_pause,json=adaptivePullPause,proto3" json:"adaptivePullPause" xml:"adaptivePullPause"`
+	// Local time ranges like "22:00-06:00" outside of which scans triggered
+	// by the rescan interval are deferred. Empty means scans are allowed
+	// at any time.
+	ScanWindows []string `protobuf:"bytes,85,rep,name=scan_windows,json=scanWindows,proto3" json:"scanWindows" xml:"scanWindow,omitempty"`
+	// Also defer scans triggered by the filesystem watcher outside the
+	// scan windows, to the full scan when the next window starts.
+	ScanWindowsDeferWatcher bool `protobuf:"varint,86,opt,name=scan_windows_defer_watcher,json=scanWindowsDeferWatcher,proto3" json:"scanWindowsDeferWatcher" xml:"scanWindowsDeferWatcher"`
+	// Name of conflict copies, appended to the original name without its
+	// extension. {date}, {time} and {ext} are required, {device} is the
+	// short ID of the device that made the conflicting change.
+	ConflictNameTemplate string `protobuf:"bytes,87,opt,name=conflict_name_template,json=conflictNameTemplate,proto3" json:"conflictNameTemplate" xml:"conflictNameTemplate" default:".sync-conflict-{date}-{time}-{device}{ext}"`
+	// Treat hidden items like ignored ones: items starting with a dot and,
+	// on Windows, items with the hidden attribute.
+	IgnoreHidden bool `protobuf:"varint,88,opt,name=ignore_hidden,json=ignoreHidden,proto3" json:"ignoreHidden" xml:"ignoreHidden"`
+	// Skip directories on another filesystem than the folder root, i.e.
+	// don't scan across mount points.
+	OneFilesystem bool `protobuf:"varint,89,opt,name=one_filesystem,json=oneFilesystem,proto3" json:"oneFilesystem" xml:"oneFilesystem"`
+	// Stop timer scans after this many minutes, keeping what was scanned
+	// and resuming with the next one. 0 means unlimited.
+	MaxScanDurationM int `protobuf:"varint,90,opt,name=max_scan_duration_m,json=maxScanDurationM,proto3,casttype=int" json:"maxScanDurationM" xml:"maxScanDurationM"`
 	// Legacy deprecated
 	DeprecatedReadOnly       bool    `protobuf:"varint,9000,opt,name=read_only,json=readOnly,proto3" json:"-" xml:"ro,attr,omitempty"`                       // Deprecated: Do not use.
 	DeprecatedMinDiskFreePct float64 `protobuf:"fixed64,9001,opt,name=min_disk_free_pct,json=minDiskFreePct,proto3" json:"-" xml:"minDiskFreePct,omitempty"` // Deprecated: Do not use.
@@ -149,135 +306,271 @@ func init() {
 }
 
 var fileDescriptor_44a9785876ed3afa = []byte{
-	// 2043 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6f, 0x24, 0x47,
-	0x15, 0x77, 0x7b, 0xbf, 0xec, 0xf2, 0x77, 0x79, 0xbd, 0xdb, 0xf1, 0x26, 0x53, 0x93, 0x66, 0x36,
-	0x38, 0x51, 0xe2, 0xdd, 0x75, 0x10, 0x12, 0x2b, 0x16, 0xc8, 0xd8, 0xb1, 0x58, 0x16, 0x67, 0x47,
-	0xed, 0x85, 0x15, 0x01, 0xa9, 0xe9, 0xe9, 0xae, 0x99, 0xa9, 0xb8, 0xbf, 0xa8, 0xea, 0x59, 0x7b,
-	0xf6, 0x10, 0x2d, 0x17, 0x04, 0x22, 0x07, 0x64, 0x0e, 0xdc, 0x50, 0x24, 0x10, 0x82, 0xfc, 0x03,
-	0x48, 0xfc, 0x05, 0x7b, 0x41, 0x9e, 0x13, 0x42, 0x1c, 0x4a, 0x8a, 0xf7, 0x36, 0xc7, 0x3e, 0xfa,
-	0x84, 0xaa, 0xaa, 0xbb, 0xa7, 0xbb, 0x67, 0x22, 0x21, 0x71, 0x9b, 0xfa, 0xfd, 0x5e, 0xbd, 0xf7,
-	0xeb, 0x57, 0xaf, 0x5e, 0xbf, 0x1e, 0xd0, 0xf0, 0x48, 0xfb, 0x8e, 0x13, 0x06, 0x1d, 0xd2, 0xbd,
-	0xd3, 0x09, 0x3d, 0x17, 0x53, 0xb5, 0xe8, 0x53, 0x3b, 0x26, 0x61, 0xb0, 0x1d, 0xd1, 0x30, 0x0e,
-	0xe1, 0x55, 0x05, 0x6e, 0xde, 0x9a, 0xb0, 0x8e, 0x07, 0x11, 0x56, 0x46, 0x9b, 0x1b, 0x05, 0x92,
-	0x91, 0xe7, 0x19, 0xbc, 0x59, 0x80, 0xa3, 0xbe, 0xe7, 0x85, 0xd4, 0xc5, 0x34, 0xe5, 0xb6, 0x0a,
-	0xdc, 0x33, 0x4c, 0x19, 0x09, 0x03, 0x12, 0x74, 0xa7, 0x28, 0xd8, 0x44, 0x05, 0xcb, 0xb6, 0x17,
-	0x3a, 0x47, 0x55, 0x57, 0x50, 0x18, 0x74, 0xd8, 0x1d, 0x21, 0x88, 0xa5, 0xd8, 0xeb, 0x29, 0xe6,
-	0x84, 0xd1, 0x80, 0xda, 0x41, 0x17, 0xfb, 0x38, 0xee, 0x85, 0x6e, 0xca, 0xce, 0xe3, 0x93, 0x58,
-	0xfd, 0x34, 0xfe, 0x75, 0x09, 0xbc, 0xb6, 0x2f, 0x9f, 0x67, 0x0f, 0x3f, 0x23, 0x0e, 0xde, 0x2d,
-	0x2a, 0x80, 0x5f, 0x68, 0x60, 0xde, 0x95, 0xb8, 0x45, 0x5c, 0x5d, 0xab, 0x6b, 0x5b, 0x8b, 0xcd,
-	0xcf, 0xb4, 0x97, 0x1c, 0xcd, 0xfc, 0x87, 0xa3, 0x6f, 0x74, 0x49, 0xdc, 0xeb, 0xb7, 0xb7, 0x9d,
-	0xd0, 0xbf, 0xc3, 0x06, 0x81, 0x13, 0xf7, 0x48, 0xd0, 0x2d, 0xfc, 0x12, 0x12, 0x64, 0x10, 0x27,
-	0xf4, 0xb6, 0x95, 0xf7, 0x87, 0x7b, 0xe7, 0x1c, 0xcd, 0x65, 0xbf, 0x47, 0x1c, 0xcd, 0xb9, 0xe9,
-	0xef, 0x84, 0xa3, 0xa5, 0x13, 0xdf, 0xbb, 0x6f, 0x10, 0xf7, 0x5d, 0x3b, 0x8e, 0xa9, 0x31, 0x3a,
-	0x6b, 0x5c, 0x4b, 0x7f, 0x27, 0x67, 0x8d, 0xdc, 0xee, 0xd7, 0xc3, 0x86, 0x76, 0x3a, 0x6c, 0xe4,
-	0x3e, 0xcc, 0x8c, 0x71, 0xe1, 0x5f, 0x34, 0xb0, 0x44, 0x82, 0x98, 0x86, 0x6e, 0xdf, 0xc1, 0xae,
-	0xd5, 0x1e, 0xe8, 0xb3, 0x52, 0xf0, 0x8b, 0xff, 0x4b, 0xf0, 0x88, 0xa3, 0xc5, 0xb1, 0xd7, 0xe6,
-	0x20, 0xe1, 0xe8, 0xa6, 0x12, 0x5a, 0x00, 0x73, 0xc9, 0x6b, 0x13, 0xa8, 0x10, 0x6c, 0x96, 0x3c,
-	0x40, 0x07, 0xac, 0xe3, 0xc0, 0xa1, 0x83, 0x48, 0xe4, 0xd8, 0x8a, 0x6c, 0xc6, 0x8e, 0x43, 0xea,
-	0xea, 0x97, 0xea, 0xda, 0xd6, 0x7c, 0x73, 0x67, 0xc4, 0x11, 0x1c, 0xd3, 0xad, 0x94, 0x4d, 0x38,
-	0xd2, 0x65, 0xd8, 0x49, 0xca, 0x30, 0xa7, 0xd8, 0x1b, 0x7f, 0xac, 0x83, 0x75, 0x75, 0xb0, 0xe5,
-	0x23, 0x3d, 0x04, 0xb3, 0xe9, 0x51, 0xce, 0x37, 0x77, 0xcf, 0x39, 0x9a, 0x95, 0x8f, 0x38, 0x4b,
-	0x44, 0x84, 0x5a, 0xe9, 0x04, 0xea, 0x41, 0xe8, 0xe2, 0x8e, 0xdd, 0xf7, 0xe2, 0xfb, 0x46, 0x4c,
-	0xfb, 0xb8, 0x78, 0x24, 0xa7, 0xc3, 0xc6, 0xec, 0xc3, 0xbd, 0xcf, 0xc5, 0xb3, 0xcd, 0x12, 0x17,
-	0xfe, 0x08, 0x5c, 0xf1, 0xec, 0x36, 0xf6, 0x64, 0xc6, 0xe7, 0x9b, 0xdf, 0x1d, 0x71, 0xa4, 0x80,
-	0x84, 0xa3, 0xba, 0x74, 0x2a, 0x57, 0xa9, 0x5f, 0x8a, 0x59, 0x6c, 0xd3, 0xf8, 0xbe, 0xd1, 0xb1,
-	0x3d, 0x26, 0xdd, 0x82, 0x31, 0xfd, 0x62, 0xd8, 0x98, 0x31, 0xd5, 0x66, 0xd8, 0x05, 0x2b, 0x1d,
-	0xe2, 0x61, 0x36, 0x60, 0x31, 0xf6, 0x2d, 0x51, 0xdf, 0x32, 0x49, 0xcb, 0x3b, 0x70, 0xbb, 0xc3,
-	0xb6, 0xf7, 0x73, 0xea, 0xc9, 0x20, 0xc2, 0xcd, 0x77, 0x46, 0x1c, 0x2d, 0x77, 0x4a, 0x58, 0xc2,
-	0xd1, 0x75, 0x19, 0xbd, 0x0c, 0x1b, 0x66, 0xc5, 0x0e, 0x1e, 0x80, 0xcb, 0x91, 0x1d, 0xf7, 0xf4,
-	0xcb, 0x52, 0xfe, 0xb7, 0x46, 0x1c, 0xc9, 0x75, 0xc2, 0xd1, 0x2d, 0xb9, 0x5f, 0x2c, 0x52, 0xf1,
-	0x79, 0x4a, 0x3e, 0x15, 0xc2, 0xe7, 0x73, 0xe6, 0xe2, 0xac, 0xa1, 0x7d, 0x6a, 0xca, 0x6d, 0xb0,
-	0x05, 0x2e, 0x4b, 0xb1, 0x57, 0x52, 0xb1, 0xea, 0xf6, 0x6e, 0xab, 0xe3, 0x90, 0x62, 0xb7, 0x44,
-	0x88, 0x58, 0x49, 0x5c, 0x91, 0x21, 0xc4, 0x22, 0x2f, 0xa3, 0xf9, 0x7c, 0x65, 0x4a, 0x2b, 0xf8,
-	0x33, 0x70, 0x4d, 0xd5, 0x39, 0xd3, 0xaf, 0xd6, 0x2f, 0x6d, 0x2d, 0xec, 0xbc, 0x59, 0x76, 0x3a,
-	0xe5, 0xf2, 0x36, 0x91, 0x28, 0xfb, 0x11, 0x47, 0xd9, 0xce, 0x84, 0xa3, 0x45, 0x19, 0x4a, 0xad,
-	0x0d, 0x33, 0x23, 0xe0, 0xef, 0x35, 0xb0, 0x46, 0x31, 0x73, 0xec, 0xc0, 0x22, 0x41, 0x8c, 0xe9,
-	0x33, 0xdb, 0xb3, 0x98, 0x7e, 0xad, 0xae, 0x6d, 0x5d, 0x69, 0x76, 0x47, 0x1c, 0xad, 0x28, 0xf2,
-	0x61, 0xca, 0x1d, 0x26, 0x1c, 0xbd, 0x2d, 0x3d, 0x55, 0xf0, 0x6a, 0x8a, 0xde, 0xff, 0xe6, 0xdd,
-	0xbb, 0xc6, 0x05, 0x47, 0x97, 0x48, 0x10, 0x8f, 0xce, 0x1a, 0xd7, 0xa7, 0x99, 0x5f, 0x9c, 0x35,
-	0x2e, 0x0b, 0x3b, 0xb3, 0x1a, 0x04, 0xfe, 0x43, 0x03, 0xb0, 0xc3, 0xac, 0x63, 0x3b, 0x76, 0x7a,
-	0x98, 0x5a, 0x38, 0xb0, 0xdb, 0x1e, 0x76, 0xf5, 0xb9, 0xba, 0xb6, 0x35, 0xd7, 0xfc, 0xad, 0x76,
-	0xce, 0xd1, 0xea, 0xfe, 0xe1, 0x53, 0xc5, 0x7e, 0xa8, 0xc8, 0x11, 0x47, 0xab, 0x1d, 0x56, 0xc6,
-	0x12, 0x8e, 0xde, 0x51, 0x45, 0x50, 0x21, 0xaa, 0x6a, 0xb3, 0x1a, 0xdf, 0x98, 0x6a, 0x28, 0x74,
-	0x0a, 0x8b, 0xd3, 0x61, 0x63, 0x22, 0xac, 0x39, 0x11, 0x14, 0xfe, 0xbd, 0x2c, 0xde, 0xc5, 0x9e,
-	0x3d, 0xb0, 0x98, 0x3e, 0x2f, 0x73, 0xfa, 0x1b, 0x21, 0x7e, 0x25, 0xf7, 0xb2, 0x27, 0xc8, 0x43,
-	0x91, 0xe7, 0xdc, 0x8d, 0x82, 0x12, 0x8e, 0xbe, 0x5e, 0x96, 0xae, 0xf0, 0xaa, 0xf2, 0x7b, 0xa5,
-	0x2c, 0x4f, 0x33, 0xbe, 0x38, 0x6b, 0xcc, 0xde, 0xbb, 0x7b, 0x3a, 0x6c, 0x54, 0xa3, 0x9a, 0xd5,
-	0x98, 0xf0, 0xe7, 0x60, 0x91, 0x74, 0x83, 0x90, 0x62, 0x2b, 0xc2, 0xd4, 0x67, 0x3a, 0x90, 0xf9,
-	0x7e, 0x30, 0xe2, 0x68, 0x41, 0xe1, 0x2d, 0x01, 0x27, 0x1c, 0xdd, 0x50, 0xdd, 0x62, 0x8c, 0xe5,
-	0xe5, 0xbb, 0x5a, 0x05, 0xcd, 0xe2, 0x56, 0xf8, 0x4b, 0x0d, 0x2c, 0xdb, 0xfd, 0x38, 0xb4, 0x82,
-	0x90, 0xfa, 0xb6, 0x47, 0x9e, 0x63, 0x7d, 0x41, 0x06, 0xf9, 0x78, 0xc4, 0xd1, 0x92, 0x60, 0x3e,
-	0xca, 0x88, 0x3c, 0x03, 0x25, 0xf4, 0xab, 0x4e, 0x0e, 0x4e, 0x5a, 0x65, 0xc7, 0x66, 0x96, 0xfd,
-	0xc2, 0x10, 0x2c, 0xf9, 0x24, 0xb0, 0x5c, 0xc2, 0x8e, 0xac, 0x0e, 0xc5, 0x58, 0x5f, 0xac, 0x6b,
-	0x5b, 0x0b, 0x3b, 0x8b, 0xd9, 0xb5, 0x3a, 0x24, 0xcf, 0x71, 0xf3, 0x41, 0x7a, 0x83, 0x16, 0x7c,
-	0x12, 0xec, 0x11, 0x76, 0xb4, 0x4f, 0xb1, 0x50, 0x84, 0xa4, 0xa2, 0x02, 0x56, 0x3c, 0x8a, 0xfa,
-	0x6d, 0xe3, 0xe2, 0xac, 0x71, 0xe9, 0x5e, 0xfd, 0xb6, 0x59, 0xdc, 0x06, 0xbb, 0x00, 0x8c, 0xdf,
-	0xf3, 0xfa, 0x92, 0x8c, 0x86, 0xb2, 0x68, 0x3f, 0xce, 0x99, 0xf2, 0x15, 0x7e, 0x2b, 0x15, 0x50,
-	0xd8, 0x9a, 0x70, 0xb4, 0x2a, 0xe3, 0x8f, 0x21, 0xc3, 0x2c, 0xf0, 0xf0, 0x01, 0xb8, 0xe6, 0x84,
-	0x11, 0xc1, 0x94, 0xe9, 0xcb, 0xb2, 0xda, 0xbe, 0x26, 0x7a, 0x40, 0x0a, 0xe5, 0xaf, 0xd9, 0x74,
-	0x9d, 0xd5, 0x8d, 0x99, 0x19, 0xc0, 0x7f, 0x6a, 0xe0, 0x86, 0x98, 0x30, 0x30, 0xb5, 0x7c, 0xfb,
-	0xc4, 0x8a, 0x70, 0xe0, 0x92, 0xa0, 0x6b, 0x1d, 0x91, 0xb6, 0xbe, 0x22, 0xdd, 0xfd, 0x41, 0x14,
-	0xef, 0x7a, 0x4b, 0x9a, 0x1c, 0xd8, 0x27, 0x2d, 0x65, 0xf0, 0x88, 0x34, 0x47, 0x1c, 0xad, 0x47,
-	0x93, 0x70, 0xc2, 0xd1, 0x6b, 0xaa, 0x89, 0x4e, 0x72, 0x85, 0xb2, 0x9d, 0xba, 0x75, 0x3a, 0x7c,
-	0x3a, 0x6c, 0x4c, 0x8b, 0x6f, 0x4e, 0xb1, 0x6d, 0x8b, 0x74, 0xf4, 0x6c, 0xd6, 0x13, 0xe9, 0x58,
-	0x1d, 0xa7, 0x23, 0x85, 0xf2, 0x74, 0xa4, 0xeb, 0x71, 0x3a, 0x52, 0x00, 0x7e, 0x00, 0xae, 0xc8,
-	0x59, 0x4b, 0x5f, 0x93, 0xbd, 0x7c, 0x2d, 0x3b, 0x31, 0x11, 0xff, 0xb1, 0x20, 0x9a, 0xba, 0x78,
-	0xd9, 0x49, 0x9b, 0x84, 0xa3, 0x05, 0xe9, 0x4d, 0xae, 0x0c, 0x53, 0xa1, 0xf0, 0x11, 0x58, 0x4a,
-	0x2f, 0x94, 0x8b, 0x3d, 0x1c, 0x63, 0x1d, 0xca, 0x62, 0x7f, 0x4b, 0x4e, 0x16, 0x92, 0xd8, 0x93,
-	0x78, 0xc2, 0x11, 0x2c, 0x5c, 0x29, 0x05, 0x1a, 0x66, 0xc9, 0x06, 0x9e, 0x00, 0x5d, 0xf6, 0xe9,
-	0x88, 0x86, 0x5d, 0x8a, 0x19, 0x2b, 0x36, 0xec, 0x75, 0xf9, 0x7c, 0xe2, 0xe5, 0xbb, 0x21, 0x6c,
-	0x5a, 0xa9, 0x49, 0xb1, 0x6d, 0xab, 0xd7, 0xd9, 0x54, 0x36, 0x7f, 0xf6, 0xe9, 0x9b, 0xe1, 0x21,
-	0x58, 0x4e, 0xeb, 0x22, 0xb2, 0xfb, 0x0c, 0x5b, 0x4c, 0xbf, 0x2e, 0xe3, 0xbd, 0x27, 0x9e, 0x43,
-	0x31, 0x2d, 0x41, 0x1c, 0xe6, 0xcf, 0x51, 0x04, 0x73, 0xef, 0x25, 0x53, 0x88, 0xc1, 0x92, 0xa8,
-	0x32, 0x91, 0x54, 0x8f, 0x38, 0x31, 0xd3, 0x37, 0xa4, 0xcf, 0xef, 0x09, 0x9f, 0xbe, 0x7d, 0xb2,
-	0x9b, 0xe1, 0xe3, 0x5b, 0x57, 0x00, 0xa7, 0x76, 0x40, 0xd5, 0xe9, 0xcc, 0xd2, 0x6e, 0xe8, 0x82,
-	0xeb, 0x2e, 0x61, 0xa2, 0x33, 0x5b, 0x2c, 0xb2, 0x29, 0xc3, 0x96, 0x1c, 0x00, 0xf4, 0x1b, 0xf2,
-	0x24, 0xe4, 0xc8, 0x95, 0xf2, 0x87, 0x92, 0x96, 0xa3, 0x45, 0x3e, 0x72, 0x4d, 0x52, 0x86, 0x39,
-	0xc5, 0xbe, 0x18, 0x25, 0xc6, 0x7e, 0x64, 0x91, 0xc0, 0xc5, 0x27, 0x98, 0xe9, 0x37, 0x27, 0xa2,
-	0x3c, 0xc1, 0x7e, 0xf4, 0x50, 0xb1, 0xd5, 0x28, 0x05, 0x6a, 0x1c, 0xa5, 0x00, 0xc2, 0x1d, 0x70,
-	0x55, 0x1e, 0x80, 0xab, 0xeb, 0xd2, 0xef, 0xe6, 0x88, 0xa3, 0x14, 0xc9, 0xdf, 0xf0, 0x6a, 0x69,
-	0x98, 0x29, 0x0e, 0x63, 0x70, 0xf3, 0x18, 0xdb, 0x47, 0x96, 0xa8, 0x6a, 0x2b, 0xee, 0x51, 0xcc,
-	0x7a, 0xa1, 0xe7, 0x5a, 0x91, 0x13, 0xeb, 0xaf, 0xc9, 0x84, 0x8b, 0xf6, 0x7e, 0x5d, 0x98, 0x7c,
-	0xdf, 0x66, 0xbd, 0x27, 0x99, 0x41, 0xcb, 0x89, 0x13, 0x8e, 0x36, 0xa5, 0xcb, 0x69, 0x64, 0x7e,
-	0xa8, 0x53, 0xb7, 0xc2, 0x5d, 0xb0, 0xe0, 0xdb, 0xf4, 0x08, 0x53, 0x2b, 0xb0, 0x7d, 0xac, 0x6f,
-	0xca, 0xe1, 0xca, 0x10, 0xed, 0x4c, 0xc1, 0x1f, 0xd9, 0x3e, 0xce, 0xdb, 0xd9, 0x18, 0x32, 0xcc,
-	0x02, 0x0f, 0x07, 0x60, 0x53, 0x7c, 0xc4, 0x58, 0xe1, 0x71, 0x80, 0x29, 0xeb, 0x91, 0xc8, 0xea,
-	0xd0, 0xd0, 0xb7, 0x22, 0x9b, 0xe2, 0x20, 0xd6, 0x6f, 0xc9, 0x14, 0x7c, 0x7b, 0xc4, 0xd1, 0x4d,
-	0x61, 0xf5, 0x38, 0x33, 0xda, 0xa7, 0xa1, 0xdf, 0x92, 0x26, 0x09, 0x47, 0x6f, 0x64, 0x1d, 0x6f,
-	0x1a, 0x6f, 0x98, 0x5f, 0xb5, 0x13, 0xfe, 0x4a, 0x03, 0x6b, 0x7e, 0xe8, 0x5a, 0x31, 0xf1, 0xb1,
-	0x75, 0x4c, 0x02, 0x37, 0x3c, 0xb6, 0x98, 0xfe, 0xba, 0x4c, 0xd8, 0x4f, 0xcf, 0x39, 0x5a, 0x33,
-	0xed, 0xe3, 0x83, 0xd0, 0x7d, 0x42, 0x7c, 0xfc, 0x54, 0xb2, 0xe2, 0x1d, 0xbe, 0xec, 0x97, 0x90,
-	0x7c, 0x04, 0x2d, 0xc3, 0x59, 0xe6, 0x4e, 0x87, 0x8d, 0x49, 0x2f, 0x66, 0xc5, 0x07, 0x7c, 0xa1,
-	0x81, 0x8d, 0xf4, 0x9a, 0x38, 0x7d, 0x2a, 0xb4, 0x59, 0xc7, 0x94, 0xc4, 0x98, 0xe9, 0x6f, 0x48,
-	0x31, 0x3f, 0x14, 0xad, 0x57, 0x15, 0x7c, 0xca, 0x3f, 0x95, 0x74, 0xc2, 0xd1, 0xed, 0xc2, 0xad,
-	0x29, 0x71, 0x85, 0xcb, 0xb3, 0x53, 0xb8, 0x3b, 0xda, 0x8e, 0x39, 0xcd, 0x93, 0x68, 0x62, 0x59,
-	0x6d, 0x77, 0xc4, 0x17, 0x93, 0x5e, 0x1b, 0x37, 0xb1, 0x94, 0xd8, 0x17, 0x78, 0x7e, 0xf9, 0x8b,
-	0xa0, 0x61, 0x96, 0x6c, 0xa0, 0x07, 0x56, 0xe5, 0x97, 0xac, 0x25, 0x7a, 0x81, 0xa5, 0xfa, 0x2b,
-	0x92, 0xfd, 0xf5, 0x46, 0xd6, 0x5f, 0x9b, 0x82, 0x1f, 0x37, 0x59, 0x39, 0xdc, 0xb7, 0x4b, 0x58,
-	0x9e, 0xd9, 0x32, 0x6c, 0x98, 0x15, 0x3b, 0xf8, 0x99, 0x06, 0xd6, 0x64, 0x09, 0xc9, 0x0f, 0x61,
-	0x4b, 0x7d, 0x09, 0xeb, 0x75, 0x19, 0x6f, 0x5d, 0x7c, 0x48, 0xec, 0x86, 0xd1, 0xc0, 0x14, 0xdc,
-	0x81, 0xa4, 0x9a, 0x8f, 0xc4, 0x28, 0xe6, 0x94, 0xc1, 0x84, 0xa3, 0xad, 0xbc, 0x8c, 0x0a, 0x78,
-	0x21, 0x8d, 0x2c, 0xb6, 0x03, 0xd7, 0xa6, 0xae, 0x78, 0xff, 0xcf, 0x65, 0x0b, 0xb3, 0xea, 0x08,
-	0xfe, 0x59, 0xc8, 0xb1, 0x45, 0x03, 0xc5, 0x01, 0x23, 0x31, 0x79, 0x26, 0x32, 0xaa, 0xbf, 0x29,
-	0xd3, 0x79, 0x22, 0xe6, 0xc2, 0x5d, 0x9b, 0xe1, 0xc3, 0x8c, 0xdb, 0x97, 0x73, 0xa1, 0x53, 0x86,
-	0x12, 0x8e, 0x36, 0x94, 0x98, 0x32, 0x2e, 0x66, 0xa0, 0x09, 0xdb, 0x49, 0x48, 0x8c, 0x81, 0x95,
-	0x20, 0x66, 0xc5, 0x86, 0xc1, 0x3f, 0x69, 0x60, 0xb5, 0x13, 0x7a, 0x5e, 0x78, 0x6c, 0x7d, 0xd2,
-	0x0f, 0x1c, 0x31, 0x8e, 0x30, 0xdd, 0x18, 0xab, 0xfc, 0x41, 0x06, 0x7e, 0xc0, 0xf6, 0x08, 0x65,
-	0x42, 0xe5, 0x27, 0x65, 0x28, 0x57, 0x59, 0xc1, 0xa5, 0xca, 0xaa, 0xed, 0x24, 0x24, 0x54, 0x56,
-	0x82, 0x98, 0x2b, 0x4a, 0x51, 0x0e, 0xc3, 0x23, 0x30, 0x4f, 0xb1, 0xed, 0x5a, 0x61, 0xe0, 0x0d,
-	0xf4, 0xbf, 0xee, 0x4b, 0x79, 0x07, 0xe7, 0x1c, 0xc1, 0x3d, 0x1c, 0x51, 0xec, 0xd8, 0x31, 0x76,
-	0x4d, 0x6c, 0xbb, 0x8f, 0x03, 0x6f, 0x30, 0xe2, 0x48, 0x7b, 0x2f, 0xff, 0x7a, 0xa7, 0xa1, 0x1c,
-	0x0f, 0xdf, 0x0d, 0x7d, 0x22, 0x7a, 0x75, 0x3c, 0x90, 0x5f, 0xef, 0x13, 0xa8, 0xae, 0x99, 0x73,
-	0x34, 0x75, 0x00, 0x7f, 0x01, 0xd6, 0x4a, 0x33, 0xa3, 0xec, 0x9f, 0x7f, 0x13, 0x41, 0xb5, 0xe6,
-	0x87, 0xe7, 0x1c, 0xe9, 0xe3, 0xa0, 0x07, 0xe3, 0xc9, 0xaf, 0xe5, 0xc4, 0x59, 0xe8, 0x5a, 0x75,
-	0x70, 0x6c, 0x39, 0x71, 0x41, 0x81, 0xae, 0x99, 0xcb, 0x65, 0x12, 0xfe, 0x04, 0x5c, 0x53, 0xef,
-	0x4b, 0xa6, 0x7f, 0xb1, 0x2f, 0xef, 0xfa, 0x77, 0x44, 0xe3, 0x19, 0x07, 0x52, 0x73, 0x10, 0x2b,
-	0x3f, 0x5c, 0xba, 0xa5, 0xe0, 0x3a, 0xbd, 0xe0, 0xba, 0x66, 0x66, 0xfe, 0x9a, 0x8f, 0x5e, 0x7e,
-	0x59, 0x9b, 0x19, 0x7e, 0x59, 0x9b, 0x79, 0x79, 0x5e, 0xd3, 0x86, 0xe7, 0x35, 0xed, 0x77, 0xaf,
-	0x6a, 0x33, 0x9f, 0xbf, 0xaa, 0x69, 0xc3, 0x57, 0xb5, 0x99, 0x7f, 0xbf, 0xaa, 0xcd, 0x7c, 0xfc,
-	0xf6, 0xff, 0xf0, 0x7f, 0x89, 0xba, 0xae, 0xed, 0xab, 0xf2, 0x7f, 0x93, 0xf7, 0xff, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0x3e, 0xb6, 0x85, 0xe6, 0x55, 0x13, 0x00, 0x00,
+	// 4217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0x4b, 0x6c, 0x1c, 0x47,
+	0x7a, 0x56, 0xeb, 0xcd, 0x92, 0x44, 0x89, 0x25, 0x91, 0x2a, 0xd1, 0x32, 0x9b, 0xee, 0xa5, 0x65,
+	0xda, 0xab, 0xb7, 0x65, 0xc5, 0x92, 0xd7, 0xbb, 0xab, 0x21, 0x45, 0x5b, 0x2b, 0x53, 0xe2, 0x16,
+	0x69, 0x69, 0xd7, 0x6b, 0xa0, 0xb7, 0xd9, 0x5d, 0xc3, 0xe9, 0x65, 0x4f, 0xf7, 0xb8, 0xbb, 0x87,
+	0xe4, 0xc8, 0xb0, 0xe1, 0x24, 0xc8, 0x63, 0xb1, 0x1b, 0x24, 0x50, 0x10, 0x04, 0xb9, 0x2d, 0x90,
+	0x20, 0x8f, 0x45, 0xee, 0x01, 0x72, 0xc8, 0xd9, 0x97, 0x40, 0x3c, 0x05, 0x41, 0x0e, 0x8d, 0xac,
+	0x7c, 0x9b, 0xe3, 0x1c, 0x95, 0x4b, 0xf0, 0xff, 0xd5, 0x5d, 0xfd, 0xa4, 0x6d, 0x60, 0x4f, 0xe4,
+	0xfc, 0xdf, 0x57, 0x7f, 0xfd, 0x5d, 0x5d, 0xf5, 0xbf, 0xaa, 0xc9, 0x9c, 0xe7, 0xae, 0x5f, 0xb1,
+	0x03, 0xbf, 0xed, 0x6e, 0x5c, 0x69, 0x07, 0x9e, 0x23, 0x42, 0xf9, 0xa3, 0x1f, 0x5a, 0xb1, 0x1b,
+	0xf8, 0x97, 0x7b, 0x61, 0x10, 0x07, 0xf4, 0xb0, 0x14, 0x4e, 0xbf, 0x54, 0x63, 0xc7, 0x83, 0x9e,
+	0x90, 0xa4, 0xe9, 0xc9, 0x02, 0x18, 0xb9, 0x4f, 0x32, 0xf1, 0x74, 0x41, 0xdc, 0xeb, 0x7b, 0x5e,
+	0x10, 0x3a, 0x22, 0x4c, 0xb1, 0xf9, 0x02, 0xb6, 0x25, 0xc2, 0xc8, 0x0d, 0x7c, 0xd7, 0xdf, 0x68,
+	0xb0, 0x60, 0x5a, 0x2f, 0x30, 0xd7, 0xbd, 0xc0, 0xde, 0xac, 0xaa, 0xba, 0x50, 0x20, 0xd8, 0x9d,
+	0x30, 0xf0, 0x5d, 0x1b, 0x7e, 0x79, 0xae, 0x1d, 0x5b, 0x76, 0x41, 0xd1, 0x4c, 0xd1, 0xca, 0x41,
+	0xd7, 0x73, 0xfd, 0xcd, 0x5e, 0xe0, 0xb9, 0xf6, 0x20, 0xc5, 0x5f, 0x29, 0xe0, 0xdb, 0x56, 0x6c,
+	0x77, 0x44, 0x18, 0x06, 0x61, 0x89, 0x52, 0xb4, 0x25, 0x0a, 0xfa, 0xa1, 0x2d, 0xda, 0x96, 0xe7,
+	0xad, 0x5b, 0xf6, 0x66, 0x4a, 0x28, 0x2e, 0x6a, 0x28, 0x7c, 0xab, 0x2b, 0x1c, 0x11, 0x0b, 0xb4,
+	0xa2, 0x1b, 0x38, 0xd9, 0xc2, 0x50, 0x60, 0xb5, 0xa3, 0x2b, 0xb0, 0x84, 0x51, 0x2a, 0x3b, 0x9f,
+	0xca, 0xec, 0xa0, 0x37, 0x08, 0x2d, 0x7f, 0x43, 0x74, 0x45, 0xdc, 0x09, 0x9c, 0x14, 0x1d, 0x13,
+	0x3b, 0xb1, 0xfc, 0xd7, 0xf8, 0xaf, 0x83, 0xe4, 0xdc, 0x12, 0xbe, 0x81, 0x45, 0xb1, 0xe5, 0xda,
+	0x62, 0xa1, 0xb8, 0x66, 0xf4, 0xb7, 0x1a, 0x19, 0x73, 0x50, 0x6e, 0xba, 0x0e, 0xd3, 0x66, 0xb5,
+	0xf9, 0xe3, 0xad, 0x5f, 0x6b, 0x5f, 0x26, 0xfa, 0xbe, 0xff, 0x49, 0xf4, 0x1b, 0x1b, 0x6e, 0xdc,
+	0xe9, 0xaf, 0x5f, 0xb6, 0x83, 0xee, 0x95, 0x68, 0xe0, 0xdb, 0x71, 0xc7, 0xf5, 0x37, 0x0a, 0xff,
+	0x81, 0x09, 0x38, 0x89, 0x1d, 0x78, 0x97, 0xa5, 0xf6, 0x7b, 0x8b, 0xcf, 0x13, 0xfd, 0x68, 0xf6,
+	0xff, 0x30, 0xd1, 0x8f, 0x3a, 0xe9, 0xff, 0xa3, 0x44, 0x3f, 0xb1, 0xd3, 0xf5, 0x6e, 0x1b, 0xae,
+	0x73, 0xd1, 0x8a, 0xe3, 0xd0, 0x18, 0x3e, 0x9b, 0x3b, 0x92, 0xfe, 0x3f, 0x7a, 0x36, 0xa7, 0x78,
+	0x7f, 0xbe, 0x3b, 0xa7, 0x3d, 0xdd, 0x9d, 0x53, 0x3a, 0x78, 0x86, 0x38, 0xf4, 0x1f, 0x35, 0x72,
+	0xc2, 0xf5, 0xe3, 0x30, 0x70, 0xfa, 0xb6, 0x70, 0xcc, 0xf5, 0x01, 0xdb, 0x8f, 0x06, 0x7f, 0xf1,
+	0x7b, 0x19, 0x3c, 0x4c, 0xf4, 0xe3, 0xb9, 0xd6, 0xd6, 0x60, 0x94, 0xe8, 0x67, 0xa5, 0xa1, 0x05,
+	0xa1, 0x32, 0x79, 0xa2, 0x26, 0x05, 0x83, 0x79, 0x49, 0x03, 0xb5, 0xc9, 0x69, 0xe1, 0xdb, 0xe1,
+	0xa0, 0x07, 0x6b, 0x6c, 0xf6, 0xac, 0x28, 0xda, 0x0e, 0x42, 0x87, 0x1d, 0x98, 0xd5, 0xe6, 0xc7,
+	0x5a, 0xd7, 0x87, 0x89, 0x4e, 0x73, 0x78, 0x25, 0x45, 0x47, 0x89, 0xce, 0x70, 0xda, 0x3a, 0x64,
+	0xf0, 0x06, 0x3e, 0xfd, 0x9c, 0x8c, 0x5b, 0x9e, 0x17, 0x6c, 0x0b, 0xc7, 0x94, 0x7b, 0x8b, 0x1d,
+	0x9c, 0xd5, 0xe6, 0x8f, 0xb6, 0x1e, 0x0f, 0x13, 0xfd, 0x44, 0x8a, 0xac, 0x22, 0x30, 0x4a, 0x74,
+	0x03, 0x55, 0x97, 0xa4, 0x68, 0xfc, 0xc5, 0xa0, 0xeb, 0xc6, 0xa2, 0xdb, 0x8b, 0x07, 0xf0, 0x70,
+	0xe7, 0xbf, 0x8e, 0xc0, 0xcb, 0x4a, 0x8d, 0x67, 0x6b, 0xe4, 0xb4, 0xdc, 0x58, 0xe5, 0x2d, 0xb5,
+	0x4a, 0xf6, 0xa7, 0x5b, 0x69, 0xac, 0xb5, 0xf0, 0x3c, 0xd1, 0xf7, 0xe3, 0x12, 0xef, 0x77, 0xe1,
+	0x09, 0x67, 0x4a, 0x3b, 0x60, 0xd6, 0x0f, 0x1c, 0xd1, 0xb6, 0xfa, 0x5e, 0x7c, 0xdb, 0x88, 0xc3,
+	0xbe, 0x28, 0x6e, 0x89, 0xa7, 0xbb, 0x73, 0xfb, 0xef, 0x2d, 0xfe, 0x06, 0xd6, 0x76, 0xbf, 0xeb,
+	0xd0, 0x0f, 0xc9, 0x21, 0xcf, 0x5a, 0x17, 0x1e, 0xbe, 0xf1, 0xb1, 0xd6, 0x0f, 0x86, 0x89, 0x2e,
+	0x05, 0xa3, 0x44, 0x9f, 0x45, 0xa5, 0xf8, 0x2b, 0xd5, 0x1b, 0x8a, 0x28, 0xb6, 0xc2, 0xf8, 0xb6,
+	0xd1, 0xb6, 0xbc, 0x08, 0xd5, 0x92, 0x1c, 0xfe, 0x62, 0x77, 0x6e, 0x1f, 0x97, 0x83, 0xe9, 0x06,
+	0x39, 0xd9, 0x76, 0x3d, 0x11, 0x0d, 0xa2, 0x58, 0x74, 0x4d, 0x38, 0x5f, 0xf8, 0x92, 0xc6, 0xaf,
+	0xd3, 0xcb, 0xed, 0xe8, 0xf2, 0x92, 0x82, 0xd6, 0x06, 0x3d, 0xd1, 0x7a, 0x63, 0x98, 0xe8, 0xe3,
+	0xed, 0x92, 0x6c, 0x94, 0xe8, 0x67, 0x70, 0xf6, 0xb2, 0xd8, 0xe0, 0x15, 0x1e, 0x5d, 0x26, 0x07,
+	0x7b, 0x56, 0xdc, 0xc1, 0x57, 0x34, 0xd6, 0xba, 0x35, 0x4c, 0x74, 0xfc, 0x3d, 0x4a, 0xf4, 0x97,
+	0x70, 0x3c, 0xfc, 0x48, 0x8d, 0x57, 0x4b, 0xf2, 0x39, 0x18, 0x3e, 0xa6, 0x90, 0x17, 0xcf, 0xe6,
+	0xb4, 0xcf, 0x39, 0x0e, 0xa3, 0x2b, 0xe4, 0x20, 0x1a, 0x7b, 0x28, 0x35, 0x56, 0xba, 0x90, 0xcb,
+	0xf2, 0x75, 0xa0, 0xb1, 0xf3, 0x30, 0x45, 0x2c, 0x4d, 0x3c, 0x89, 0x53, 0xc0, 0x0f, 0xb5, 0x8d,
+	0xc7, 0xd4, 0x2f, 0x8e, 0x2c, 0xfa, 0x31, 0x39, 0x22, 0xcf, 0x59, 0xc4, 0x0e, 0xcf, 0x1e, 0x98,
+	0x3f, 0x76, 0xfd, 0x95, 0xb2, 0xd2, 0x06, 0xe7, 0xd1, 0xd2, 0xe1, 0xd8, 0x0d, 0x13, 0x3d, 0x1b,
+	0x39, 0x4a, 0xf4, 0xe3, 0x38, 0x95, 0xfc, 0x6d, 0xf0, 0x0c, 0xa0, 0x7f, 0xad, 0x91, 0x89, 0x50,
+	0x44, 0xb6, 0xe5, 0x9b, 0xae, 0x1f, 0x8b, 0x70, 0xcb, 0xf2, 0xcc, 0x88, 0x1d, 0x99, 0xd5, 0xe6,
+	0x0f, 0xb5, 0x36, 0x86, 0x89, 0x7e, 0x52, 0x82, 0xf7, 0x52, 0x6c, 0x75, 0x94, 0xe8, 0xaf, 0xa3,
+	0xa6, 0x8a, 0xbc, 0xba, 0x44, 0x6f, 0xde, 0xbc, 0x7a, 0xd5, 0x78, 0x91, 0xe8, 0x07, 0x5c, 0x3f,
+	0x1e, 0x3e, 0x9b, 0x3b, 0xd3, 0x44, 0x7f, 0xf1, 0x6c, 0xee, 0x20, 0xf0, 0x78, 0x75, 0x12, 0xfa,
+	0xef, 0x1a, 0xa1, 0xed, 0xc8, 0x4c, 0x9d, 0xb7, 0x29, 0x7c, 0x6b, 0xdd, 0x13, 0x0e, 0x3b, 0x8a,
+	0xc7, 0xe8, 0x57, 0xda, 0xf3, 0x44, 0x3f, 0xb5, 0xb4, 0xfa, 0x58, 0xa2, 0x77, 0x25, 0x38, 0x4c,
+	0xf4, 0x53, 0xed, 0xa8, 0x2c, 0x1b, 0x25, 0xfa, 0x1b, 0x72, 0x13, 0x54, 0x80, 0xaa, 0xb5, 0xd9,
+	0x1e, 0x9f, 0x6c, 0x24, 0x82, 0x9d, 0xc0, 0x78, 0xba, 0x3b, 0x57, 0x9b, 0x96, 0xd7, 0x26, 0xa5,
+	0xff, 0x56, 0x36, 0xde, 0x11, 0x9e, 0x35, 0x30, 0x23, 0x36, 0x86, 0x6b, 0xfa, 0x4b, 0x30, 0xfe,
+	0xa4, 0xd2, 0xb2, 0x08, 0xe0, 0x2a, 0xac, 0x73, 0x3b, 0x2a, 0x89, 0x46, 0x89, 0xfe, 0x5a, 0xd9,
+	0x74, 0x29, 0xaf, 0x5a, 0x7e, 0xad, 0xb4, 0xca, 0x4d, 0xe4, 0x17, 0xcf, 0xe6, 0xf6, 0x5f, 0xbb,
+	0xfa, 0x74, 0x77, 0xae, 0x3a, 0x2b, 0xaf, 0xce, 0x49, 0x7f, 0x4e, 0x8e, 0xbb, 0x1b, 0x7e, 0x10,
+	0x0a, 0xb3, 0x27, 0xc2, 0x6e, 0xc4, 0x08, 0xae, 0xf7, 0xbb, 0xc3, 0x44, 0x3f, 0x26, 0xe5, 0x2b,
+	0x20, 0x1e, 0x25, 0xfa, 0x94, 0xf4, 0x16, 0xb9, 0x4c, 0x6d, 0xdf, 0x53, 0x55, 0x21, 0x2f, 0x0e,
+	0xa5, 0x7f, 0xa8, 0x91, 0x71, 0xab, 0x1f, 0x07, 0xa6, 0x1f, 0x84, 0x5d, 0xcb, 0x73, 0x9f, 0x08,
+	0x76, 0x0c, 0x27, 0xf9, 0x08, 0x7d, 0x63, 0x3f, 0x0e, 0x1e, 0x64, 0x80, 0x5a, 0x81, 0x92, 0x74,
+	0xaf, 0x37, 0x47, 0xeb, 0xac, 0xec, 0xb5, 0xf1, 0xb2, 0x5e, 0x1a, 0x90, 0x13, 0x5d, 0xd7, 0x37,
+	0x1d, 0x37, 0xda, 0x34, 0xdb, 0xa1, 0x10, 0xec, 0xf8, 0xac, 0x36, 0x7f, 0xec, 0xfa, 0xf1, 0xec,
+	0x58, 0xad, 0xba, 0x4f, 0x44, 0xeb, 0xdd, 0xf4, 0x04, 0x1d, 0xeb, 0xba, 0xfe, 0xa2, 0x1b, 0x6d,
+	0x2e, 0x85, 0x02, 0x2c, 0xd2, 0xd1, 0xa2, 0x82, 0xac, 0xf8, 0x2a, 0x66, 0x5f, 0x35, 0x5e, 0x3c,
+	0x9b, 0x3b, 0x70, 0x6d, 0xf6, 0x55, 0x5e, 0x1c, 0x46, 0x37, 0x08, 0xc9, 0x33, 0x23, 0x76, 0x02,
+	0x67, 0xd3, 0xb3, 0xd9, 0x1e, 0x29, 0xa4, 0x7c, 0x84, 0x2f, 0xa4, 0x06, 0x14, 0x86, 0x8e, 0x12,
+	0xfd, 0x14, 0xce, 0x9f, 0x8b, 0x0c, 0x5e, 0xc0, 0xe9, 0xbb, 0xe4, 0x88, 0x1d, 0xf4, 0x5c, 0x11,
+	0x46, 0x6c, 0x1c, 0x77, 0xdb, 0x77, 0xc0, 0x07, 0xa4, 0x22, 0x15, 0xe6, 0xd3, 0xdf, 0xd9, 0xbe,
+	0xe1, 0x19, 0x81, 0xfe, 0xa7, 0x46, 0xa6, 0x20, 0x27, 0x13, 0xa1, 0xd9, 0xb5, 0x76, 0xcc, 0x9e,
+	0xf0, 0x1d, 0xd7, 0xdf, 0x30, 0x37, 0xdd, 0x75, 0x76, 0x12, 0xd5, 0xfd, 0x2d, 0x6c, 0xde, 0xd3,
+	0x2b, 0x48, 0x59, 0xb6, 0x76, 0x56, 0x24, 0xe1, 0xbe, 0xdb, 0x1a, 0x26, 0xfa, 0xe9, 0x5e, 0x5d,
+	0x3c, 0x4a, 0xf4, 0x73, 0xd2, 0x89, 0xd6, 0xb1, 0xc2, 0xb6, 0x6d, 0x1c, 0xda, 0x2c, 0x7e, 0xba,
+	0x3b, 0xd7, 0x34, 0x3f, 0x6f, 0xe0, 0xae, 0xc3, 0x72, 0x74, 0xac, 0xa8, 0x03, 0xcb, 0x71, 0x2a,
+	0x5f, 0x8e, 0x54, 0xa4, 0x96, 0x23, 0xfd, 0x9d, 0x2f, 0x47, 0x2a, 0xa0, 0x77, 0xc8, 0x21, 0xcc,
+	0x4e, 0xd9, 0x04, 0xfa, 0xf2, 0x89, 0xec, 0x8d, 0xc1, 0xfc, 0x0f, 0x01, 0x68, 0x31, 0x08, 0x76,
+	0xc8, 0x19, 0x25, 0xfa, 0x31, 0xd4, 0x86, 0xbf, 0x0c, 0x2e, 0xa5, 0xf4, 0x3e, 0x39, 0x91, 0x1e,
+	0x28, 0x47, 0x78, 0x22, 0x16, 0x8c, 0xe2, 0x66, 0xbf, 0x80, 0x99, 0x0d, 0x02, 0x8b, 0x28, 0x1f,
+	0x25, 0x3a, 0x2d, 0x1c, 0x29, 0x29, 0x34, 0x78, 0x89, 0x43, 0x77, 0x08, 0x43, 0x3f, 0xdd, 0x0b,
+	0x83, 0x8d, 0x50, 0x44, 0x51, 0xd1, 0x61, 0x9f, 0xc6, 0xe7, 0x83, 0xe0, 0x3b, 0x09, 0x9c, 0x95,
+	0x94, 0x52, 0x74, 0xdb, 0x32, 0x9c, 0x35, 0xa2, 0xea, 0xd9, 0x9b, 0x07, 0xd3, 0x55, 0x32, 0x9e,
+	0xee, 0x8b, 0x9e, 0xd5, 0x8f, 0x84, 0x19, 0xb1, 0x33, 0x38, 0xdf, 0x25, 0x78, 0x0e, 0x89, 0xac,
+	0x00, 0xb0, 0xaa, 0x9e, 0xa3, 0x28, 0x54, 0xda, 0x4b, 0x54, 0x2a, 0xc8, 0x09, 0xd8, 0x65, 0x59,
+	0x86, 0x1f, 0xb1, 0x49, 0xd4, 0xf9, 0x43, 0xd0, 0xd9, 0xb5, 0x76, 0x16, 0x32, 0x79, 0x7e, 0xea,
+	0x0a, 0xc2, 0x46, 0x0f, 0x28, 0x3d, 0x1d, 0x2f, 0x8d, 0xa6, 0x0e, 0x39, 0xe3, 0xb8, 0x11, 0x78,
+	0x66, 0x33, 0xea, 0x59, 0x61, 0x24, 0x4c, 0x4c, 0x00, 0xd8, 0x14, 0xbe, 0x09, 0x4c, 0xf9, 0x52,
+	0x7c, 0x15, 0x61, 0x4c, 0x2d, 0x54, 0xca, 0x57, 0x87, 0x0c, 0xde, 0xc0, 0x2f, 0xce, 0x02, 0x39,
+	0x99, 0xe9, 0xfa, 0x8e, 0xd8, 0x11, 0x11, 0x3b, 0x5b, 0x9b, 0x65, 0x4d, 0x74, 0x7b, 0xf7, 0x24,
+	0x5a, 0x9d, 0xa5, 0x00, 0xe5, 0xb3, 0x14, 0x84, 0xf4, 0x3a, 0x39, 0x8c, 0x2f, 0xc0, 0x61, 0x0c,
+	0xf5, 0x4e, 0x0f, 0x13, 0x3d, 0x95, 0xa8, 0x08, 0x2f, 0x7f, 0x1a, 0x3c, 0x95, 0xd3, 0x98, 0x9c,
+	0xdd, 0x16, 0xd6, 0xa6, 0x09, 0xbb, 0xda, 0x8c, 0x3b, 0xa1, 0x88, 0x3a, 0x81, 0xe7, 0x98, 0x3d,
+	0x3b, 0x66, 0xe7, 0x70, 0xc1, 0xc1, 0xbd, 0x9f, 0x01, 0xca, 0xfb, 0x56, 0xd4, 0x59, 0xcb, 0x08,
+	0x2b, 0x76, 0x3c, 0x4a, 0xf4, 0x69, 0x54, 0xd9, 0x04, 0xaa, 0x97, 0xda, 0x38, 0x94, 0x2e, 0x90,
+	0x63, 0x5d, 0x2b, 0xdc, 0x14, 0xa1, 0x09, 0xa5, 0x13, 0x9b, 0xc6, 0xe4, 0xca, 0x00, 0x77, 0x26,
+	0xc5, 0x0f, 0xac, 0xae, 0x50, 0xee, 0x2c, 0x17, 0x19, 0xbc, 0x80, 0xd3, 0x01, 0x99, 0x86, 0x22,
+	0xca, 0x0c, 0xb6, 0x7d, 0x11, 0x46, 0x1d, 0xb7, 0x67, 0xb6, 0xc3, 0xa0, 0x6b, 0xf6, 0xac, 0x50,
+	0xf8, 0x31, 0x7b, 0x09, 0x97, 0xe0, 0x7b, 0xc3, 0x44, 0x3f, 0x0b, 0xac, 0x87, 0x19, 0x69, 0x29,
+	0x0c, 0xba, 0x2b, 0x48, 0x19, 0x25, 0xfa, 0xcb, 0x99, 0xc7, 0x6b, 0xc2, 0x0d, 0xbe, 0xd7, 0x48,
+	0xfa, 0xa7, 0x1a, 0x99, 0xe8, 0x06, 0x8e, 0x19, 0xbb, 0x5d, 0x61, 0x6e, 0xbb, 0xbe, 0x13, 0x6c,
+	0x9b, 0x11, 0x3b, 0x8f, 0x0b, 0xf6, 0xb3, 0xe7, 0x89, 0x3e, 0xc1, 0xad, 0xed, 0xe5, 0xc0, 0x59,
+	0x73, 0xbb, 0xe2, 0x31, 0xa2, 0x10, 0xc3, 0xc7, 0xbb, 0x25, 0x89, 0x4a, 0x41, 0xcb, 0xe2, 0x6c,
+	0xe5, 0x9e, 0xee, 0xce, 0xd5, 0xb5, 0xf0, 0x8a, 0x0e, 0xfa, 0x85, 0x46, 0x26, 0xd3, 0x63, 0x62,
+	0xf7, 0x43, 0xb0, 0xcd, 0xdc, 0x0e, 0xdd, 0x58, 0x44, 0xec, 0x65, 0x34, 0xe6, 0x03, 0x70, 0xbd,
+	0x72, 0xc3, 0xa7, 0xf8, 0x63, 0x84, 0x47, 0x89, 0xfe, 0x6a, 0xe1, 0xd4, 0x94, 0xb0, 0xc2, 0xe1,
+	0xb9, 0x5e, 0x38, 0x3b, 0xda, 0x75, 0xde, 0xa4, 0x09, 0x9c, 0x58, 0xb6, 0xb7, 0xdb, 0x50, 0xb1,
+	0xb1, 0x99, 0xdc, 0x89, 0xa5, 0xc0, 0x12, 0xc8, 0xd5, 0xe1, 0x2f, 0x0a, 0x0d, 0x5e, 0xe2, 0x50,
+	0x8f, 0x9c, 0xc2, 0xda, 0xdf, 0x04, 0x5f, 0x60, 0x4a, 0xff, 0xaa, 0xa3, 0x7f, 0x9d, 0xca, 0xfc,
+	0x6b, 0x0b, 0xf0, 0xdc, 0xc9, 0x62, 0x72, 0xbf, 0x5e, 0x92, 0xa9, 0x95, 0x2d, 0x8b, 0x0d, 0x5e,
+	0xe1, 0xd1, 0x5f, 0x6b, 0x64, 0x02, 0xb7, 0x10, 0x16, 0xe2, 0xa6, 0xac, 0xc4, 0xd9, 0x2c, 0xce,
+	0x77, 0x1a, 0x0a, 0x89, 0x85, 0xa0, 0x37, 0xe0, 0x80, 0x2d, 0x23, 0xd4, 0xba, 0x0f, 0xa9, 0x98,
+	0x5d, 0x16, 0x8e, 0x12, 0x7d, 0x5e, 0x6d, 0xa3, 0x82, 0xbc, 0xb0, 0x8c, 0x51, 0x6c, 0xf9, 0x8e,
+	0x15, 0x3a, 0x10, 0xff, 0x8f, 0x66, 0x3f, 0x78, 0x55, 0x11, 0xfd, 0x07, 0x30, 0xc7, 0x02, 0x07,
+	0x2a, 0xfc, 0xc8, 0x8d, 0xdd, 0x2d, 0x58, 0x51, 0xf6, 0x0a, 0x2e, 0xe7, 0x0e, 0xe4, 0x85, 0x0b,
+	0x56, 0x24, 0x56, 0x33, 0x6c, 0x09, 0xf3, 0x42, 0xbb, 0x2c, 0x1a, 0x25, 0xfa, 0xa4, 0x34, 0xa6,
+	0x2c, 0x87, 0x1c, 0xa8, 0xc6, 0xad, 0x8b, 0x20, 0x0d, 0xac, 0x4c, 0xc2, 0x2b, 0x9c, 0x88, 0xfe,
+	0xbd, 0x46, 0x4e, 0xb5, 0x03, 0x28, 0x29, 0xcd, 0x5f, 0xf4, 0x7d, 0xec, 0x79, 0x44, 0xcc, 0xc8,
+	0xad, 0xfc, 0x51, 0x26, 0xbc, 0x13, 0x2d, 0xba, 0x61, 0x04, 0x56, 0xfe, 0xa2, 0x2c, 0x52, 0x56,
+	0x56, 0xe4, 0x68, 0x65, 0x95, 0x5b, 0x17, 0x81, 0x95, 0x95, 0x49, 0xf8, 0x49, 0x69, 0x91, 0x12,
+	0xd3, 0xff, 0xd3, 0xc8, 0x74, 0x39, 0xcd, 0x16, 0xb1, 0x30, 0x37, 0x42, 0xcb, 0x16, 0x66, 0x37,
+	0x62, 0xdf, 0xc1, 0xe3, 0xf1, 0x1f, 0x90, 0xb1, 0x4c, 0x15, 0x13, 0x5f, 0x11, 0x8b, 0xf7, 0x80,
+	0xb3, 0x0c, 0x76, 0x4f, 0xb5, 0xa3, 0x26, 0xa4, 0x5e, 0x37, 0x94, 0xe0, 0xc2, 0x8b, 0x7f, 0xab,
+	0x54, 0xe5, 0xec, 0xa5, 0x6e, 0x4f, 0x04, 0xd2, 0xc5, 0xb7, 0xae, 0x42, 0x72, 0xbe, 0x87, 0x8d,
+	0x7c, 0x8f, 0x81, 0x74, 0x8d, 0x9c, 0xda, 0x12, 0xa1, 0xdb, 0x1e, 0x98, 0x99, 0x9b, 0x8a, 0xd8,
+	0x1c, 0xbe, 0x22, 0x3c, 0x2f, 0x12, 0x4b, 0x7d, 0x4b, 0xa4, 0xce, 0x4b, 0x59, 0x6c, 0xf0, 0x0a,
+	0x0f, 0x9a, 0x4e, 0xd3, 0x59, 0xeb, 0xc2, 0x0e, 0xfc, 0x18, 0xdc, 0x4d, 0xe4, 0x6e, 0xf8, 0x56,
+	0xdc, 0x0f, 0x45, 0xc4, 0x5e, 0x9d, 0x3d, 0x30, 0x3f, 0xd6, 0xf2, 0x86, 0x89, 0xce, 0x52, 0xd6,
+	0x82, 0x24, 0xad, 0x2a, 0x4e, 0x9e, 0xb5, 0x37, 0x13, 0xca, 0x6d, 0x8d, 0x57, 0xbe, 0x91, 0xc5,
+	0xf7, 0x9c, 0x89, 0x3a, 0x04, 0xdc, 0x95, 0x89, 0x39, 0x51, 0xd0, 0x13, 0x7e, 0x1a, 0xd8, 0x2f,
+	0xe0, 0x8b, 0x7f, 0x0b, 0xea, 0xc1, 0xae, 0xb5, 0xb3, 0x6a, 0x5b, 0xfe, 0xc3, 0x9e, 0xf0, 0xb3,
+	0xb0, 0x3e, 0x95, 0x39, 0xc5, 0x12, 0xa0, 0xa2, 0x59, 0x6d, 0x08, 0xfd, 0x63, 0x8d, 0x4c, 0xa7,
+	0xcd, 0x48, 0x95, 0xab, 0xe4, 0x71, 0x94, 0xbd, 0x86, 0xb3, 0xdd, 0x85, 0x25, 0x49, 0x59, 0x59,
+	0xea, 0xa1, 0xe2, 0xa1, 0xea, 0xae, 0xec, 0x45, 0x50, 0xb3, 0xef, 0xa9, 0x82, 0xfe, 0x8d, 0x46,
+	0xce, 0xd5, 0xac, 0x50, 0x71, 0x69, 0x1e, 0x8d, 0x80, 0x12, 0x6a, 0xaa, 0xa2, 0x21, 0x0f, 0x45,
+	0x17, 0x9b, 0x4c, 0x48, 0xe1, 0xc2, 0x86, 0x7e, 0xfb, 0xe6, 0x8d, 0xab, 0xc5, 0x84, 0xea, 0x10,
+	0x0a, 0xf8, 0x1e, 0x7a, 0xe9, 0x5f, 0x6a, 0xe4, 0x6c, 0xcd, 0x2e, 0xd9, 0xac, 0x65, 0xaf, 0xa3,
+	0x9b, 0x7d, 0x39, 0x73, 0xeb, 0x0b, 0x65, 0x0d, 0x77, 0x90, 0xd4, 0x7a, 0x1b, 0x52, 0x56, 0xbb,
+	0x09, 0x52, 0x29, 0x6b, 0x23, 0x6a, 0xf0, 0xe6, 0x51, 0xf4, 0xe7, 0xe4, 0x74, 0xb4, 0xe9, 0xf6,
+	0xcc, 0xbe, 0x6f, 0x77, 0xc0, 0xf5, 0x3a, 0xa6, 0xe3, 0x86, 0x11, 0x7b, 0x03, 0xcf, 0xc6, 0xd5,
+	0x61, 0xa2, 0x4f, 0x00, 0xfc, 0x61, 0x86, 0xa6, 0xde, 0x4a, 0xf6, 0x15, 0x6b, 0x88, 0xc1, 0xeb,
+	0x6c, 0x38, 0x7a, 0xe8, 0x74, 0x64, 0x05, 0x19, 0xf5, 0x2c, 0x5b, 0xb0, 0xef, 0xe6, 0x47, 0x0f,
+	0x31, 0xa8, 0xfd, 0x56, 0x01, 0x51, 0x47, 0xaf, 0x2c, 0x36, 0x78, 0x85, 0x07, 0x76, 0x63, 0x48,
+	0x44, 0x3f, 0x06, 0x0e, 0xce, 0x0c, 0x7c, 0x6f, 0xc0, 0x2e, 0xe6, 0x76, 0x03, 0xbc, 0x98, 0xa1,
+	0x0f, 0x7d, 0x2f, 0xef, 0x87, 0xd6, 0x10, 0x83, 0xd7, 0xd9, 0x50, 0x7b, 0x9f, 0xef, 0x05, 0x51,
+	0x2c, 0x43, 0xef, 0x96, 0xe5, 0xb9, 0x0e, 0x96, 0x9a, 0xa6, 0x1d, 0x74, 0xbb, 0x96, 0xef, 0xb0,
+	0x4b, 0x98, 0xa5, 0x41, 0x02, 0x7e, 0x0e, 0x78, 0x10, 0x46, 0x1f, 0x29, 0xd6, 0x82, 0x24, 0xa9,
+	0x6c, 0x7c, 0x4f, 0x86, 0xc1, 0xf7, 0x1e, 0x4d, 0xb7, 0xc9, 0x59, 0xcb, 0xb1, 0x7a, 0x18, 0xfa,
+	0xf0, 0xe0, 0xe6, 0x27, 0xe9, 0x72, 0x5e, 0xc2, 0x64, 0x14, 0x38, 0x89, 0xc5, 0x63, 0x24, 0xf7,
+	0x43, 0x23, 0x9a, 0x97, 0x30, 0x8d, 0x30, 0xfd, 0x95, 0x46, 0x58, 0x79, 0xe6, 0x42, 0xf5, 0x74,
+	0x05, 0xa7, 0xe6, 0xd5, 0xa9, 0x8b, 0xd5, 0xd3, 0x7c, 0x6d, 0x6a, 0x85, 0x16, 0x4e, 0xcf, 0xcd,
+	0x52, 0x2d, 0x72, 0xf3, 0x2a, 0x6f, 0xd6, 0x07, 0xaf, 0x62, 0xb2, 0x6c, 0xcd, 0x27, 0x7d, 0x57,
+	0xc4, 0x66, 0xc4, 0xae, 0xa2, 0x29, 0x0f, 0xa0, 0x60, 0x28, 0x0e, 0xfd, 0x31, 0xc0, 0x60, 0xc7,
+	0x85, 0x9a, 0x1d, 0x12, 0x2a, 0x19, 0x51, 0xb4, 0xe2, 0x00, 0x34, 0xd8, 0x1a, 0x74, 0xd1, 0x9f,
+	0x90, 0x89, 0x34, 0x82, 0x04, 0xbe, 0x89, 0x5d, 0xd9, 0x7e, 0x8f, 0x5d, 0xc3, 0xed, 0x76, 0x11,
+	0x42, 0xba, 0x04, 0x1f, 0xfa, 0xab, 0x12, 0x52, 0x21, 0xbd, 0x22, 0x37, 0x78, 0x95, 0x09, 0x4e,
+	0x81, 0xd5, 0x54, 0x9b, 0x91, 0xd5, 0xed, 0x79, 0x82, 0x5d, 0xc7, 0x07, 0x7c, 0x04, 0x6b, 0x5d,
+	0x19, 0xb7, 0x8a, 0x04, 0x15, 0x7b, 0x1b, 0xd1, 0x52, 0xdd, 0x57, 0x7a, 0xce, 0x83, 0xf0, 0x9b,
+	0x37, 0xeb, 0xa4, 0x2e, 0x99, 0xaa, 0x1b, 0xd4, 0xee, 0x7b, 0x1e, 0x7b, 0x13, 0x1f, 0xf8, 0x06,
+	0x64, 0xd1, 0x95, 0xa1, 0x4b, 0x7d, 0xcf, 0x53, 0x0d, 0x8c, 0x06, 0xcc, 0xe0, 0x4d, 0x23, 0x68,
+	0x9b, 0x8c, 0xa7, 0x77, 0x52, 0xa6, 0xbc, 0x71, 0x62, 0x37, 0xd0, 0x0f, 0x4e, 0xaa, 0xf6, 0x92,
+	0x44, 0x57, 0x10, 0xc4, 0x6e, 0xf0, 0x89, 0xa8, 0x28, 0x1a, 0x25, 0xfa, 0x69, 0xe9, 0x8d, 0x8a,
+	0x52, 0x83, 0x97, 0x59, 0xb4, 0x47, 0xa6, 0x30, 0x40, 0x9a, 0xd0, 0x76, 0x36, 0x37, 0xfa, 0x56,
+	0xe8, 0x98, 0xd8, 0x3a, 0x62, 0x6f, 0xe1, 0x0a, 0xbf, 0x03, 0x8f, 0x84, 0x8c, 0x15, 0x2b, 0xee,
+	0xbc, 0x07, 0x38, 0x07, 0x58, 0x3d, 0x52, 0x03, 0xa6, 0x0e, 0x51, 0xd3, 0x40, 0xba, 0x43, 0xce,
+	0xa9, 0x3d, 0x8b, 0x2e, 0x44, 0xd5, 0x24, 0xf6, 0x80, 0xdd, 0xcc, 0xab, 0xb1, 0x8c, 0x04, 0x1e,
+	0x60, 0x21, 0xa7, 0xa8, 0x6a, 0x6c, 0x0f, 0xdc, 0xe0, 0x7b, 0x8d, 0xa4, 0xff, 0x5b, 0x3c, 0x2e,
+	0x38, 0x35, 0x04, 0x7e, 0xe8, 0x4b, 0xfd, 0x01, 0x3e, 0xeb, 0xbf, 0x42, 0x96, 0x47, 0xef, 0x14,
+	0x46, 0x2f, 0x5b, 0x3b, 0xb2, 0x2d, 0x45, 0xad, 0x9a, 0x54, 0xb5, 0xb0, 0xeb, 0x50, 0xb1, 0x32,
+	0xba, 0x79, 0xfd, 0xda, 0x8d, 0x1b, 0x85, 0xe4, 0xae, 0x49, 0x53, 0xa3, 0xf4, 0xc5, 0xb3, 0xb9,
+	0xc3, 0x72, 0xf4, 0xd3, 0xdd, 0xb9, 0x06, 0xab, 0x78, 0x7d, 0xcc, 0x3a, 0xfd, 0x84, 0x30, 0x0c,
+	0x5b, 0xf2, 0xae, 0xd1, 0x4c, 0xbb, 0x46, 0x76, 0x47, 0xd8, 0x9b, 0xec, 0x6d, 0x5c, 0x5b, 0x8c,
+	0x94, 0xc0, 0xe1, 0x48, 0xb9, 0x87, 0x8c, 0x05, 0x20, 0xe4, 0xcd, 0x9d, 0x26, 0xd4, 0xe0, 0xcd,
+	0xa3, 0xe8, 0x16, 0xa1, 0x32, 0x8e, 0xe1, 0xf5, 0x68, 0xb6, 0x5b, 0x6f, 0xe1, 0x6e, 0x65, 0xd9,
+	0x6e, 0xc5, 0xe4, 0xf3, 0x2e, 0x10, 0xd2, 0x0d, 0x7b, 0x19, 0x12, 0xab, 0xed, 0x8a, 0x54, 0x25,
+	0x56, 0x55, 0xc0, 0xe0, 0x35, 0x2e, 0xfd, 0xa5, 0x46, 0x58, 0x71, 0xe2, 0xf4, 0xfa, 0xc1, 0x6a,
+	0xc7, 0x22, 0x64, 0xb7, 0xf1, 0x85, 0xae, 0xc0, 0xb3, 0xe6, 0x03, 0x39, 0x32, 0xee, 0x00, 0x41,
+	0xe5, 0x97, 0x8d, 0x68, 0xf1, 0x02, 0xa2, 0x58, 0xd9, 0xbe, 0xc9, 0x9b, 0xb5, 0x81, 0x13, 0xc4,
+	0xc6, 0x88, 0x2f, 0xb6, 0x45, 0x14, 0x9b, 0x6d, 0x37, 0x8c, 0x62, 0xf6, 0x4e, 0xee, 0x04, 0x01,
+	0x7c, 0x80, 0xd8, 0x12, 0x40, 0xca, 0x09, 0x56, 0xe4, 0x06, 0xaf, 0x32, 0xe9, 0xc7, 0x04, 0x43,
+	0xb0, 0x29, 0xb6, 0x84, 0x1f, 0x47, 0xd0, 0x50, 0x37, 0x23, 0xf6, 0x3d, 0x7c, 0xba, 0x6b, 0x90,
+	0x26, 0x00, 0x78, 0x17, 0xb1, 0x15, 0x11, 0xe6, 0xbd, 0x82, 0xb2, 0x58, 0x1d, 0xc8, 0x0a, 0x9d,
+	0xfe, 0x8c, 0x9c, 0xc2, 0x16, 0x2d, 0xcc, 0x10, 0x8a, 0x38, 0x74, 0x45, 0xc4, 0xde, 0xcd, 0x95,
+	0x77, 0xad, 0x1d, 0xd8, 0x5b, 0x5c, 0x22, 0x4a, 0x79, 0x59, 0x9c, 0x2b, 0x2f, 0xcb, 0xe9, 0x26,
+	0x39, 0x29, 0xef, 0x2d, 0xcd, 0xec, 0x52, 0x9c, 0x7d, 0xbf, 0x5c, 0xa2, 0xcb, 0x8b, 0xc6, 0xa5,
+	0x14, 0x95, 0x79, 0x4f, 0x54, 0x92, 0xa9, 0x39, 0xcb, 0x62, 0x83, 0x57, 0x78, 0xf4, 0x1d, 0x32,
+	0x66, 0xf5, 0x1d, 0x37, 0x36, 0xbd, 0x60, 0x83, 0xfd, 0x00, 0x57, 0x7e, 0x06, 0x6e, 0xa7, 0x51,
+	0xf8, 0x41, 0x00, 0x4d, 0xef, 0xf1, 0xf4, 0x1a, 0x40, 0x0a, 0x0c, 0xae, 0x30, 0xfa, 0x67, 0xe0,
+	0x18, 0xb2, 0xd1, 0xe8, 0x14, 0x84, 0x2f, 0x17, 0xe3, 0x87, 0xb8, 0x18, 0x6b, 0xe8, 0x01, 0x52,
+	0xf6, 0xb2, 0xb5, 0x73, 0xd7, 0xcf, 0x16, 0xe4, 0xf5, 0x92, 0xce, 0x1c, 0xaa, 0x04, 0x98, 0x52,
+	0x88, 0x39, 0x2c, 0x25, 0xbc, 0x41, 0x23, 0xed, 0x92, 0xa9, 0xb2, 0x21, 0xd6, 0x86, 0x30, 0x1d,
+	0x6b, 0x10, 0xb1, 0x3b, 0x68, 0xc9, 0xad, 0x8a, 0x25, 0x77, 0x36, 0xc4, 0xa2, 0x35, 0xc8, 0x5b,
+	0x80, 0x75, 0x48, 0xbd, 0x9e, 0x86, 0x61, 0xf4, 0x01, 0x39, 0x8e, 0x87, 0x66, 0x3b, 0x80, 0x6e,
+	0x59, 0xc4, 0x5a, 0x38, 0xc9, 0x77, 0xe1, 0xc2, 0x02, 0xe4, 0x8f, 0xa5, 0x78, 0x94, 0xe8, 0x13,
+	0xaa, 0xeb, 0x9b, 0xca, 0x94, 0xda, 0x22, 0x11, 0x02, 0x24, 0xea, 0x2b, 0xe6, 0xcc, 0x32, 0x01,
+	0x5d, 0xc8, 0x03, 0x24, 0x30, 0x16, 0xf2, 0x44, 0x38, 0x4d, 0x41, 0xcf, 0xa9, 0x19, 0x2a, 0x98,
+	0xc1, 0x9b, 0x46, 0xd0, 0x90, 0x4c, 0xb4, 0xe5, 0xb6, 0xc5, 0x19, 0xc5, 0x96, 0x08, 0x07, 0x6c,
+	0x11, 0xed, 0x5f, 0xc2, 0x8b, 0x30, 0xdc, 0x89, 0x80, 0xdd, 0x05, 0x48, 0x5d, 0x91, 0x57, 0xe4,
+	0x5f, 0xd7, 0x01, 0xae, 0xea, 0xa0, 0x7f, 0xa2, 0x91, 0xc9, 0xd4, 0xb3, 0xaa, 0xcf, 0x38, 0xa0,
+	0x70, 0x16, 0xec, 0x2e, 0x6e, 0xec, 0x97, 0xb2, 0x8d, 0x2d, 0xbd, 0xe4, 0x62, 0xc6, 0x59, 0x0e,
+	0x1c, 0x21, 0x9f, 0x3d, 0xac, 0x03, 0xea, 0xd9, 0x1b, 0x30, 0x83, 0x37, 0x8d, 0x80, 0xdb, 0xd6,
+	0xe9, 0x76, 0xff, 0xc9, 0x93, 0x41, 0xe6, 0xe7, 0xcb, 0x0d, 0xd9, 0x25, 0x95, 0x1b, 0x9d, 0x45,
+	0x96, 0xb4, 0xa6, 0xd2, 0x93, 0x4d, 0x3b, 0x13, 0xcd, 0x78, 0x61, 0x55, 0x6e, 0x95, 0x56, 0xe5,
+	0xd6, 0x55, 0xbe, 0x97, 0x4e, 0x68, 0x11, 0xab, 0x42, 0x3a, 0x14, 0x96, 0x63, 0xae, 0x5b, 0xbe,
+	0xb3, 0xed, 0x3a, 0x71, 0x87, 0xbd, 0x97, 0xb7, 0x88, 0xd3, 0xca, 0x98, 0x0b, 0xcb, 0x69, 0x65,
+	0xb8, 0x6a, 0x11, 0x37, 0x81, 0x79, 0x8b, 0xb8, 0x09, 0xa5, 0x7f, 0xa1, 0x91, 0x99, 0x50, 0xd8,
+	0x02, 0x62, 0x3a, 0xec, 0x34, 0x33, 0x84, 0xad, 0x10, 0x17, 0xf3, 0xf2, 0xf7, 0x71, 0xf6, 0x7b,
+	0xc3, 0x44, 0x9f, 0x4e, 0x99, 0xb0, 0x83, 0x38, 0xf2, 0x8a, 0xc9, 0xf9, 0x6c, 0xfa, 0x1a, 0xf6,
+	0xa2, 0x28, 0x4b, 0xbe, 0x46, 0x0d, 0xdd, 0x20, 0x67, 0x60, 0x6f, 0x84, 0x5d, 0xd7, 0x77, 0xa3,
+	0xd8, 0xb5, 0xd3, 0x0d, 0xca, 0xee, 0xe5, 0x07, 0xa0, 0x84, 0xcb, 0xfd, 0xa5, 0x36, 0x41, 0x03,
+	0x66, 0xf0, 0xa6, 0x11, 0xb4, 0x4f, 0xce, 0xa5, 0xf5, 0x63, 0x18, 0xf4, 0xd2, 0x48, 0xef, 0xa4,
+	0x71, 0x82, 0xfd, 0x08, 0x67, 0xbb, 0x0d, 0xa5, 0xbc, 0x2c, 0x10, 0xc3, 0xa0, 0x27, 0x83, 0xb6,
+	0x23, 0xdd, 0xff, 0x28, 0xd1, 0xcf, 0x17, 0x0a, 0xca, 0x2a, 0x6c, 0xf0, 0x3d, 0xc6, 0x41, 0xa8,
+	0xcb, 0xab, 0xbf, 0xac, 0xe4, 0xbb, 0x8f, 0x25, 0x1f, 0x86, 0xba, 0xac, 0x68, 0xcb, 0x0b, 0xbd,
+	0xc9, 0x52, 0xa1, 0xa7, 0xca, 0xbb, 0x2a, 0x93, 0xfa, 0xe4, 0x24, 0xec, 0x9f, 0xb6, 0xeb, 0x09,
+	0x19, 0xd2, 0x23, 0xf6, 0x81, 0x3a, 0xcf, 0x70, 0xc9, 0x03, 0x9d, 0x14, 0x0c, 0xbd, 0x91, 0x3a,
+	0xcd, 0x25, 0xe9, 0x37, 0x65, 0xf5, 0x65, 0x1d, 0x50, 0x2a, 0xa7, 0xed, 0xc9, 0x30, 0x08, 0x62,
+	0x33, 0xcd, 0x8b, 0xd9, 0x72, 0x5e, 0x2a, 0x4b, 0x98, 0x07, 0x41, 0x9c, 0x66, 0xdb, 0xaa, 0x54,
+	0xae, 0x21, 0x06, 0xaf, 0xb3, 0x21, 0x1b, 0x73, 0x44, 0x5b, 0x84, 0xf2, 0x4c, 0x6c, 0x77, 0xe0,
+	0xc9, 0x60, 0xdd, 0xe0, 0xfe, 0xf6, 0x41, 0x9e, 0x8d, 0x21, 0x07, 0x76, 0xf6, 0x63, 0x60, 0xac,
+	0x48, 0x82, 0xca, 0xc6, 0x1a, 0x51, 0x83, 0x37, 0x8f, 0xa2, 0xff, 0xa4, 0x91, 0xd7, 0x30, 0x03,
+	0x8c, 0x3a, 0x16, 0xec, 0x87, 0xad, 0xc0, 0xeb, 0x83, 0xbb, 0xb2, 0x62, 0x6b, 0x1d, 0x5b, 0xc6,
+	0xd0, 0x25, 0x48, 0x13, 0xc2, 0x87, 0x68, 0x02, 0xd4, 0xab, 0x98, 0xf2, 0xad, 0xe2, 0x88, 0x47,
+	0x38, 0x60, 0x31, 0xe5, 0x63, 0x53, 0x21, 0xcb, 0x0e, 0xe7, 0x55, 0x76, 0xf8, 0xf5, 0x54, 0x83,
+	0x7f, 0x0b, 0x12, 0xfd, 0x98, 0xd0, 0xb4, 0x98, 0x5a, 0x17, 0x6d, 0xfc, 0x58, 0x00, 0x0a, 0xa9,
+	0x15, 0xb4, 0x09, 0xb3, 0x43, 0x89, 0xb6, 0x10, 0x5c, 0x91, 0x55, 0xd4, 0x54, 0xa1, 0x8a, 0xca,
+	0x01, 0x83, 0xd7, 0xb8, 0xf4, 0x8f, 0x34, 0x72, 0x26, 0x75, 0x8e, 0xb6, 0x65, 0x77, 0x84, 0x8a,
+	0xe8, 0x3f, 0x56, 0x99, 0x21, 0x95, 0xf8, 0x02, 0xc0, 0x79, 0x44, 0x7f, 0xad, 0xe0, 0x8b, 0x8b,
+	0xd0, 0x37, 0x6d, 0xae, 0x06, 0x6d, 0x74, 0x85, 0x8c, 0xc3, 0x27, 0x02, 0xb8, 0xa3, 0x21, 0x90,
+	0x47, 0x8c, 0xe7, 0x01, 0xb6, 0xeb, 0x62, 0x6b, 0xf0, 0xce, 0x86, 0x58, 0x55, 0x01, 0xb6, 0x20,
+	0xcb, 0x03, 0x6c, 0x41, 0x48, 0x6d, 0x42, 0x37, 0x85, 0xe8, 0xe1, 0xed, 0x60, 0x10, 0x5a, 0x30,
+	0x8b, 0xd9, 0x61, 0xab, 0x79, 0xaf, 0x12, 0xd0, 0xb5, 0x1c, 0x7c, 0x5f, 0x2d, 0x5a, 0x15, 0xc8,
+	0x7b, 0x95, 0x55, 0x04, 0x0e, 0x46, 0xb9, 0x4c, 0xc2, 0x3b, 0x40, 0xb6, 0x96, 0x1f, 0x8c, 0x62,
+	0xe5, 0x81, 0xf7, 0xb0, 0xea, 0x60, 0xd4, 0x10, 0x83, 0xd7, 0xd9, 0xb4, 0x93, 0xe5, 0x1d, 0xd8,
+	0xff, 0x8b, 0xd8, 0x87, 0xd8, 0x11, 0xbe, 0xab, 0xf2, 0x0e, 0x29, 0x56, 0x61, 0x21, 0x97, 0x95,
+	0xfb, 0xbe, 0x67, 0x9a, 0x00, 0x5e, 0x54, 0x01, 0x97, 0x7f, 0xc5, 0x99, 0x4c, 0x79, 0x1e, 0xd3,
+	0x6e, 0x3f, 0x7b, 0x94, 0x97, 0x9b, 0x85, 0x41, 0x8b, 0xc0, 0x49, 0xdb, 0xe5, 0xaa, 0xdc, 0xdc,
+	0x03, 0x37, 0xf8, 0x5e, 0x23, 0xe9, 0xae, 0x46, 0xa6, 0x54, 0x33, 0x53, 0x86, 0x69, 0xd1, 0xed,
+	0x79, 0x56, 0x2c, 0xd8, 0x63, 0xf4, 0x97, 0x7f, 0xa7, 0x41, 0x40, 0xcc, 0x28, 0x70, 0x57, 0xb9,
+	0x96, 0x12, 0x46, 0x89, 0xfe, 0x30, 0xbd, 0x2b, 0xaa, 0x83, 0x85, 0x9d, 0x78, 0x19, 0x2e, 0xc3,
+	0x2e, 0x65, 0xa4, 0x4b, 0x9f, 0x3a, 0x56, 0x2c, 0x3e, 0xbb, 0xf4, 0x69, 0xec, 0x76, 0xe1, 0x8f,
+	0xfc, 0x38, 0xeb, 0xb3, 0x4f, 0xc5, 0x4e, 0xfc, 0x19, 0x5c, 0x29, 0xbd, 0xf1, 0xed, 0xe9, 0xbc,
+	0xd1, 0xac, 0xc2, 0x87, 0x08, 0x1d, 0xd7, 0x71, 0x84, 0xcf, 0x7e, 0x52, 0xfd, 0x10, 0xe1, 0x7d,
+	0x94, 0x57, 0x3e, 0x44, 0x90, 0x42, 0xf5, 0x21, 0x82, 0xfc, 0x49, 0x1f, 0x92, 0xf1, 0xc0, 0x17,
+	0x66, 0xfe, 0x21, 0x1d, 0xfb, 0x29, 0x6a, 0xc3, 0x5e, 0x46, 0xe0, 0x8b, 0xfc, 0xeb, 0x3c, 0xd5,
+	0xcb, 0x28, 0x49, 0x0d, 0x5e, 0x66, 0x95, 0x3a, 0xf9, 0x4e, 0xfa, 0x01, 0x8c, 0xd9, 0x65, 0x1f,
+	0xd5, 0x3a, 0xf9, 0x8b, 0x29, 0xb8, 0x5c, 0xed, 0xe4, 0x2b, 0xa0, 0xd6, 0xc9, 0x57, 0x08, 0xdd,
+	0x24, 0x63, 0x98, 0xdd, 0x60, 0x5a, 0xfb, 0xcf, 0x4b, 0x68, 0xf2, 0x32, 0x34, 0x0e, 0x16, 0x45,
+	0x2f, 0x14, 0xb6, 0x15, 0x0b, 0x07, 0x32, 0x14, 0xc8, 0x0d, 0x86, 0x89, 0xae, 0x5d, 0x52, 0x47,
+	0x23, 0x0c, 0x1a, 0xbe, 0xc8, 0x9c, 0xa8, 0x49, 0x99, 0xc6, 0x8f, 0x86, 0xa9, 0x02, 0xfa, 0x09,
+	0x99, 0x28, 0x7d, 0x64, 0x84, 0xf9, 0xdd, 0xbf, 0xc0, 0xa4, 0x5a, 0xeb, 0xee, 0xf3, 0x44, 0x67,
+	0xf9, 0xa4, 0xcb, 0xf9, 0xa7, 0x42, 0x2b, 0x76, 0x9c, 0x4d, 0x3d, 0x53, 0xfd, 0xd2, 0x68, 0xc5,
+	0x8e, 0x0b, 0x16, 0x30, 0x8d, 0x8f, 0x97, 0x41, 0xfa, 0x53, 0x72, 0x44, 0x7e, 0x60, 0x11, 0xb1,
+	0xdf, 0xca, 0x4c, 0xf2, 0xfb, 0x70, 0x53, 0x9d, 0x4f, 0x24, 0x3f, 0x9c, 0x89, 0xca, 0x0f, 0x97,
+	0x0e, 0x29, 0xa8, 0x4e, 0x57, 0x90, 0x69, 0x3c, 0xd3, 0xd7, 0xba, 0xff, 0xe5, 0xef, 0x66, 0xf6,
+	0xed, 0xfe, 0x6e, 0x66, 0xdf, 0x97, 0xcf, 0x67, 0xb4, 0xdd, 0xe7, 0x33, 0xda, 0x5f, 0x7d, 0x35,
+	0xb3, 0xef, 0x37, 0x5f, 0xcd, 0x68, 0xbb, 0x5f, 0xcd, 0xec, 0xfb, 0xef, 0xaf, 0x66, 0xf6, 0x7d,
+	0xf4, 0xfa, 0xb7, 0xf8, 0xc0, 0x57, 0xe6, 0xd8, 0xeb, 0x87, 0xf1, 0x43, 0xdf, 0x37, 0xff, 0x7f,
+	0x00, 0x77, 0xd7, 0x5e, 0xff, 0xb8, 0x2e, 0x00, 0x00,
 }
 
 func (m *FolderDeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -300,6 +593,16 @@ func (m *FolderDeviceConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.AllowedSource {
+		i--
+		if m.AllowedSource {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.EncryptionPassword) > 0 {
 		i -= len(m.EncryptionPassword)
 		copy(dAtA[i:], m.EncryptionPassword)
@@ -383,492 +686,2313 @@ func (m *FolderConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
-	if m.JunctionsAsDirs {
+	if m.MaxScanDurationM != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxScanDurationM))
 		i--
-		if m.JunctionsAsDirs {
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.OneFilesystem {
+		i--
+		if m.OneFilesystem {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x5
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0xc8
 	}
-	if m.CaseSensitiveFS {
+	if m.IgnoreHidden {
 		i--
-		if m.CaseSensitiveFS {
+		if m.IgnoreHidden {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x5
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0xc0
 	}
-	if m.CopyRangeMethod != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.CopyRangeMethod))
+	if len(m.ConflictNameTemplate) > 0 {
+		i -= len(m.ConflictNameTemplate)
+		copy(dAtA[i:], m.ConflictNameTemplate)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.ConflictNameTemplate)))
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x5
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0xba
 	}
-	if m.BlockPullOrder != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.BlockPullOrder))
+	if m.ScanWindowsDeferWatcher {
 		i--
-		dAtA[i] = 0x1
+		if m.ScanWindowsDeferWatcher {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xf8
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xb0
 	}
-	if m.DisableFsync {
+	if len(m.ScanWindows) > 0 {
+		for iNdEx := len(m.ScanWindows) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScanWindows[iNdEx])
+			copy(dAtA[i:], m.ScanWindows[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.ScanWindows[iNdEx])))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.AdaptivePullPause {
 		i--
-		if m.DisableFsync {
+		if m.AdaptivePullPause {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x5
 		i--
-		dAtA[i] = 0xf0
+		dAtA[i] = 0xa0
 	}
-	if m.MaxConcurrentWrites != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxConcurrentWrites))
+	if m.KeepTemporariesH != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.KeepTemporariesH))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x5
 		i--
-		dAtA[i] = 0xe8
+		dAtA[i] = 0x98
 	}
-	if m.RawModTimeWindowS != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.RawModTimeWindowS))
+	if m.MinFileAgeS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MinFileAgeS))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x5
 		i--
-		dAtA[i] = 0xe0
+		dAtA[i] = 0x90
 	}
-	if m.CopyOwnershipFromParent {
+	if m.RenameCacheEntries != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.RenameCacheEntries))
 		i--
-		if m.CopyOwnershipFromParent {
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.VerifyBeforePull {
+		i--
+		if m.VerifyBeforePull {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x5
 		i--
-		dAtA[i] = 0xd8
+		dAtA[i] = 0x80
 	}
-	if len(m.MarkerName) > 0 {
-		i -= len(m.MarkerName)
-		copy(dAtA[i:], m.MarkerName)
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.MarkerName)))
+	if m.SkipSharedVolumeDatabaseSpaceCheck {
 		i--
-		dAtA[i] = 0x1
+		if m.SkipSharedVolumeDatabaseSpaceCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xf8
 	}
-	if m.WeakHashThresholdPct != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.WeakHashThresholdPct))
+	if m.DeferScanWhilePulling {
 		i--
-		dAtA[i] = 0x1
+		if m.DeferScanWhilePulling {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xc8
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xf0
 	}
-	if m.Paused {
+	if m.FollowRootSymlink {
 		i--
-		if m.Paused {
+		if m.FollowRootSymlink {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
 		i--
-		dAtA[i] = 0xc0
+		dAtA[i] = 0xe8
 	}
-	if m.DisableTempIndexes {
+	if m.MaxFileErrors != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxFileErrors))
 		i--
-		if m.DisableTempIndexes {
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.PostPullCommand) > 0 {
+		i -= len(m.PostPullCommand)
+		copy(dAtA[i:], m.PostPullCommand)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.PostPullCommand)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.WatchDropIgnoredEvents {
+		i--
+		if m.WatchDropIgnoredEvents {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
 		i--
-		dAtA[i] = 0xb8
+		dAtA[i] = 0xd0
 	}
-	if m.DisableSparseFiles {
+	if m.DeterministicRescan {
 		i--
-		if m.DisableSparseFiles {
+		if m.DeterministicRescan {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
 		i--
-		dAtA[i] = 0xb0
+		dAtA[i] = 0xc8
 	}
-	if m.MaxConflicts != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxConflicts))
+	if m.ReceiveOnlyRevertIntervalS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ReceiveOnlyRevertIntervalS))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
 		i--
-		dAtA[i] = 0xa8
+		dAtA[i] = 0xc0
 	}
-	if m.PullerPauseS != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.PullerPauseS))
+	if m.MaxScanReadBandwidth != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxScanReadBandwidth))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
 		i--
-		dAtA[i] = 0xa0
+		dAtA[i] = 0xb8
 	}
-	if m.ScanProgressIntervalS != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ScanProgressIntervalS))
+	if m.FuzzyRenameThresholdPct != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.FuzzyRenameThresholdPct))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
 		i--
-		dAtA[i] = 0x98
+		dAtA[i] = 0xb0
 	}
-	if m.IgnoreDelete {
+	if m.RenameDetectionMode != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.RenameDetectionMode))
 		i--
-		if m.IgnoreDelete {
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.FullRescanEvery != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.FullRescanEvery))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ScanChangedDirsOnly {
+		i--
+		if m.ScanChangedDirsOnly {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.ScanWorkers != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ScanWorkers))
+		i--
+		dAtA[i] = 0x4
 		i--
 		dAtA[i] = 0x90
 	}
-	if m.Order != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Order))
+	if m.AuditLogMaxAgeDays != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.AuditLogMaxAgeDays))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
 		i--
 		dAtA[i] = 0x88
 	}
-	if m.Hashers != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Hashers))
+	if m.AuditLogMaxEntries != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.AuditLogMaxEntries))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x4
 		i--
 		dAtA[i] = 0x80
 	}
-	if m.PullerMaxPendingKiB != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.PullerMaxPendingKiB))
+	if m.AuditLog {
 		i--
-		dAtA[i] = 0x78
+		if m.AuditLog {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf8
 	}
-	if m.Copiers != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Copiers))
+	if m.SourceFallback != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.SourceFallback))
 		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf0
 	}
-	{
-		size, err := m.Versioning.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	if m.MaxPullRetries != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxPullRetries))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe8
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size, err := m.MinDiskFree.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	if m.PullEventsPerS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.PullEventsPerS))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
 	}
-	i--
-	dAtA[i] = 0x62
-	if m.AutoNormalize {
+	if m.HashNewestFirst {
 		i--
-		if m.AutoNormalize {
+		if m.HashNewestFirst {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd8
 	}
-	if m.IgnorePerms {
+	if m.WatchErrorRescanAfter != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.WatchErrorRescanAfter))
 		i--
-		if m.IgnorePerms {
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.WatchErrorPolicy != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.WatchErrorPolicy))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.SkipRenameIgnoreCheck {
+		i--
+		if m.SkipRenameIgnoreCheck {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
 	}
-	if m.FSWatcherDelayS != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.FSWatcherDelayS))
+	if m.AdaptivePullMaxKiB != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.AdaptivePullMaxKiB))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
 	}
-	if m.FSWatcherEnabled {
+	if m.AdaptivePullConcurrency {
 		i--
-		if m.FSWatcherEnabled {
+		if m.AdaptivePullConcurrency {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x40
-	}
-	if m.RescanIntervalS != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.RescanIntervalS))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Devices) > 0 {
-		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+		dAtA[i] = 0xb0
 	}
-	if m.Type != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Type))
+	if m.EmptyPathGuardRatio != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.EmptyPathGuardRatio))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Path)))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa8
 	}
-	if m.FilesystemType != 0 {
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.FilesystemType))
+	if m.SymlinkPolicy != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.SymlinkPolicy))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Label)))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa0
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.ID)))
+	if m.VerifyOnStartupFull {
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintFolderconfiguration(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFolderconfiguration(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *FolderDeviceConfiguration) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.DeviceID.ProtoSize()
-	n += 1 + l + sovFolderconfiguration(uint64(l))
-	l = m.IntroducedBy.ProtoSize()
-	n += 1 + l + sovFolderconfiguration(uint64(l))
-	l = len(m.EncryptionPassword)
-	if l > 0 {
-		n += 1 + l + sovFolderconfiguration(uint64(l))
+		if m.VerifyOnStartupFull {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
 	}
-	return n
-}
-
-func (m *FolderConfiguration) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.VerifyOnStartupSample != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.VerifyOnStartupSample))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
 	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovFolderconfiguration(uint64(l))
+	if m.VerifyOnStartup {
+		i--
+		if m.VerifyOnStartup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovFolderconfiguration(uint64(l))
+	if m.AdaptiveScanQuietS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.AdaptiveScanQuietS))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.FilesystemType != 0 {
-		n += 1 + sovFolderconfiguration(uint64(m.FilesystemType))
+	if m.AdaptiveScanIntervalS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.AdaptiveScanIntervalS))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovFolderconfiguration(uint64(l))
+	if m.AdaptiveScanThreshold != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.AdaptiveScanThreshold))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
 	}
-	if m.Type != 0 {
-		n += 1 + sovFolderconfiguration(uint64(m.Type))
+	if len(m.PostPullValidationCommand) > 0 {
+		i -= len(m.PostPullValidationCommand)
+		copy(dAtA[i:], m.PostPullValidationCommand)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.PostPullValidationCommand)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
 	}
-	if len(m.Devices) > 0 {
-		for _, e := range m.Devices {
-			l = e.ProtoSize()
-			n += 1 + l + sovFolderconfiguration(uint64(l))
+	if m.PullDeletionsOnly {
+		i--
+		if m.PullDeletionsOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
 	}
-	if m.RescanIntervalS != 0 {
-		n += 1 + sovFolderconfiguration(uint64(m.RescanIntervalS))
-	}
-	if m.FSWatcherEnabled {
-		n += 2
-	}
-	if m.FSWatcherDelayS != 0 {
-		n += 1 + sovFolderconfiguration(uint64(m.FSWatcherDelayS))
+	if m.WatchDiskSpace {
+		i--
+		if m.WatchDiskSpace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
 	}
-	if m.IgnorePerms {
-		n += 2
+	if m.SkipUnchangedDirs {
+		i--
+		if m.SkipUnchangedDirs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
 	}
-	if m.AutoNormalize {
-		n += 2
+	if m.ChronicConflictAction != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ChronicConflictAction))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
 	}
-	l = m.MinDiskFree.ProtoSize()
-	n += 1 + l + sovFolderconfiguration(uint64(l))
-	l = m.Versioning.ProtoSize()
-	n += 1 + l + sovFolderconfiguration(uint64(l))
-	if m.Copiers != 0 {
-		n += 1 + sovFolderconfiguration(uint64(m.Copiers))
+	if m.ChronicConflictWindowS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ChronicConflictWindowS))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
 	}
-	if m.PullerMaxPendingKiB != 0 {
-		n += 1 + sovFolderconfiguration(uint64(m.PullerMaxPendingKiB))
+	if m.ChronicConflictThreshold != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ChronicConflictThreshold))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
 	}
-	if m.Hashers != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.Hashers))
+	if m.MaxScanOpenFiles != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxScanOpenFiles))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
 	}
-	if m.Order != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.Order))
+	if len(m.AllowedContentSignatures) > 0 {
+		for iNdEx := len(m.AllowedContentSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContentSignatures[iNdEx])
+			copy(dAtA[i:], m.AllowedContentSignatures[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.AllowedContentSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	if m.IgnoreDelete {
-		n += 3
+	if m.VerifyModTimes {
+		i--
+		if m.VerifyModTimes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.ScanProgressIntervalS != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.ScanProgressIntervalS))
+	if m.FSWatcherDeleteGraceMs != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.FSWatcherDeleteGraceMs))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.PullerPauseS != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.PullerPauseS))
+	if m.JunctionsAsDirs {
+		i--
+		if m.JunctionsAsDirs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.MaxConflicts != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.MaxConflicts))
+	if m.CaseSensitiveFS {
+		i--
+		if m.CaseSensitiveFS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.DisableSparseFiles {
-		n += 3
+	if m.CopyRangeMethod != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.CopyRangeMethod))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.DisableTempIndexes {
-		n += 3
+	if m.BlockPullOrder != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.BlockPullOrder))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	if m.Paused {
-		n += 3
+	if m.DisableFsync {
+		i--
+		if m.DisableFsync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	if m.WeakHashThresholdPct != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.WeakHashThresholdPct))
+	if m.MaxConcurrentWrites != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxConcurrentWrites))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
 	}
-	l = len(m.MarkerName)
-	if l > 0 {
-		n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.RawModTimeWindowS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.RawModTimeWindowS))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
 	if m.CopyOwnershipFromParent {
-		n += 3
+		i--
+		if m.CopyOwnershipFromParent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
 	}
-	if m.RawModTimeWindowS != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.RawModTimeWindowS))
+	if len(m.MarkerName) > 0 {
+		i -= len(m.MarkerName)
+		copy(dAtA[i:], m.MarkerName)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.MarkerName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	if m.MaxConcurrentWrites != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.MaxConcurrentWrites))
+	if m.WeakHashThresholdPct != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.WeakHashThresholdPct))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	if m.DisableFsync {
-		n += 3
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	if m.BlockPullOrder != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.BlockPullOrder))
+	if m.DisableTempIndexes {
+		i--
+		if m.DisableTempIndexes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	if m.CopyRangeMethod != 0 {
-		n += 2 + sovFolderconfiguration(uint64(m.CopyRangeMethod))
+	if m.DisableSparseFiles {
+		i--
+		if m.DisableSparseFiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	if m.CaseSensitiveFS {
-		n += 3
+	if m.MaxConflicts != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxConflicts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	if m.JunctionsAsDirs {
-		n += 3
+	if m.PullerPauseS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.PullerPauseS))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.DeprecatedReadOnly {
-		n += 4
+	if m.ScanProgressIntervalS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ScanProgressIntervalS))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.DeprecatedMinDiskFreePct != 0 {
-		n += 11
+	if m.IgnoreDelete {
+		i--
+		if m.IgnoreDelete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.DeprecatedPullers != 0 {
-		n += 3 + sovFolderconfiguration(uint64(m.DeprecatedPullers))
+	if m.Order != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	return n
+	if m.Hashers != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Hashers))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.PullerMaxPendingKiB != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.PullerMaxPendingKiB))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Copiers != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Copiers))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.Versioning.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.MinDiskFree.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.AutoNormalize {
+		i--
+		if m.AutoNormalize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IgnorePerms {
+		i--
+		if m.IgnorePerms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FSWatcherDelayS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.FSWatcherDelayS))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FSWatcherEnabled {
+		i--
+		if m.FSWatcherEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RescanIntervalS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.RescanIntervalS))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FilesystemType != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.FilesystemType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovFolderconfiguration(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFolderconfiguration(x uint64) (n int) {
-	return sovFolderconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func encodeVarintFolderconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFolderconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
-func (m *FolderDeviceConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *FolderDeviceConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeviceID.ProtoSize()
+	n += 1 + l + sovFolderconfiguration(uint64(l))
+	l = m.IntroducedBy.ProtoSize()
+	n += 1 + l + sovFolderconfiguration(uint64(l))
+	l = len(m.EncryptionPassword)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.AllowedSource {
+		n += 2
+	}
+	return n
+}
+
+func (m *FolderConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.FilesystemType != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.FilesystemType))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.Type))
+	}
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.ProtoSize()
+			n += 1 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.RescanIntervalS != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.RescanIntervalS))
+	}
+	if m.FSWatcherEnabled {
+		n += 2
+	}
+	if m.FSWatcherDelayS != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.FSWatcherDelayS))
+	}
+	if m.IgnorePerms {
+		n += 2
+	}
+	if m.AutoNormalize {
+		n += 2
+	}
+	l = m.MinDiskFree.ProtoSize()
+	n += 1 + l + sovFolderconfiguration(uint64(l))
+	l = m.Versioning.ProtoSize()
+	n += 1 + l + sovFolderconfiguration(uint64(l))
+	if m.Copiers != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.Copiers))
+	}
+	if m.PullerMaxPendingKiB != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.PullerMaxPendingKiB))
+	}
+	if m.Hashers != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.Hashers))
+	}
+	if m.Order != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.Order))
+	}
+	if m.IgnoreDelete {
+		n += 3
+	}
+	if m.ScanProgressIntervalS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ScanProgressIntervalS))
+	}
+	if m.PullerPauseS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.PullerPauseS))
+	}
+	if m.MaxConflicts != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxConflicts))
+	}
+	if m.DisableSparseFiles {
+		n += 3
+	}
+	if m.DisableTempIndexes {
+		n += 3
+	}
+	if m.Paused {
+		n += 3
+	}
+	if m.WeakHashThresholdPct != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.WeakHashThresholdPct))
+	}
+	l = len(m.MarkerName)
+	if l > 0 {
+		n += 2 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.CopyOwnershipFromParent {
+		n += 3
+	}
+	if m.RawModTimeWindowS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.RawModTimeWindowS))
+	}
+	if m.MaxConcurrentWrites != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxConcurrentWrites))
+	}
+	if m.DisableFsync {
+		n += 3
+	}
+	if m.BlockPullOrder != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.BlockPullOrder))
+	}
+	if m.CopyRangeMethod != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.CopyRangeMethod))
+	}
+	if m.CaseSensitiveFS {
+		n += 3
+	}
+	if m.JunctionsAsDirs {
+		n += 3
+	}
+	if m.FSWatcherDeleteGraceMs != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.FSWatcherDeleteGraceMs))
+	}
+	if m.VerifyModTimes {
+		n += 3
+	}
+	if len(m.AllowedContentSignatures) > 0 {
+		for _, s := range m.AllowedContentSignatures {
+			l = len(s)
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.MaxScanOpenFiles != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxScanOpenFiles))
+	}
+	if m.ChronicConflictThreshold != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ChronicConflictThreshold))
+	}
+	if m.ChronicConflictWindowS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ChronicConflictWindowS))
+	}
+	if m.ChronicConflictAction != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ChronicConflictAction))
+	}
+	if m.SkipUnchangedDirs {
+		n += 3
+	}
+	if m.WatchDiskSpace {
+		n += 3
+	}
+	if m.PullDeletionsOnly {
+		n += 3
+	}
+	l = len(m.PostPullValidationCommand)
+	if l > 0 {
+		n += 2 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.AdaptiveScanThreshold != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.AdaptiveScanThreshold))
+	}
+	if m.AdaptiveScanIntervalS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.AdaptiveScanIntervalS))
+	}
+	if m.AdaptiveScanQuietS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.AdaptiveScanQuietS))
+	}
+	if m.VerifyOnStartup {
+		n += 3
+	}
+	if m.VerifyOnStartupSample != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.VerifyOnStartupSample))
+	}
+	if m.VerifyOnStartupFull {
+		n += 3
+	}
+	if m.SymlinkPolicy != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.SymlinkPolicy))
+	}
+	if m.EmptyPathGuardRatio != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.EmptyPathGuardRatio))
+	}
+	if m.AdaptivePullConcurrency {
+		n += 3
+	}
+	if m.AdaptivePullMaxKiB != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.AdaptivePullMaxKiB))
+	}
+	if m.SkipRenameIgnoreCheck {
+		n += 3
+	}
+	if m.WatchErrorPolicy != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.WatchErrorPolicy))
+	}
+	if m.WatchErrorRescanAfter != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.WatchErrorRescanAfter))
+	}
+	if m.HashNewestFirst {
+		n += 3
+	}
+	if m.PullEventsPerS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.PullEventsPerS))
+	}
+	if m.MaxPullRetries != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxPullRetries))
+	}
+	if m.SourceFallback != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.SourceFallback))
+	}
+	if m.AuditLog {
+		n += 3
+	}
+	if m.AuditLogMaxEntries != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.AuditLogMaxEntries))
+	}
+	if m.AuditLogMaxAgeDays != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.AuditLogMaxAgeDays))
+	}
+	if m.ScanWorkers != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ScanWorkers))
+	}
+	if m.ScanChangedDirsOnly {
+		n += 3
+	}
+	if m.FullRescanEvery != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.FullRescanEvery))
+	}
+	if m.RenameDetectionMode != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.RenameDetectionMode))
+	}
+	if m.FuzzyRenameThresholdPct != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.FuzzyRenameThresholdPct))
+	}
+	if m.MaxScanReadBandwidth != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxScanReadBandwidth))
+	}
+	if m.ReceiveOnlyRevertIntervalS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ReceiveOnlyRevertIntervalS))
+	}
+	if m.DeterministicRescan {
+		n += 3
+	}
+	if m.WatchDropIgnoredEvents {
+		n += 3
+	}
+	l = len(m.PostPullCommand)
+	if l > 0 {
+		n += 2 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.MaxFileErrors != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxFileErrors))
+	}
+	if m.FollowRootSymlink {
+		n += 3
+	}
+	if m.DeferScanWhilePulling {
+		n += 3
+	}
+	if m.SkipSharedVolumeDatabaseSpaceCheck {
+		n += 3
+	}
+	if m.VerifyBeforePull {
+		n += 3
+	}
+	if m.RenameCacheEntries != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.RenameCacheEntries))
+	}
+	if m.MinFileAgeS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MinFileAgeS))
+	}
+	if m.KeepTemporariesH != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.KeepTemporariesH))
+	}
+	if m.AdaptivePullPause {
+		n += 3
+	}
+	if len(m.ScanWindows) > 0 {
+		for _, s := range m.ScanWindows {
+			l = len(s)
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.ScanWindowsDeferWatcher {
+		n += 3
+	}
+	l = len(m.ConflictNameTemplate)
+	if l > 0 {
+		n += 2 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.IgnoreHidden {
+		n += 3
+	}
+	if m.OneFilesystem {
+		n += 3
+	}
+	if m.MaxScanDurationM != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxScanDurationM))
+	}
+	if m.DeprecatedReadOnly {
+		n += 4
+	}
+	if m.DeprecatedMinDiskFreePct != 0 {
+		n += 11
+	}
+	if m.DeprecatedPullers != 0 {
+		n += 3 + sovFolderconfiguration(uint64(m.DeprecatedPullers))
+	}
+	return n
+}
+
+func sovFolderconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFolderconfiguration(x uint64) (n int) {
+	return sovFolderconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FolderDeviceConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowFolderconfiguration
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FolderDeviceConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FolderDeviceConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntroducedBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IntroducedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowedSource = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FolderConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FolderConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilesystemType", wireType)
+			}
+			m.FilesystemType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilesystemType |= fs.FilesystemType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FolderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, FolderDeviceConfiguration{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RescanIntervalS", wireType)
+			}
+			m.RescanIntervalS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RescanIntervalS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FSWatcherEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FSWatcherEnabled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FSWatcherDelayS", wireType)
+			}
+			m.FSWatcherDelayS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FSWatcherDelayS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnorePerms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnorePerms = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoNormalize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoNormalize = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDiskFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDiskFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versioning", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Versioning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Copiers", wireType)
+			}
+			m.Copiers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Copiers |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullerMaxPendingKiB", wireType)
+			}
+			m.PullerMaxPendingKiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullerMaxPendingKiB |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashers", wireType)
+			}
+			m.Hashers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hashers |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= PullOrder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDelete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreDelete = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanProgressIntervalS", wireType)
+			}
+			m.ScanProgressIntervalS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScanProgressIntervalS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullerPauseS", wireType)
+			}
+			m.PullerPauseS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullerPauseS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConflicts", wireType)
+			}
+			m.MaxConflicts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConflicts |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSparseFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableSparseFiles = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableTempIndexes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableTempIndexes = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeakHashThresholdPct", wireType)
+			}
+			m.WeakHashThresholdPct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeakHashThresholdPct |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CopyOwnershipFromParent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CopyOwnershipFromParent = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawModTimeWindowS", wireType)
+			}
+			m.RawModTimeWindowS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RawModTimeWindowS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentWrites", wireType)
+			}
+			m.MaxConcurrentWrites = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConcurrentWrites |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableFsync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableFsync = bool(v != 0)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockPullOrder", wireType)
+			}
+			m.BlockPullOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockPullOrder |= BlockPullOrder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CopyRangeMethod", wireType)
+			}
+			m.CopyRangeMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CopyRangeMethod |= fs.CopyRangeMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaseSensitiveFS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CaseSensitiveFS = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JunctionsAsDirs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JunctionsAsDirs = bool(v != 0)
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FSWatcherDeleteGraceMs", wireType)
+			}
+			m.FSWatcherDeleteGraceMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FSWatcherDeleteGraceMs |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyModTimes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyModTimes = bool(v != 0)
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContentSignatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AllowedContentSignatures = append(m.AllowedContentSignatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScanOpenFiles", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FolderDeviceConfiguration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FolderDeviceConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MaxScanOpenFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScanOpenFiles |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChronicConflictThreshold", wireType)
+			}
+			m.ChronicConflictThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChronicConflictThreshold |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChronicConflictWindowS", wireType)
+			}
+			m.ChronicConflictWindowS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChronicConflictWindowS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChronicConflictAction", wireType)
+			}
+			m.ChronicConflictAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChronicConflictAction |= ChronicConflictAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipUnchangedDirs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipUnchangedDirs = bool(v != 0)
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchDiskSpace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WatchDiskSpace = bool(v != 0)
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullDeletionsOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PullDeletionsOnly = bool(v != 0)
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPullValidationCommand", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -878,30 +3002,29 @@ func (m *FolderDeviceConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFolderconfiguration
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFolderconfiguration
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PostPullValidationCommand = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntroducedBy", wireType)
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveScanThreshold", wireType)
 			}
-			var byteLen int
+			m.AdaptiveScanThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -911,30 +3034,54 @@ func (m *FolderDeviceConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.AdaptiveScanThreshold |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFolderconfiguration
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveScanIntervalS", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
+			m.AdaptiveScanIntervalS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdaptiveScanIntervalS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveScanQuietS", wireType)
 			}
-			if err := m.IntroducedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AdaptiveScanQuietS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdaptiveScanQuietS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionPassword", wireType)
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyOnStartup", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -944,82 +3091,114 @@ func (m *FolderDeviceConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFolderconfiguration
+			m.VerifyOnStartup = bool(v != 0)
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyOnStartupSample", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
+			m.VerifyOnStartupSample = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifyOnStartupSample |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyOnStartupFull", wireType)
 			}
-			m.EncryptionPassword = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthFolderconfiguration
+			m.VerifyOnStartupFull = bool(v != 0)
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkPolicy", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthFolderconfiguration
+			m.SymlinkPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SymlinkPolicy |= SymlinkPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyPathGuardRatio", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFolderconfiguration
+			m.EmptyPathGuardRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmptyPathGuardRatio |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptivePullConcurrency", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FolderConfiguration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FolderConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			m.AdaptivePullConcurrency = bool(v != 0)
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptivePullMaxKiB", wireType)
 			}
-			var stringLen uint64
+			m.AdaptivePullMaxKiB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1029,29 +3208,36 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AdaptivePullMaxKiB |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipRenameIgnoreCheck", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			m.SkipRenameIgnoreCheck = bool(v != 0)
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchErrorPolicy", wireType)
 			}
-			var stringLen uint64
+			m.WatchErrorPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1061,29 +3247,35 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WatchErrorPolicy |= WatchErrorPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
+		case 58:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchErrorRescanAfter", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.WatchErrorRescanAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchErrorRescanAfter |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 59:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilesystemType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashNewestFirst", wireType)
 			}
-			m.FilesystemType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1093,16 +3285,17 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FilesystemType |= fs.FilesystemType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
+			m.HashNewestFirst = bool(v != 0)
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullEventsPerS", wireType)
+			}
+			m.PullEventsPerS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1112,29 +3305,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PullEventsPerS |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 61:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPullRetries", wireType)
 			}
-			m.Type = 0
+			m.MaxPullRetries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1144,16 +3324,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= FolderType(b&0x7F) << shift
+				m.MaxPullRetries |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+		case 62:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFallback", wireType)
 			}
-			var msglen int
+			m.SourceFallback = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1163,31 +3343,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SourceFallback |= SourceFallback(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Devices = append(m.Devices, FolderDeviceConfiguration{})
-			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 63:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RescanIntervalS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
 			}
-			m.RescanIntervalS = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1197,16 +3362,17 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RescanIntervalS |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			m.AuditLog = bool(v != 0)
+		case 64:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FSWatcherEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogMaxEntries", wireType)
 			}
-			var v int
+			m.AuditLogMaxEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1216,17 +3382,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.AuditLogMaxEntries |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FSWatcherEnabled = bool(v != 0)
-		case 9:
+		case 65:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FSWatcherDelayS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogMaxAgeDays", wireType)
 			}
-			m.FSWatcherDelayS = 0
+			m.AuditLogMaxAgeDays = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1236,16 +3401,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FSWatcherDelayS |= int(b&0x7F) << shift
+				m.AuditLogMaxAgeDays |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 66:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnorePerms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanWorkers", wireType)
 			}
-			var v int
+			m.ScanWorkers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1255,15 +3420,14 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ScanWorkers |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IgnorePerms = bool(v != 0)
-		case 11:
+		case 67:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoNormalize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanChangedDirsOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1280,12 +3444,12 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AutoNormalize = bool(v != 0)
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDiskFree", wireType)
+			m.ScanChangedDirsOnly = bool(v != 0)
+		case 68:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullRescanEvery", wireType)
 			}
-			var msglen int
+			m.FullRescanEvery = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1295,30 +3459,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FullRescanEvery |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinDiskFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versioning", wireType)
+		case 69:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameDetectionMode", wireType)
 			}
-			var msglen int
+			m.RenameDetectionMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1328,30 +3478,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RenameDetectionMode |= RenameDetectionMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Versioning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 70:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Copiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuzzyRenameThresholdPct", wireType)
 			}
-			m.Copiers = 0
+			m.FuzzyRenameThresholdPct = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1361,16 +3497,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Copiers |= int(b&0x7F) << shift
+				m.FuzzyRenameThresholdPct |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 71:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullerMaxPendingKiB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScanReadBandwidth", wireType)
 			}
-			m.PullerMaxPendingKiB = 0
+			m.MaxScanReadBandwidth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1380,16 +3516,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PullerMaxPendingKiB |= int(b&0x7F) << shift
+				m.MaxScanReadBandwidth |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 72:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hashers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveOnlyRevertIntervalS", wireType)
 			}
-			m.Hashers = 0
+			m.ReceiveOnlyRevertIntervalS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1399,16 +3535,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Hashers |= int(b&0x7F) << shift
+				m.ReceiveOnlyRevertIntervalS |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+		case 73:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeterministicRescan", wireType)
 			}
-			m.Order = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1418,14 +3554,15 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Order |= PullOrder(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+			m.DeterministicRescan = bool(v != 0)
+		case 74:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchDropIgnoredEvents", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1442,12 +3579,12 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IgnoreDelete = bool(v != 0)
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScanProgressIntervalS", wireType)
+			m.WatchDropIgnoredEvents = bool(v != 0)
+		case 75:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPullCommand", wireType)
 			}
-			m.ScanProgressIntervalS = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1457,16 +3594,29 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScanProgressIntervalS |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostPullCommand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 76:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullerPauseS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFileErrors", wireType)
 			}
-			m.PullerPauseS = 0
+			m.MaxFileErrors = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1476,16 +3626,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PullerPauseS |= int(b&0x7F) << shift
+				m.MaxFileErrors |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
+		case 77:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxConflicts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowRootSymlink", wireType)
 			}
-			m.MaxConflicts = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1495,14 +3645,15 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxConflicts |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 22:
+			m.FollowRootSymlink = bool(v != 0)
+		case 78:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableSparseFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferScanWhilePulling", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1519,10 +3670,10 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableSparseFiles = bool(v != 0)
-		case 23:
+			m.DeferScanWhilePulling = bool(v != 0)
+		case 79:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableTempIndexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSharedVolumeDatabaseSpaceCheck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1539,10 +3690,10 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableTempIndexes = bool(v != 0)
-		case 24:
+			m.SkipSharedVolumeDatabaseSpaceCheck = bool(v != 0)
+		case 80:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyBeforePull", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1559,12 +3710,12 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
-		case 25:
+			m.VerifyBeforePull = bool(v != 0)
+		case 81:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WeakHashThresholdPct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameCacheEntries", wireType)
 			}
-			m.WeakHashThresholdPct = 0
+			m.RenameCacheEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1574,16 +3725,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WeakHashThresholdPct |= int(b&0x7F) << shift
+				m.RenameCacheEntries |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerName", wireType)
+		case 82:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFileAgeS", wireType)
 			}
-			var stringLen uint64
+			m.MinFileAgeS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1593,29 +3744,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinFileAgeS |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFolderconfiguration
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarkerName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 27:
+		case 83:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CopyOwnershipFromParent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepTemporariesH", wireType)
 			}
-			var v int
+			m.KeepTemporariesH = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1625,17 +3763,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.KeepTemporariesH |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CopyOwnershipFromParent = bool(v != 0)
-		case 28:
+		case 84:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawModTimeWindowS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptivePullPause", wireType)
 			}
-			m.RawModTimeWindowS = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1645,16 +3782,17 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RawModTimeWindowS |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 29:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentWrites", wireType)
+			m.AdaptivePullPause = bool(v != 0)
+		case 85:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanWindows", wireType)
 			}
-			m.MaxConcurrentWrites = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1664,14 +3802,27 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxConcurrentWrites |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 30:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScanWindows = append(m.ScanWindows, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 86:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableFsync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanWindowsDeferWatcher", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1688,12 +3839,12 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableFsync = bool(v != 0)
-		case 31:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockPullOrder", wireType)
+			m.ScanWindowsDeferWatcher = bool(v != 0)
+		case 87:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictNameTemplate", wireType)
 			}
-			m.BlockPullOrder = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1703,16 +3854,29 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockPullOrder |= BlockPullOrder(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 32:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictNameTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 88:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CopyRangeMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreHidden", wireType)
 			}
-			m.CopyRangeMethod = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1722,14 +3886,15 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CopyRangeMethod |= fs.CopyRangeMethod(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 33:
+			m.IgnoreHidden = bool(v != 0)
+		case 89:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CaseSensitiveFS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OneFilesystem", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1746,12 +3911,12 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.CaseSensitiveFS = bool(v != 0)
-		case 34:
+			m.OneFilesystem = bool(v != 0)
+		case 90:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JunctionsAsDirs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScanDurationM", wireType)
 			}
-			var v int
+			m.MaxScanDurationM = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1761,12 +3926,11 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxScanDurationM |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JunctionsAsDirs = bool(v != 0)
 		case 9000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReadOnly", wireType)
@@ -55,6 +55,9 @@ func (opts *OptionsConfiguration) prepare(guiPWIsSet bool) {
 	if opts.ConnectionLimitMax < 0 {
 		opts.ConnectionLimitMax = 0
 	}
+	if opts.MaxScanningFolders < 0 {
+		opts.MaxScanningFolders = 0
+	}
 }
 
 // RequiresRestartOnly returns a copy with only the attributes that require
@@ -81,6 +81,12 @@ type OptionsConfiguration struct {
 	// meaning no limit. Affects incoming connections and prevents
 	// attempting outgoing connections.
 	ConnectionLimitMax int `protobuf:"varint,52,opt,name=connection_limit_max,json=connectionLimitMax,proto3,casttype=int" json:"connectionLimitMax" xml:"connectionLimitMax"`
+	// The maximum number of folders which may be scanning at the same
+	// time, zero meaning no limit other than max_folder_concurrency.
+	MaxScanningFolders int `protobuf:"varint,53,opt,name=max_scanning_folders,json=maxScanningFolders,proto3,casttype=int" json:"maxScanningFolders" xml:"maxScanningFolders"`
+	// How long to wait on shutdown for folders to finish their current
+	// database batch and stop on their own, before cancelling them.
+	ShutdownTimeoutS int `protobuf:"varint,54,opt,name=shutdown_timeout_s,json=shutdownTimeoutS,proto3,casttype=int" json:"shutdownTimeoutS" xml:"shutdownTimeoutS" default:"10"`
 	// Legacy deprecated
 	DeprecatedUPnPEnabled        bool     `protobuf:"varint,9000,opt,name=upnp_enabled,json=upnpEnabled,proto3" json:"-" xml:"upnpEnabled,omitempty"`                                    // Deprecated: Do not use.
 	DeprecatedUPnPLeaseM         int      `protobuf:"varint,9001,opt,name=upnp_lease_m,json=upnpLeaseM,proto3,casttype=int" json:"-" xml:"upnpLeaseMinutes,omitempty"`                   // Deprecated: Do not use.
@@ -133,209 +139,212 @@ func init() {
 }
 
 var fileDescriptor_d09882599506ca03 = []byte{
-	// 3218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5d, 0x6c, 0x1d, 0x47,
-	0xf5, 0xcf, 0x26, 0x4d, 0xda, 0x6c, 0x1c, 0x27, 0x1e, 0x3b, 0xf6, 0x36, 0x49, 0xbd, 0xee, 0xcd,
-	0x4d, 0xeb, 0x7e, 0x25, 0xb6, 0x93, 0xe6, 0x9f, 0x46, 0xfa, 0xab, 0xf8, 0xa3, 0x26, 0x6e, 0xec,
-	0xc4, 0x1a, 0xdb, 0x2a, 0x2a, 0x42, 0xab, 0xb9, 0x7b, 0xe7, 0xda, 0x8b, 0xf7, 0xce, 0xde, 0xee,
-	0xcc, 0xfa, 0xda, 0x2d, 0x82, 0xaa, 0x88, 0x8f, 0x37, 0xc0, 0xe2, 0x43, 0x02, 0x09, 0x15, 0x01,
-	0x12, 0xa5, 0x14, 0x21, 0x21, 0x21, 0xc1, 0x0b, 0x08, 0x09, 0xa9, 0x82, 0x07, 0xfb, 0xb1, 0x12,
-	0x65, 0x51, 0x9d, 0x3e, 0xdd, 0x07, 0x1e, 0xee, 0xa3, 0x79, 0x41, 0x33, 0xfb, 0x35, 0xbb, 0x3b,
-	0xb7, 0xc9, 0xdb, 0xdd, 0xf3, 0x3b, 0x73, 0xe6, 0x77, 0xe6, 0xe3, 0xcc, 0x39, 0x33, 0x57, 0xbf,
-	0xec, 0x3a, 0xb5, 0xab, 0xb6, 0x47, 0x1a, 0xce, 0xfa, 0x55, 0xaf, 0xc5, 0x1c, 0x8f, 0xd0, 0xe8,
-	0x2b, 0xf0, 0x11, 0xff, 0xba, 0xd2, 0xf2, 0x3d, 0xe6, 0x81, 0x13, 0x91, 0xf0, 0xfc, 0x88, 0xa4,
-	0xce, 0x02, 0xe2, 0x90, 0xf5, 0x48, 0xe1, 0xfc, 0x39, 0x09, 0xa0, 0xce, 0x9b, 0x38, 0x16, 0x9f,
-	0xc4, 0xdb, 0x2c, 0xfa, 0x59, 0xf9, 0xe8, 0xb6, 0x3e, 0x74, 0x2f, 0xea, 0x61, 0x56, 0xee, 0x01,
-	0xfc, 0x54, 0xd3, 0xcf, 0xba, 0x0e, 0x65, 0x98, 0x58, 0xa8, 0x5e, 0xf7, 0x31, 0xa5, 0x98, 0x1a,
-	0xda, 0xd8, 0xb1, 0xf1, 0x93, 0x33, 0xf4, 0x20, 0x34, 0x01, 0x44, 0xed, 0x45, 0x01, 0x4f, 0x27,
-	0x68, 0x27, 0x34, 0xcf, 0xb8, 0x79, 0x51, 0x37, 0x34, 0x2f, 0x6f, 0x37, 0xdd, 0x5b, 0x95, 0x9c,
-	0xbc, 0x32, 0x56, 0xc7, 0x0d, 0x14, 0xb8, 0xec, 0x56, 0x25, 0xfe, 0x51, 0x39, 0xdc, 0xab, 0x3e,
-	0x1a, 0xff, 0xde, 0xdd, 0xaf, 0x2a, 0x8c, 0xc3, 0xa2, 0x69, 0xf0, 0x1f, 0x4d, 0x37, 0xd6, 0x5d,
-	0xaf, 0x86, 0x5c, 0xab, 0xee, 0x50, 0xdb, 0xdb, 0xc2, 0xfe, 0x8e, 0x45, 0xb1, 0xbf, 0x85, 0x7d,
-	0x6a, 0x1c, 0x15, 0x44, 0x7f, 0xaf, 0x1d, 0x84, 0xe6, 0x20, 0x44, 0xed, 0xcf, 0x0b, 0xbd, 0x69,
-	0x42, 0x56, 0x22, 0xbc, 0x13, 0x9a, 0xe7, 0xd6, 0x13, 0x99, 0x17, 0x10, 0x1b, 0xc7, 0x40, 0x37,
-	0x34, 0x9f, 0x17, 0x84, 0x55, 0xa8, 0x82, 0x77, 0x67, 0xaf, 0x3a, 0xa4, 0x52, 0xed, 0xee, 0x55,
-	0xd5, 0x1d, 0xe4, 0x1d, 0x55, 0x71, 0x83, 0xc3, 0x51, 0xc3, 0xb9, 0xc4, 0xa9, 0x58, 0x0e, 0x3e,
-	0x55, 0x39, 0x8c, 0x09, 0xaa, 0xb9, 0xb8, 0x6e, 0x1c, 0x1b, 0xd3, 0xc6, 0x1f, 0x9b, 0x79, 0x8f,
-	0x3b, 0x7c, 0x36, 0xb5, 0xf8, 0x4a, 0x04, 0x96, 0xbd, 0x8d, 0x81, 0x6e, 0x68, 0x3e, 0xab, 0xf0,
-	0x36, 0x46, 0x25, 0x77, 0x99, 0x1f, 0x60, 0xee, 0x6b, 0x0f, 0x33, 0xbd, 0x80, 0xc3, 0xbd, 0xea,
-	0x23, 0xbc, 0xe9, 0xee, 0x7e, 0xb5, 0x44, 0xaa, 0xe4, 0x66, 0x2c, 0x07, 0x1f, 0x6b, 0xfa, 0x88,
-	0xeb, 0xd9, 0x4a, 0x2f, 0x1f, 0x11, 0x5e, 0xfe, 0x9c, 0x7b, 0x79, 0x66, 0x91, 0xeb, 0xe4, 0x9c,
-	0x1c, 0x72, 0x63, 0x51, 0xc1, 0xc7, 0x67, 0xa2, 0x25, 0xa8, 0x00, 0x15, 0x2e, 0xaa, 0x8d, 0xf4,
-	0x90, 0x4b, 0x0e, 0x16, 0xf9, 0xc0, 0x73, 0xa2, 0x41, 0xc9, 0xbd, 0x7f, 0x68, 0xfa, 0x60, 0xe4,
-	0x1e, 0x8a, 0x6d, 0x59, 0x2d, 0xcf, 0x67, 0xc6, 0xf1, 0x31, 0x6d, 0xfc, 0xf8, 0xcc, 0x8f, 0xb9,
-	0x6b, 0x7d, 0x89, 0xa9, 0x65, 0xcf, 0x67, 0x9d, 0xd0, 0x1c, 0xc8, 0x75, 0xcd, 0x85, 0xdd, 0xd0,
-	0x7c, 0xba, 0xec, 0x14, 0x47, 0x24, 0x8f, 0xa6, 0x26, 0x27, 0xa6, 0xfe, 0xaf, 0x72, 0x18, 0x9a,
-	0xc7, 0x1c, 0xc2, 0x3a, 0x7b, 0x55, 0x85, 0x19, 0x95, 0xf0, 0x70, 0xaf, 0x7a, 0x5c, 0x34, 0xdd,
-	0xdd, 0xaf, 0xe6, 0x98, 0xc0, 0xb2, 0x2e, 0xf8, 0xfa, 0x51, 0x7d, 0xac, 0xe0, 0x4d, 0x33, 0x70,
-	0x99, 0x63, 0x23, 0xca, 0x92, 0xb8, 0x61, 0x9c, 0x18, 0xd3, 0xc6, 0x4f, 0xce, 0xfc, 0x91, 0xbb,
-	0xd6, 0x9f, 0x18, 0x5c, 0x9a, 0xe5, 0x3b, 0xb9, 0x13, 0x9a, 0x83, 0x39, 0xa3, 0x91, 0xb8, 0x1b,
-	0x9a, 0x37, 0xca, 0xee, 0x45, 0x98, 0xe4, 0xe0, 0x17, 0x1b, 0x8d, 0xc9, 0xa9, 0x5b, 0xb7, 0x6e,
-	0x5e, 0xbb, 0x79, 0xfd, 0x4b, 0xb7, 0x22, 0x6f, 0x3b, 0x7b, 0x55, 0xa5, 0x41, 0xb5, 0xf8, 0x70,
-	0xaf, 0x0a, 0xca, 0x46, 0x76, 0xf7, 0xab, 0x05, 0x9a, 0xf0, 0x89, 0x7c, 0xe3, 0xc4, 0xc3, 0x38,
-	0x18, 0x81, 0x7b, 0xfa, 0xe9, 0x26, 0xda, 0xb6, 0x28, 0x26, 0x75, 0x6b, 0xb3, 0xd6, 0xa2, 0xc6,
-	0xa3, 0x62, 0x32, 0x9f, 0xeb, 0x84, 0xe6, 0xa9, 0x26, 0xda, 0x5e, 0xc1, 0xa4, 0x7e, 0xa7, 0xd6,
-	0xe2, 0xc1, 0x65, 0x40, 0xb8, 0x25, 0xc9, 0x92, 0xf9, 0x81, 0xb2, 0x62, 0x62, 0xd0, 0xc7, 0xf6,
-	0x56, 0x64, 0xf0, 0xb1, 0x9c, 0x41, 0x88, 0xed, 0xad, 0xa2, 0xc1, 0x44, 0x96, 0x33, 0x98, 0x08,
-	0xc1, 0x1f, 0x34, 0x7d, 0xc4, 0xc7, 0xb6, 0x47, 0x08, 0xb6, 0x79, 0x78, 0xb7, 0x1c, 0xc2, 0xb0,
-	0xbf, 0x85, 0x5c, 0x8b, 0x1a, 0x27, 0x85, 0xed, 0xaf, 0x8a, 0xa0, 0x9e, 0xa8, 0x2c, 0xc4, 0xf0,
-	0x0a, 0x8f, 0x1d, 0x72, 0xc3, 0x14, 0xe8, 0x86, 0xe6, 0xb8, 0xe8, 0x5b, 0x89, 0x4a, 0xb3, 0x74,
-	0x63, 0x22, 0xa1, 0x74, 0xb8, 0x57, 0x3d, 0x7a, 0x63, 0x42, 0xc4, 0xf7, 0x52, 0x3f, 0x50, 0xdd,
-	0x0b, 0x68, 0xe8, 0xfd, 0x3e, 0x76, 0xd1, 0x0e, 0x4d, 0x63, 0x80, 0x2e, 0x62, 0xc0, 0xcb, 0x9d,
-	0xd0, 0x3c, 0x1d, 0x21, 0xd9, 0x46, 0xaf, 0xc4, 0x84, 0x24, 0x69, 0x71, 0x87, 0x27, 0x3b, 0x16,
-	0xe6, 0x1b, 0x83, 0x77, 0x8e, 0xea, 0x17, 0xe2, 0x8e, 0x52, 0x22, 0xd9, 0x20, 0x35, 0x8d, 0x53,
-	0x62, 0x90, 0xfe, 0xca, 0xd7, 0xf0, 0x08, 0xe4, 0x7a, 0x25, 0x17, 0x96, 0x3a, 0xa1, 0x39, 0xe2,
-	0xab, 0xa1, 0x34, 0xd0, 0xf6, 0xc0, 0x25, 0x96, 0x93, 0x13, 0xd2, 0x96, 0xed, 0x69, 0xaf, 0x37,
-	0xc4, 0x07, 0x79, 0x92, 0x0f, 0x72, 0x2f, 0x9a, 0xd0, 0x88, 0xfc, 0x2c, 0x23, 0xa0, 0xa6, 0x9f,
-	0xa6, 0x0c, 0xf9, 0xcc, 0xaa, 0xf9, 0x5e, 0x9b, 0x62, 0xdf, 0xe8, 0x13, 0x63, 0xfd, 0xff, 0x9d,
-	0xd0, 0xec, 0x13, 0xc0, 0x4c, 0x24, 0xef, 0x86, 0xe6, 0x93, 0xc2, 0x1d, 0x59, 0xd8, 0x73, 0xa4,
-	0x73, 0x4d, 0xc1, 0x2f, 0x35, 0xfd, 0x1c, 0x41, 0xcc, 0x62, 0x3e, 0xe2, 0xa7, 0x1a, 0x72, 0xd3,
-	0x89, 0xed, 0x17, 0x9d, 0xbd, 0x71, 0x10, 0x9a, 0xfa, 0xdd, 0xe9, 0xd5, 0x2c, 0xac, 0xeb, 0x04,
-	0xb1, 0x6c, 0x8e, 0x4d, 0xd1, 0x71, 0x26, 0x52, 0x84, 0x70, 0xb9, 0x41, 0xee, 0x4b, 0x0a, 0xd7,
-	0x52, 0x17, 0x70, 0x90, 0x20, 0xb6, 0x9a, 0xd0, 0x49, 0x16, 0xc4, 0x9f, 0x4a, 0x3c, 0x5d, 0x8c,
-	0x28, 0xb6, 0x9a, 0xc6, 0x19, 0xb1, 0x14, 0xbe, 0xc9, 0x97, 0xc2, 0xc9, 0xbb, 0xd3, 0xab, 0x8b,
-	0x5c, 0xcc, 0x27, 0xff, 0x0c, 0x41, 0x2c, 0xfa, 0x70, 0x48, 0xc0, 0x44, 0xf2, 0x53, 0x49, 0xc8,
-	0xca, 0x72, 0xe5, 0xde, 0xe8, 0xec, 0x55, 0x4b, 0xed, 0xcb, 0xa2, 0x74, 0x07, 0x65, 0x1d, 0x43,
-	0x20, 0xb3, 0x8f, 0x64, 0xe0, 0xef, 0x9a, 0x3e, 0x92, 0x27, 0xef, 0x63, 0x82, 0xdb, 0x62, 0x25,
-	0x9f, 0x15, 0xf4, 0x77, 0x39, 0xfd, 0x53, 0x77, 0xa7, 0x57, 0x61, 0x04, 0x70, 0x07, 0x06, 0x08,
-	0x62, 0xc9, 0x67, 0xea, 0x42, 0x35, 0x71, 0x21, 0x8f, 0x48, 0x4e, 0x5c, 0x93, 0x9d, 0x50, 0xd8,
-	0x50, 0x09, 0xb9, 0x23, 0xd7, 0xb8, 0x23, 0x32, 0x05, 0x38, 0x24, 0xbb, 0x92, 0x48, 0x15, 0xce,
-	0x30, 0xa7, 0x89, 0xbd, 0x80, 0x59, 0xd4, 0x18, 0xc8, 0x3b, 0xb3, 0x1a, 0x01, 0x2b, 0xb1, 0x33,
-	0xc9, 0x27, 0x5f, 0xe9, 0xf5, 0x9c, 0x33, 0x79, 0xa4, 0xd7, 0xf6, 0x53, 0xd8, 0x50, 0x09, 0xd3,
-	0x2d, 0x27, 0x53, 0xc8, 0x3b, 0x93, 0x48, 0xc1, 0x4f, 0x34, 0xdd, 0x08, 0x28, 0x5a, 0xc7, 0x96,
-	0x8f, 0xf9, 0xb9, 0xef, 0x90, 0x75, 0x0b, 0xd9, 0x36, 0x6e, 0x31, 0x5c, 0x37, 0x80, 0xf0, 0x06,
-	0xf1, 0x1d, 0xb0, 0x06, 0xa7, 0x63, 0x29, 0xdf, 0x01, 0x81, 0x9f, 0x7c, 0x75, 0x43, 0xf3, 0xac,
-	0x70, 0x22, 0x13, 0x49, 0x84, 0x65, 0xc5, 0xdc, 0x17, 0x5f, 0xf1, 0x99, 0x49, 0x38, 0x2c, 0x28,
-	0xc0, 0x84, 0x41, 0x22, 0x07, 0x6f, 0xe9, 0x43, 0x45, 0x72, 0x14, 0x63, 0x62, 0x0c, 0x0a, 0x62,
-	0x0b, 0x07, 0xa1, 0x79, 0x62, 0x0d, 0xae, 0x60, 0x4c, 0x3a, 0xa1, 0x79, 0x22, 0xf0, 0xf9, 0xaf,
-	0x6e, 0x68, 0xf6, 0xc5, 0x84, 0xf8, 0xa7, 0x44, 0x26, 0x51, 0x48, 0x7f, 0xed, 0xee, 0x57, 0xe3,
-	0xe6, 0x10, 0xe4, 0x09, 0x70, 0x19, 0xf8, 0x81, 0xa6, 0x3f, 0x5e, 0xec, 0x3d, 0x20, 0xce, 0x1b,
-	0x01, 0xb6, 0x9c, 0xba, 0x31, 0x24, 0x92, 0x88, 0xd7, 0xa3, 0xb1, 0x59, 0x13, 0xe2, 0x85, 0xb9,
-	0x68, 0x6c, 0xe2, 0x2f, 0x79, 0x6c, 0x12, 0x85, 0x4a, 0x34, 0x28, 0xc9, 0x67, 0x57, 0xfe, 0x8a,
-	0x07, 0x25, 0xc1, 0x8a, 0x83, 0x92, 0x68, 0x81, 0xbf, 0x68, 0xfa, 0x60, 0x89, 0x97, 0xef, 0x1a,
-	0xe7, 0x04, 0xa3, 0xef, 0xf0, 0xb5, 0x77, 0x7c, 0x0d, 0xae, 0xc1, 0xc5, 0x4e, 0x68, 0x1e, 0x0f,
-	0xfc, 0x35, 0xb8, 0xd8, 0x0d, 0xcd, 0x9b, 0x09, 0x11, 0xb8, 0x28, 0xad, 0xae, 0x0d, 0xc6, 0x5a,
-	0xf4, 0xd6, 0xd5, 0xab, 0x75, 0xc4, 0xd0, 0x15, 0xba, 0x43, 0x6c, 0xb6, 0xc1, 0x8b, 0x35, 0x82,
-	0xd9, 0x55, 0x82, 0xdb, 0x5c, 0xca, 0x09, 0xc7, 0x46, 0x92, 0x1f, 0x87, 0x7b, 0xd5, 0x87, 0x68,
-	0xb8, 0xbb, 0x5f, 0x8d, 0x58, 0xc0, 0x81, 0x82, 0x1f, 0xbe, 0x0b, 0xfe, 0xad, 0xe9, 0x66, 0xd1,
-	0x85, 0x96, 0x47, 0xf9, 0x09, 0x47, 0xb1, 0x1d, 0xf8, 0xd8, 0xdd, 0x31, 0x86, 0x45, 0xf8, 0xfd,
-	0x91, 0xa8, 0x20, 0xd6, 0xe0, 0xb2, 0x47, 0xd9, 0x42, 0x0a, 0x76, 0x42, 0xf3, 0x6c, 0xe0, 0xe7,
-	0x65, 0xdd, 0xd0, 0x7c, 0x2a, 0x76, 0x32, 0x0f, 0x48, 0xfe, 0x36, 0x90, 0x4b, 0x45, 0x48, 0x2e,
-	0xb7, 0x56, 0xc8, 0x78, 0xe6, 0x29, 0x5a, 0xf0, 0x7a, 0xa1, 0x48, 0x01, 0x5e, 0xcc, 0xbb, 0x95,
-	0x47, 0xc1, 0xbf, 0x14, 0x1e, 0x3a, 0xc4, 0x61, 0x0e, 0xaf, 0x23, 0xf8, 0x79, 0x67, 0x51, 0x63,
-	0x44, 0xac, 0xe2, 0x1f, 0x8a, 0xea, 0x61, 0x0d, 0x2e, 0x44, 0xe8, 0x1c, 0x07, 0x79, 0xc0, 0x38,
-	0x13, 0xf8, 0x39, 0x51, 0x1a, 0x2e, 0x0a, 0x72, 0x39, 0x58, 0xdc, 0x9c, 0xc8, 0x05, 0xf0, 0xa2,
-	0x85, 0xb2, 0x88, 0x9f, 0x40, 0xbc, 0x15, 0x2f, 0x18, 0x0a, 0x14, 0xe0, 0x85, 0xbc, 0x83, 0x39,
-	0x10, 0x78, 0xfa, 0x80, 0x8f, 0xa3, 0xc3, 0xd9, 0x23, 0x56, 0x1b, 0x6d, 0xe2, 0xa0, 0x65, 0x18,
-	0x62, 0xca, 0x66, 0x39, 0xf9, 0x18, 0xbc, 0x47, 0x5e, 0x13, 0x50, 0x4a, 0xbe, 0x20, 0xef, 0x79,
-	0x48, 0x17, 0x0d, 0x80, 0x6f, 0x69, 0xfa, 0x08, 0x0a, 0x98, 0x67, 0x05, 0xad, 0x75, 0x1f, 0xd5,
-	0x71, 0x96, 0x0c, 0x6d, 0x18, 0x8f, 0x8b, 0x81, 0x5c, 0xe6, 0x25, 0x17, 0x57, 0x59, 0x8b, 0x34,
-	0x92, 0x3c, 0xe2, 0x76, 0x5a, 0x9d, 0xa8, 0x40, 0x79, 0xf8, 0xa6, 0xe4, 0xcc, 0x70, 0x72, 0x0a,
-	0x2a, 0xad, 0x81, 0xa6, 0x3e, 0x92, 0x70, 0x60, 0x9e, 0xd5, 0xf2, 0xf9, 0x14, 0x8b, 0xb3, 0x98,
-	0x1a, 0xe7, 0xc5, 0x00, 0xdc, 0xe0, 0x44, 0x62, 0x95, 0x55, 0x6f, 0xd9, 0xc7, 0x30, 0xc6, 0xbb,
-	0xa1, 0x79, 0x3e, 0x9a, 0x42, 0x05, 0x58, 0x81, 0xca, 0x36, 0x60, 0x4b, 0x07, 0x9b, 0x18, 0xb7,
-	0x2c, 0x86, 0x9b, 0x2d, 0xcf, 0x47, 0xbe, 0x83, 0xa9, 0xb5, 0x61, 0x5c, 0x10, 0x2e, 0xdf, 0xe6,
-	0x1b, 0x81, 0xa3, 0xab, 0x19, 0xc8, 0xdd, 0xbd, 0x24, 0x7a, 0x29, 0x02, 0x72, 0x2d, 0x76, 0x5d,
-	0x76, 0x75, 0xea, 0x3a, 0x2c, 0x59, 0x01, 0x3b, 0xfa, 0xa0, 0x8d, 0xec, 0x0d, 0x6c, 0x39, 0xeb,
-	0xc4, 0xf3, 0x71, 0xdd, 0x6a, 0x38, 0x2e, 0xa6, 0xc6, 0x45, 0xe1, 0xe2, 0x02, 0x3f, 0xd1, 0x04,
-	0xbc, 0x10, 0xa1, 0xf3, 0x1c, 0x4c, 0x07, 0xba, 0x84, 0x94, 0xf6, 0x60, 0xba, 0xb7, 0x60, 0xd9,
-	0x0c, 0xf8, 0x9e, 0xa6, 0x9f, 0x6f, 0xf9, 0xde, 0x3a, 0x2f, 0x66, 0xac, 0xa0, 0x55, 0x47, 0x0c,
-	0xcb, 0x05, 0xc2, 0x13, 0xc2, 0xf7, 0x55, 0x9e, 0xdf, 0x26, 0x5a, 0x6b, 0x42, 0x49, 0x2e, 0x06,
-	0xa2, 0x22, 0xbb, 0x07, 0x2e, 0xd1, 0x79, 0x51, 0x1a, 0x08, 0xed, 0x45, 0xd8, 0xcb, 0x22, 0x78,
-	0x47, 0xd3, 0x87, 0x5d, 0xa7, 0xe9, 0x30, 0xab, 0x86, 0x48, 0xbd, 0xed, 0xd4, 0xd9, 0x86, 0xe5,
-	0x10, 0xcb, 0x45, 0xc4, 0x18, 0x15, 0x43, 0xb2, 0x24, 0x8a, 0x47, 0xae, 0x31, 0x93, 0x28, 0x2c,
-	0x90, 0x45, 0x44, 0xb2, 0x82, 0xbf, 0x8c, 0x7d, 0xc6, 0xb0, 0xa8, 0x4c, 0x81, 0xb7, 0x35, 0x1d,
-	0x34, 0x1d, 0x62, 0x6d, 0x78, 0x4d, 0x6c, 0xd5, 0x1d, 0xba, 0x69, 0x35, 0x7c, 0x8c, 0x0d, 0x73,
-	0x4c, 0x1b, 0x3f, 0x35, 0xd5, 0x77, 0x25, 0xba, 0x59, 0xbb, 0xb2, 0xe2, 0xbc, 0x89, 0x67, 0x5e,
-	0xf9, 0x30, 0x34, 0x8f, 0xf0, 0x9d, 0xd8, 0x74, 0xc8, 0x6d, 0xaf, 0x89, 0xe7, 0x1c, 0xba, 0x39,
-	0xef, 0x63, 0x9c, 0xae, 0x8e, 0x82, 0x5c, 0xde, 0x07, 0x63, 0x97, 0x39, 0x91, 0x63, 0x93, 0x63,
-	0x97, 0x61, 0xb1, 0x39, 0xb8, 0xaf, 0xe9, 0x7d, 0xc9, 0x7a, 0x17, 0xc7, 0xce, 0x98, 0x38, 0x76,
-	0xfe, 0x2c, 0x52, 0x9e, 0x64, 0xd1, 0x46, 0x87, 0xcf, 0x29, 0x3f, 0xfb, 0xec, 0x86, 0xe6, 0x5c,
-	0x52, 0x71, 0x24, 0x32, 0xc5, 0x41, 0x14, 0xef, 0x00, 0x5a, 0x38, 0x53, 0x9a, 0x98, 0xa1, 0x2b,
-	0x5f, 0xa6, 0x1e, 0xe1, 0xb1, 0x3b, 0x67, 0x36, 0xff, 0x79, 0xb8, 0x57, 0x1d, 0x7f, 0x58, 0x53,
-	0x3c, 0x3f, 0x92, 0xf8, 0xc2, 0xcc, 0x8e, 0xef, 0x82, 0xd7, 0xf4, 0x01, 0xe4, 0xb6, 0x79, 0xf5,
-	0x15, 0xdd, 0x26, 0x10, 0xcc, 0xa8, 0xf1, 0xa4, 0xb8, 0xc4, 0xe3, 0x45, 0xef, 0x99, 0x08, 0x14,
-	0x55, 0xf9, 0x5d, 0xcc, 0xf8, 0xc2, 0x1f, 0x8a, 0x22, 0x4c, 0x4e, 0x5e, 0x81, 0x45, 0x45, 0xf0,
-	0x5f, 0x4d, 0x1f, 0xf7, 0xb6, 0xb0, 0xdf, 0xf6, 0x1d, 0xc6, 0x03, 0x47, 0xd3, 0x63, 0xd8, 0xaa,
-	0xe3, 0x2d, 0xc7, 0xc6, 0x16, 0x41, 0x4d, 0x4c, 0x79, 0x38, 0x8d, 0x0b, 0x21, 0xa3, 0x92, 0x5d,
-	0x2f, 0x8d, 0xdc, 0x4b, 0x1a, 0x41, 0xd1, 0x66, 0x0e, 0x6f, 0xdd, 0xe5, 0xea, 0x9d, 0xd0, 0xbc,
-	0xe4, 0x95, 0x20, 0xc7, 0xc6, 0x02, 0xbd, 0x47, 0x66, 0x23, 0x53, 0xdd, 0xd0, 0x7c, 0x49, 0x10,
-	0x7c, 0x08, 0xdd, 0xde, 0x8b, 0x92, 0x57, 0x71, 0x3d, 0x78, 0xc0, 0x87, 0x61, 0x01, 0xbe, 0xa6,
-	0x9f, 0xe3, 0x61, 0xcc, 0x72, 0x48, 0x1d, 0x6f, 0x5b, 0x7c, 0x25, 0xd7, 0x5c, 0xcf, 0xde, 0xa4,
-	0xc6, 0x25, 0xb1, 0xa5, 0xf9, 0xa2, 0x01, 0x5c, 0x61, 0x81, 0xe3, 0x4b, 0x0e, 0x99, 0x11, 0x68,
-	0x7a, 0x6b, 0x5b, 0x86, 0x94, 0x99, 0x72, 0x94, 0xff, 0x42, 0x85, 0x25, 0xf0, 0x4f, 0x9e, 0xee,
-	0x12, 0x64, 0x6f, 0xe2, 0xba, 0x45, 0x3c, 0xe6, 0x34, 0x1c, 0x1b, 0x45, 0xf7, 0x0f, 0x75, 0x6a,
-	0x54, 0xc5, 0xfc, 0xbe, 0xcb, 0x87, 0x7b, 0x78, 0x2d, 0x52, 0xba, 0x2b, 0xe9, 0x2c, 0xcc, 0xf1,
-	0xd1, 0x1e, 0x0e, 0x94, 0x48, 0x37, 0x34, 0x2f, 0x44, 0xa1, 0x5d, 0x05, 0x8b, 0xbb, 0x4a, 0x25,
-	0xd2, 0xdd, 0xab, 0xf6, 0xb0, 0xb8, 0xbb, 0x5f, 0xed, 0xc1, 0x02, 0x2a, 0x5b, 0xd4, 0x29, 0x80,
-	0xfa, 0x69, 0xe6, 0xa3, 0x46, 0xc3, 0xb1, 0x2d, 0xdb, 0x45, 0x94, 0x1a, 0x97, 0xc5, 0xb0, 0xbe,
-	0xc0, 0xeb, 0xe5, 0x18, 0x98, 0xe5, 0xf2, 0x6e, 0x68, 0x82, 0x68, 0x40, 0x25, 0x61, 0x7a, 0x51,
-	0x93, 0x53, 0x05, 0x6f, 0xe9, 0x83, 0xf1, 0x10, 0x5b, 0x0d, 0xcf, 0xad, 0x63, 0xdf, 0x6a, 0x21,
-	0xb6, 0x61, 0x3c, 0x25, 0x76, 0xfd, 0x9d, 0x83, 0xd0, 0xbc, 0x30, 0x87, 0x5b, 0x3e, 0xb6, 0x11,
-	0xc3, 0xf5, 0xb9, 0x48, 0x71, 0x5e, 0xe8, 0x2d, 0x23, 0xb6, 0xd1, 0x09, 0x4d, 0xed, 0x85, 0xb4,
-	0x3a, 0xaf, 0x17, 0xe1, 0xe7, 0xbd, 0xa6, 0xc3, 0x27, 0x89, 0xed, 0x54, 0x0c, 0x0d, 0x0e, 0x94,
-	0x70, 0xb0, 0xa9, 0x9f, 0xa5, 0x98, 0x59, 0xae, 0xd7, 0xb6, 0x5a, 0xbe, 0xe3, 0xf9, 0x0e, 0xdb,
-	0x31, 0x9e, 0x16, 0x9b, 0x62, 0xba, 0x13, 0x9a, 0xfd, 0x14, 0xb3, 0x45, 0xaf, 0xbd, 0x1c, 0x23,
-	0x69, 0x64, 0xcb, 0x8b, 0x7b, 0xa6, 0x18, 0x85, 0xe6, 0xe0, 0x3d, 0x4d, 0x1f, 0x6e, 0xa2, 0xed,
-	0xc4, 0x4d, 0xdb, 0x23, 0x76, 0xe0, 0xfb, 0x98, 0xd8, 0x3b, 0xc6, 0xb8, 0x18, 0x47, 0x2a, 0x2e,
-	0x5b, 0x50, 0x7b, 0x09, 0x6d, 0x47, 0x1c, 0x67, 0x33, 0x15, 0x7e, 0xe4, 0x37, 0x15, 0xf2, 0xf4,
-	0xc8, 0x57, 0x81, 0xc9, 0x90, 0x8b, 0xdb, 0x11, 0xb5, 0x5d, 0xa8, 0xb4, 0x0a, 0x3e, 0xd6, 0xf4,
-	0x41, 0xdb, 0x47, 0x74, 0xa3, 0x50, 0x03, 0x3c, 0x23, 0xa6, 0xe5, 0x7d, 0x51, 0x03, 0xcc, 0x26,
-	0x35, 0x80, 0x1d, 0xd7, 0x00, 0xf3, 0xd1, 0xd9, 0xcc, 0x9b, 0x65, 0xd9, 0xb8, 0x32, 0x0c, 0x0b,
-	0x9d, 0x72, 0x5e, 0x2f, 0xc4, 0x7c, 0x2d, 0x0f, 0x94, 0x8c, 0xf0, 0xea, 0xc0, 0x8e, 0xab, 0x83,
-	0xea, 0xc3, 0x98, 0xe1, 0xf5, 0xc1, 0x6c, 0x54, 0x1f, 0x14, 0x8c, 0xf9, 0x2e, 0xf8, 0x99, 0xa6,
-	0x8f, 0x14, 0xdd, 0x4b, 0xae, 0x65, 0x9e, 0x15, 0xf3, 0xef, 0x1c, 0x84, 0xe6, 0xc9, 0x59, 0x28,
-	0xbd, 0x28, 0xe4, 0xad, 0x14, 0x5f, 0x14, 0x94, 0x68, 0xaf, 0xa5, 0xb1, 0xbb, 0x5f, 0xcd, 0x6c,
-	0x43, 0xb5, 0x65, 0xf0, 0x0d, 0x4d, 0x1f, 0xa6, 0x2c, 0x20, 0x16, 0xcf, 0x9c, 0x90, 0xeb, 0x6c,
-	0x61, 0x2b, 0xca, 0x87, 0xa9, 0xf1, 0x5c, 0x9a, 0x8f, 0x0e, 0x72, 0x8d, 0x3b, 0x89, 0xc2, 0x0a,
-	0xc7, 0x57, 0xd2, 0x2c, 0x49, 0x81, 0xe5, 0x93, 0x79, 0x29, 0xa0, 0x1d, 0x9b, 0xbc, 0x39, 0x01,
-	0x55, 0xd6, 0x78, 0x8d, 0x5c, 0xa0, 0xc1, 0xe3, 0x2a, 0x35, 0x9e, 0x17, 0x24, 0x5e, 0xe5, 0x89,
-	0x5a, 0xae, 0xd9, 0x92, 0x43, 0xb2, 0x5a, 0xa2, 0x84, 0xc8, 0x39, 0x62, 0x2e, 0xa0, 0x4e, 0x4d,
-	0xc0, 0xb2, 0x1d, 0x9e, 0x95, 0xf7, 0x89, 0xde, 0x93, 0x87, 0xae, 0x17, 0x44, 0x0c, 0xad, 0x1f,
-	0x84, 0x66, 0x3f, 0x44, 0xed, 0x15, 0x16, 0x48, 0x4f, 0x5c, 0xa7, 0x68, 0xf6, 0x99, 0x5e, 0x46,
-	0x65, 0xb2, 0x07, 0x3e, 0xc3, 0x15, 0x2c, 0x42, 0xd9, 0x1e, 0xd8, 0xd2, 0xcf, 0xf0, 0xb2, 0xb3,
-	0x86, 0x28, 0xb6, 0xa2, 0x37, 0x47, 0xe3, 0xca, 0x98, 0x36, 0xde, 0x3f, 0xd5, 0x9f, 0xa4, 0x45,
-	0xab, 0x42, 0x2a, 0x6e, 0x0f, 0xfb, 0x13, 0xd5, 0x48, 0x96, 0x46, 0x8e, 0xbc, 0xb8, 0x32, 0x16,
-	0x17, 0x21, 0xf1, 0xf2, 0x78, 0x7b, 0xbf, 0xaa, 0xc1, 0x42, 0x53, 0xf0, 0xfd, 0xa3, 0xfa, 0x25,
-	0x1e, 0x35, 0xd2, 0x70, 0xc1, 0x8b, 0x58, 0xdb, 0x6b, 0xf2, 0x25, 0xeb, 0xe3, 0x37, 0x02, 0x4c,
-	0x99, 0xb5, 0xe9, 0xd4, 0x8c, 0xab, 0x62, 0x3a, 0xfe, 0xa6, 0xc5, 0x6f, 0x95, 0x4b, 0x68, 0x7b,
-	0x76, 0x01, 0x46, 0xf8, 0x1d, 0x67, 0xa6, 0x13, 0x9a, 0x66, 0x13, 0x6d, 0xa7, 0x5b, 0x9c, 0x2d,
-	0xc4, 0x36, 0x32, 0x95, 0xf4, 0x14, 0x7c, 0x80, 0x9e, 0x54, 0x00, 0x3e, 0xd0, 0xe4, 0x83, 0x55,
-	0xe2, 0xd7, 0xcf, 0x02, 0x5d, 0xf8, 0x80, 0x66, 0x35, 0xf0, 0xa9, 0xa6, 0x0f, 0xa7, 0x4f, 0x30,
-	0x2e, 0x92, 0x1f, 0x6d, 0x27, 0xc4, 0x06, 0xfe, 0x80, 0x8f, 0xc4, 0x50, 0xf2, 0x84, 0xb1, 0x38,
-	0x7d, 0x57, 0x7e, 0xb7, 0x1d, 0x42, 0x0a, 0x79, 0x9a, 0x48, 0xab, 0x40, 0xd5, 0xcb, 0x99, 0xd2,
-	0x48, 0x0f, 0xb9, 0xb4, 0xf5, 0x95, 0xa4, 0x60, 0xd6, 0x0a, 0x49, 0x8f, 0xbe, 0x5b, 0xfa, 0x79,
-	0xf1, 0xca, 0xd2, 0x08, 0x5c, 0x37, 0xce, 0x6a, 0x3c, 0x92, 0x94, 0xa8, 0xc6, 0xa4, 0xf0, 0xf4,
-	0x16, 0xcf, 0x1a, 0xb8, 0xd6, 0x7c, 0xe0, 0xba, 0x22, 0x1f, 0xb9, 0x47, 0xe2, 0xa2, 0xb2, 0x1b,
-	0x9a, 0x17, 0xe3, 0x23, 0x4b, 0x05, 0x57, 0x60, 0x8f, 0x76, 0xe0, 0x55, 0xfd, 0x74, 0x03, 0x23,
-	0x16, 0xf8, 0xd8, 0x6a, 0xb8, 0x68, 0x9d, 0x1a, 0x53, 0x62, 0xdf, 0x5d, 0xe6, 0x27, 0x7d, 0x0c,
-	0xcc, 0x73, 0x79, 0xfa, 0x22, 0x23, 0x09, 0x2b, 0x30, 0xa7, 0x02, 0xda, 0xfa, 0x88, 0xf4, 0x10,
-	0x13, 0xd5, 0x38, 0x98, 0x78, 0xc1, 0xfa, 0x86, 0x71, 0x4d, 0x2c, 0xda, 0x97, 0x45, 0x78, 0x4d,
-	0x55, 0x16, 0xb9, 0xc6, 0x2b, 0x42, 0x21, 0xcd, 0x7a, 0x94, 0x68, 0x9a, 0x51, 0xa8, 0x1b, 0x83,
-	0x4d, 0x7d, 0xa8, 0xd4, 0x71, 0x13, 0x6d, 0x1b, 0xd7, 0x45, 0xaf, 0x2f, 0xf1, 0x64, 0xb0, 0xd0,
-	0x70, 0x09, 0x6d, 0x77, 0x43, 0xd3, 0x50, 0x75, 0xb9, 0x84, 0xb6, 0xd3, 0xfe, 0x14, 0xcd, 0xc0,
-	0x57, 0xf4, 0xbe, 0xa0, 0x45, 0x5a, 0xe9, 0x31, 0xf2, 0xab, 0x79, 0x31, 0x39, 0x5f, 0x38, 0x08,
-	0xcd, 0x73, 0x59, 0x06, 0xb3, 0xb6, 0x4c, 0x96, 0xb3, 0x33, 0x45, 0xe4, 0x2e, 0x71, 0x5a, 0xd7,
-	0x22, 0xad, 0x18, 0x90, 0xb2, 0x96, 0xdd, 0xfd, 0xaa, 0xba, 0xb1, 0xa1, 0xc1, 0x53, 0x52, 0x13,
-	0xf0, 0x0b, 0x2d, 0xee, 0x3e, 0xb9, 0xb4, 0x7f, 0x6f, 0x5e, 0x38, 0xf9, 0xb6, 0xd8, 0x05, 0x79,
-	0x13, 0xe9, 0x05, 0xbe, 0xe8, 0x7e, 0x2c, 0xed, 0x5e, 0xbe, 0x78, 0x97, 0x38, 0x64, 0xdb, 0xfd,
-	0x7c, 0x6f, 0x2d, 0xbe, 0xac, 0x55, 0xbd, 0x18, 0x1a, 0xd4, 0xb3, 0x56, 0xe0, 0x77, 0x9a, 0xde,
-	0x2f, 0x68, 0x66, 0xd7, 0xf3, 0xbf, 0x8e, 0x88, 0x7e, 0x5b, 0x64, 0xc5, 0x79, 0x13, 0xd2, 0x55,
-	0xbd, 0xa0, 0x5a, 0x49, 0xa9, 0xe6, 0x2f, 0xd7, 0x95, 0x64, 0x2f, 0x7e, 0x96, 0x1e, 0xcf, 0x7d,
-	0xd5, 0x7d, 0x19, 0x1a, 0xec, 0x93, 0x5b, 0x66, 0x94, 0xb3, 0x4b, 0xf8, 0xf7, 0x7b, 0x53, 0x96,
-	0x2e, 0xe4, 0x0b, 0x94, 0xf3, 0x57, 0xe8, 0xbd, 0x29, 0xf7, 0xd2, 0x2b, 0x53, 0x4e, 0x34, 0x13,
-	0xca, 0xe9, 0x9d, 0x7b, 0x43, 0x8f, 0x1e, 0xfb, 0xd2, 0x43, 0xf3, 0x37, 0xf3, 0x62, 0xf7, 0x7e,
-	0x2e, 0xcf, 0x57, 0xbc, 0x97, 0x65, 0xa7, 0xa7, 0xb4, 0x18, 0xfd, 0x0c, 0xc9, 0xa7, 0xd0, 0x7d,
-	0x12, 0x42, 0xc5, 0x95, 0x45, 0xf9, 0xb6, 0xc0, 0x6a, 0xd9, 0xcc, 0xf8, 0x80, 0x0f, 0x91, 0x36,
-	0xb3, 0x74, 0x10, 0x9a, 0x17, 0xb3, 0x1e, 0x97, 0xf2, 0xb5, 0xfe, 0xb2, 0xcd, 0xf2, 0xe3, 0xd4,
-	0x2c, 0xe1, 0xf9, 0xee, 0x41, 0x59, 0x81, 0x67, 0x08, 0x43, 0x85, 0xf3, 0x91, 0xda, 0x88, 0x50,
-	0xe3, 0xb7, 0xd1, 0x2c, 0xad, 0x16, 0x28, 0xc8, 0xe7, 0xca, 0x0a, 0x57, 0x2c, 0x50, 0x28, 0xe1,
-	0xe5, 0xa9, 0x12, 0x4c, 0x4a, 0x7a, 0x33, 0x77, 0x3e, 0xfc, 0x64, 0xf4, 0xc8, 0xfe, 0x27, 0xa3,
-	0x47, 0x3e, 0x3c, 0x18, 0xd5, 0xf6, 0x0f, 0x46, 0xb5, 0xef, 0xde, 0x1f, 0x3d, 0xf2, 0xee, 0xfd,
-	0x51, 0x6d, 0xff, 0xfe, 0xe8, 0x91, 0x8f, 0xee, 0x8f, 0x1e, 0x79, 0xfd, 0x99, 0x75, 0x87, 0x6d,
-	0x04, 0xb5, 0x2b, 0xb6, 0xd7, 0xbc, 0x9a, 0x66, 0xad, 0xd2, 0xaf, 0xec, 0xdf, 0x4b, 0xb5, 0x13,
-	0xe2, 0xef, 0x4a, 0xd7, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x30, 0xd1, 0x0d, 0x1a, 0x25,
-	0x00, 0x00,
+	// 3275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5b, 0x6c, 0xdc, 0xc6,
+	0xd5, 0x36, 0xed, 0xd8, 0x89, 0x69, 0x59, 0xb6, 0x28, 0x59, 0x62, 0x6c, 0x47, 0x54, 0xd6, 0xeb,
+	0x44, 0xb9, 0xd8, 0x96, 0x64, 0xc7, 0xbf, 0x63, 0xe0, 0x47, 0x7e, 0x5d, 0xa2, 0x3f, 0x8a, 0x25,
+	0x59, 0x18, 0x49, 0xc8, 0x8f, 0xfc, 0x28, 0x88, 0x59, 0xee, 0xac, 0xc4, 0x8a, 0x3b, 0xdc, 0x90,
+	0x43, 0xad, 0x94, 0x14, 0x6d, 0x90, 0xa2, 0x97, 0xb7, 0xb6, 0x42, 0x2f, 0x40, 0x0b, 0x14, 0x29,
+	0xda, 0x02, 0x4d, 0xd3, 0x14, 0x05, 0x0a, 0x14, 0x68, 0x81, 0xa2, 0x45, 0x81, 0x02, 0x41, 0xfb,
+	0x20, 0x3d, 0x16, 0x68, 0xcb, 0x22, 0x72, 0x9f, 0xf6, 0xa1, 0x0f, 0xfb, 0xa8, 0xbe, 0x14, 0x67,
+	0x78, 0x1b, 0x92, 0xb3, 0xb1, 0xdf, 0x96, 0xe7, 0x3b, 0x73, 0xe6, 0x3b, 0x73, 0x39, 0x73, 0xce,
+	0xcc, 0xaa, 0x57, 0x1d, 0xbb, 0x76, 0xc3, 0x72, 0x69, 0xc3, 0xde, 0xb8, 0xe1, 0xb6, 0x98, 0xed,
+	0x52, 0x3f, 0xfa, 0x0a, 0x3c, 0x0c, 0x5f, 0xd7, 0x5b, 0x9e, 0xcb, 0x5c, 0xed, 0x54, 0x24, 0xbc,
+	0x38, 0x22, 0xa8, 0xb3, 0x80, 0xda, 0x74, 0x23, 0x52, 0xb8, 0x78, 0x41, 0x00, 0x7c, 0xfb, 0x6d,
+	0x12, 0x8b, 0x4f, 0x93, 0x1d, 0x16, 0xfd, 0xac, 0xfc, 0xf6, 0x75, 0x75, 0xe8, 0x7e, 0xd4, 0xc3,
+	0xac, 0xd8, 0x83, 0xf6, 0x7d, 0x45, 0x3d, 0xef, 0xd8, 0x3e, 0x23, 0xd4, 0xc4, 0xf5, 0xba, 0x47,
+	0x7c, 0x9f, 0xf8, 0xba, 0x32, 0x76, 0x62, 0xfc, 0xf4, 0x8c, 0x7f, 0x18, 0x1a, 0x1a, 0xc2, 0xed,
+	0x45, 0x0e, 0x4f, 0x27, 0x68, 0x27, 0x34, 0xce, 0x39, 0x79, 0x51, 0x37, 0x34, 0xae, 0xee, 0x34,
+	0x9d, 0xbb, 0x95, 0x9c, 0xbc, 0x32, 0x56, 0x27, 0x0d, 0x1c, 0x38, 0xec, 0x6e, 0x25, 0xfe, 0x51,
+	0x39, 0xda, 0xaf, 0x3e, 0x1e, 0xff, 0xde, 0x3b, 0xa8, 0x4a, 0x8c, 0xa3, 0xa2, 0x69, 0xed, 0x5f,
+	0x8a, 0xaa, 0x6f, 0x38, 0x6e, 0x0d, 0x3b, 0x66, 0xdd, 0xf6, 0x2d, 0x77, 0x9b, 0x78, 0xbb, 0xa6,
+	0x4f, 0xbc, 0x6d, 0xe2, 0xf9, 0xfa, 0x71, 0x4e, 0xf4, 0x97, 0xca, 0x61, 0x68, 0x0c, 0x22, 0xdc,
+	0xfe, 0x5f, 0xae, 0x37, 0x4d, 0xe9, 0x6a, 0x84, 0x77, 0x42, 0xe3, 0xc2, 0x46, 0x22, 0x73, 0x03,
+	0x6a, 0x91, 0x18, 0xe8, 0x86, 0xc6, 0x8b, 0x9c, 0xb0, 0x0c, 0x95, 0xf0, 0xee, 0xec, 0x57, 0x87,
+	0x64, 0xaa, 0xdd, 0xfd, 0xaa, 0xbc, 0x83, 0xbc, 0xa3, 0x32, 0x6e, 0x68, 0x38, 0x6a, 0x38, 0x97,
+	0x38, 0x15, 0xcb, 0xb5, 0x7f, 0xca, 0x1c, 0x26, 0x14, 0xd7, 0x1c, 0x52, 0xd7, 0x4f, 0x8c, 0x29,
+	0xe3, 0x4f, 0xcc, 0x7c, 0x00, 0x0e, 0x9f, 0x4f, 0x2d, 0xbe, 0x1a, 0x81, 0x65, 0x6f, 0x63, 0xa0,
+	0x1b, 0x1a, 0xcf, 0x4b, 0xbc, 0x8d, 0x51, 0xc1, 0x5d, 0xe6, 0x05, 0x04, 0x7c, 0xed, 0x61, 0xa6,
+	0x17, 0x70, 0xb4, 0x5f, 0x7d, 0x0c, 0x9a, 0xee, 0x1d, 0x54, 0x4b, 0xa4, 0x4a, 0x6e, 0xc6, 0x72,
+	0xed, 0x6f, 0x8a, 0x3a, 0xe2, 0xb8, 0x96, 0xd4, 0xcb, 0xc7, 0xb8, 0x97, 0x3f, 0x04, 0x2f, 0xcf,
+	0x2d, 0xba, 0x96, 0x68, 0xaf, 0x13, 0x1a, 0x43, 0x8e, 0x6b, 0x95, 0x38, 0x74, 0x43, 0xe3, 0xb9,
+	0x68, 0x09, 0xba, 0xd6, 0xa3, 0xb8, 0x28, 0x37, 0xd2, 0x43, 0x2e, 0x38, 0x58, 0xe4, 0x83, 0x2e,
+	0xf0, 0x06, 0x25, 0xf7, 0xfe, 0xac, 0xa8, 0x83, 0x91, 0x7b, 0x38, 0xb6, 0x65, 0xb6, 0x5c, 0x8f,
+	0xe9, 0x27, 0xc7, 0x94, 0xf1, 0x93, 0x33, 0xdf, 0x05, 0xd7, 0xfa, 0x12, 0x53, 0x2b, 0xae, 0xc7,
+	0x3a, 0xa1, 0x31, 0x90, 0xeb, 0x1a, 0x84, 0xdd, 0xd0, 0x78, 0xb6, 0xec, 0x14, 0x20, 0x82, 0x47,
+	0x53, 0x93, 0x13, 0x53, 0xff, 0x55, 0x39, 0x0a, 0x8d, 0x13, 0x36, 0x65, 0x9d, 0xfd, 0xaa, 0xc4,
+	0x8c, 0x4c, 0x78, 0xb4, 0x5f, 0x3d, 0xc9, 0x9b, 0xee, 0x1d, 0x54, 0x73, 0x4c, 0x50, 0x59, 0x57,
+	0xfb, 0xe2, 0x71, 0x75, 0xac, 0xe0, 0x4d, 0x33, 0x70, 0x98, 0x6d, 0x61, 0x9f, 0x25, 0x71, 0x43,
+	0x3f, 0x35, 0xa6, 0x8c, 0x9f, 0x9e, 0xf9, 0x35, 0xb8, 0xd6, 0x9f, 0x18, 0x5c, 0x9a, 0x85, 0x9d,
+	0xdc, 0x09, 0x8d, 0xc1, 0x9c, 0xd1, 0x48, 0xdc, 0x0d, 0x8d, 0xdb, 0x65, 0xf7, 0x22, 0x4c, 0x70,
+	0xf0, 0xff, 0x1b, 0x8d, 0xc9, 0xa9, 0xbb, 0x77, 0xef, 0xdc, 0xbc, 0x73, 0xeb, 0x33, 0x77, 0x23,
+	0x6f, 0x3b, 0xfb, 0x55, 0xa9, 0x41, 0xb9, 0xf8, 0x68, 0xbf, 0xaa, 0x95, 0x8d, 0xec, 0x1d, 0x54,
+	0x0b, 0x34, 0xd1, 0x53, 0xf9, 0xc6, 0x89, 0x87, 0x71, 0x30, 0xd2, 0xee, 0xab, 0x67, 0x9b, 0x78,
+	0xc7, 0xf4, 0x09, 0xad, 0x9b, 0x5b, 0xb5, 0x96, 0xaf, 0x3f, 0xce, 0x27, 0xf3, 0x85, 0x4e, 0x68,
+	0x9c, 0x69, 0xe2, 0x9d, 0x55, 0x42, 0xeb, 0xf7, 0x6a, 0x2d, 0x08, 0x2e, 0x03, 0xdc, 0x2d, 0x41,
+	0x96, 0xcc, 0x0f, 0x12, 0x15, 0x13, 0x83, 0x1e, 0xb1, 0xb6, 0x23, 0x83, 0x4f, 0xe4, 0x0c, 0x22,
+	0x62, 0x6d, 0x17, 0x0d, 0x26, 0xb2, 0x9c, 0xc1, 0x44, 0xa8, 0xfd, 0x4a, 0x51, 0x47, 0x3c, 0x62,
+	0xb9, 0x94, 0x12, 0x0b, 0xc2, 0xbb, 0x69, 0x53, 0x46, 0xbc, 0x6d, 0xec, 0x98, 0xbe, 0x7e, 0x9a,
+	0xdb, 0xfe, 0x3c, 0x0f, 0xea, 0x89, 0xca, 0x42, 0x0c, 0xaf, 0x42, 0xec, 0x10, 0x1b, 0xa6, 0x40,
+	0x37, 0x34, 0xc6, 0x79, 0xdf, 0x52, 0x54, 0x98, 0xa5, 0xdb, 0x13, 0x09, 0xa5, 0xa3, 0xfd, 0xea,
+	0xf1, 0xdb, 0x13, 0x3c, 0xbe, 0x97, 0xfa, 0x41, 0xf2, 0x5e, 0xb4, 0x86, 0xda, 0xef, 0x11, 0x07,
+	0xef, 0xfa, 0x69, 0x0c, 0x50, 0x79, 0x0c, 0x78, 0xa5, 0x13, 0x1a, 0x67, 0x23, 0x24, 0xdb, 0xe8,
+	0x95, 0x98, 0x90, 0x20, 0x2d, 0xee, 0xf0, 0x64, 0xc7, 0xa2, 0x7c, 0x63, 0xed, 0xbd, 0xe3, 0xea,
+	0xa5, 0xb8, 0xa3, 0x94, 0x48, 0x36, 0x48, 0x4d, 0xfd, 0x0c, 0x1f, 0xa4, 0x3f, 0xc0, 0x1a, 0x1e,
+	0x41, 0xa0, 0x57, 0x72, 0x61, 0xa9, 0x13, 0x1a, 0x23, 0x9e, 0x1c, 0x4a, 0x03, 0x6d, 0x0f, 0x5c,
+	0x60, 0x39, 0x39, 0x21, 0x6c, 0xd9, 0x9e, 0xf6, 0x7a, 0x43, 0x30, 0xc8, 0x93, 0x30, 0xc8, 0xbd,
+	0x68, 0x22, 0x3d, 0xf2, 0xb3, 0x8c, 0x68, 0x35, 0xf5, 0xac, 0xcf, 0xb0, 0xc7, 0xcc, 0x9a, 0xe7,
+	0xb6, 0x7d, 0xe2, 0xe9, 0x7d, 0x7c, 0xac, 0xff, 0xbb, 0x13, 0x1a, 0x7d, 0x1c, 0x98, 0x89, 0xe4,
+	0xdd, 0xd0, 0x78, 0x9a, 0xbb, 0x23, 0x0a, 0x7b, 0x8e, 0x74, 0xae, 0xa9, 0xf6, 0x63, 0x45, 0xbd,
+	0x40, 0x31, 0x33, 0x99, 0x87, 0xe1, 0x54, 0xc3, 0x4e, 0x3a, 0xb1, 0xfd, 0xbc, 0xb3, 0xb7, 0x0e,
+	0x43, 0x43, 0x5d, 0x9e, 0x5e, 0xcb, 0xc2, 0xba, 0x4a, 0x31, 0xcb, 0xe6, 0xd8, 0xe0, 0x1d, 0x67,
+	0x22, 0x49, 0x08, 0x17, 0x1b, 0xe4, 0xbe, 0x84, 0x70, 0x2d, 0x74, 0x81, 0x06, 0x29, 0x66, 0x6b,
+	0x09, 0x9d, 0x64, 0x41, 0xfc, 0xa6, 0xc4, 0xd3, 0x21, 0xd8, 0x27, 0x66, 0x53, 0x3f, 0xc7, 0x97,
+	0xc2, 0x97, 0x61, 0x29, 0x9c, 0x5e, 0x9e, 0x5e, 0x5b, 0x04, 0x31, 0x4c, 0xfe, 0x39, 0x8a, 0x59,
+	0xf4, 0x61, 0xd3, 0x80, 0x11, 0x3f, 0x5d, 0x90, 0x05, 0xb9, 0x74, 0x6f, 0x74, 0xf6, 0xab, 0xa5,
+	0xf6, 0x65, 0x51, 0xba, 0x83, 0xb2, 0x8e, 0x91, 0x26, 0xb2, 0x8f, 0x64, 0xda, 0x9f, 0x14, 0x75,
+	0x24, 0x4f, 0xde, 0x23, 0x94, 0xb4, 0xf9, 0x4a, 0x3e, 0xcf, 0xe9, 0xef, 0x01, 0xfd, 0x33, 0xcb,
+	0xd3, 0x6b, 0x28, 0x02, 0xc0, 0x81, 0x01, 0x8a, 0x59, 0xf2, 0x99, 0xba, 0x50, 0x4d, 0x5c, 0xc8,
+	0x23, 0x82, 0x13, 0x37, 0x45, 0x27, 0x24, 0x36, 0x64, 0x42, 0x70, 0xe4, 0x26, 0x38, 0x22, 0x52,
+	0x40, 0x43, 0xa2, 0x2b, 0x89, 0x54, 0xe2, 0x0c, 0xb3, 0x9b, 0xc4, 0x0d, 0x98, 0xe9, 0xeb, 0x03,
+	0x79, 0x67, 0xd6, 0x22, 0x60, 0x35, 0x76, 0x26, 0xf9, 0x84, 0x95, 0x5e, 0xcf, 0x39, 0x93, 0x47,
+	0x7a, 0x6d, 0x3f, 0x89, 0x0d, 0x99, 0x30, 0xdd, 0x72, 0x22, 0x85, 0xbc, 0x33, 0x89, 0x54, 0xfb,
+	0x9e, 0xa2, 0xea, 0x81, 0x8f, 0x37, 0x88, 0xe9, 0x11, 0x38, 0xf7, 0x6d, 0xba, 0x61, 0x62, 0xcb,
+	0x22, 0x2d, 0x46, 0xea, 0xba, 0xc6, 0xbd, 0xc1, 0xb0, 0x03, 0xd6, 0xd1, 0x74, 0x2c, 0x85, 0x1d,
+	0x10, 0x78, 0xc9, 0x57, 0x37, 0x34, 0xce, 0x73, 0x27, 0x32, 0x91, 0x40, 0x58, 0x54, 0xcc, 0x7d,
+	0xc1, 0x8a, 0xcf, 0x4c, 0xa2, 0x61, 0x4e, 0x01, 0x25, 0x0c, 0x12, 0xb9, 0xf6, 0x8e, 0x3a, 0x54,
+	0x24, 0xe7, 0x13, 0x42, 0xf5, 0x41, 0x4e, 0x6c, 0xe1, 0x30, 0x34, 0x4e, 0xad, 0xa3, 0x55, 0x42,
+	0x68, 0x27, 0x34, 0x4e, 0x05, 0x1e, 0xfc, 0xea, 0x86, 0x46, 0x5f, 0x4c, 0x08, 0x3e, 0x05, 0x32,
+	0x89, 0x42, 0xfa, 0x6b, 0xef, 0xa0, 0x1a, 0x37, 0x47, 0x5a, 0x9e, 0x00, 0xc8, 0xb4, 0x6f, 0x29,
+	0xea, 0x93, 0xc5, 0xde, 0x03, 0x6a, 0xbf, 0x15, 0x10, 0xd3, 0xae, 0xeb, 0x43, 0x3c, 0x89, 0x78,
+	0x33, 0x1a, 0x9b, 0x75, 0x2e, 0x5e, 0x98, 0x8b, 0xc6, 0x26, 0xfe, 0x12, 0xc7, 0x26, 0x51, 0xa8,
+	0x44, 0x83, 0x92, 0x7c, 0x76, 0xc5, 0xaf, 0x78, 0x50, 0x12, 0xac, 0x38, 0x28, 0x89, 0x96, 0xf6,
+	0x7b, 0x45, 0x1d, 0x2c, 0xf1, 0xf2, 0x1c, 0xfd, 0x02, 0x67, 0xf4, 0x35, 0x58, 0x7b, 0x27, 0xd7,
+	0xd1, 0x3a, 0x5a, 0xec, 0x84, 0xc6, 0xc9, 0xc0, 0x5b, 0x47, 0x8b, 0xdd, 0xd0, 0xb8, 0x93, 0x10,
+	0x41, 0x8b, 0xc2, 0xea, 0xda, 0x64, 0xac, 0xe5, 0xdf, 0xbd, 0x71, 0xa3, 0x8e, 0x19, 0xbe, 0xee,
+	0xef, 0x52, 0x8b, 0x6d, 0x42, 0xb1, 0x46, 0x09, 0xbb, 0x41, 0x49, 0x1b, 0xa4, 0x40, 0x38, 0x36,
+	0x92, 0xfc, 0x38, 0xda, 0xaf, 0x3e, 0x42, 0xc3, 0xbd, 0x83, 0x6a, 0xc4, 0x02, 0x0d, 0x14, 0xfc,
+	0xf0, 0x1c, 0xed, 0x1f, 0x8a, 0x6a, 0x14, 0x5d, 0x68, 0xb9, 0x3e, 0x9c, 0x70, 0x3e, 0xb1, 0x02,
+	0x8f, 0x38, 0xbb, 0xfa, 0x30, 0x0f, 0xbf, 0xdf, 0xe1, 0x15, 0xc4, 0x3a, 0x5a, 0x71, 0x7d, 0xb6,
+	0x90, 0x82, 0x9d, 0xd0, 0x38, 0x1f, 0x78, 0x79, 0x59, 0x37, 0x34, 0x9e, 0x89, 0x9d, 0xcc, 0x03,
+	0x82, 0xbf, 0x0d, 0xec, 0xf8, 0x3c, 0x24, 0x97, 0x5b, 0x4b, 0x64, 0x90, 0x79, 0xf2, 0x16, 0x50,
+	0x2f, 0x14, 0x29, 0xa0, 0xcb, 0x79, 0xb7, 0xf2, 0xa8, 0xf6, 0x77, 0x89, 0x87, 0x36, 0xb5, 0x99,
+	0x0d, 0x75, 0x04, 0x9c, 0x77, 0xa6, 0xaf, 0x8f, 0xf0, 0x55, 0xfc, 0x6d, 0x5e, 0x3d, 0xac, 0xa3,
+	0x85, 0x08, 0x9d, 0x03, 0x10, 0x02, 0xc6, 0xb9, 0xc0, 0xcb, 0x89, 0xd2, 0x70, 0x51, 0x90, 0x8b,
+	0xc1, 0xe2, 0xce, 0x44, 0x2e, 0x80, 0x17, 0x2d, 0x94, 0x45, 0x70, 0x02, 0x41, 0x2b, 0x28, 0x18,
+	0x0a, 0x14, 0xd0, 0xa5, 0xbc, 0x83, 0x39, 0x50, 0x73, 0xd5, 0x01, 0x8f, 0x44, 0x87, 0xb3, 0x4b,
+	0xcd, 0x36, 0xde, 0x22, 0x41, 0x4b, 0xd7, 0xf9, 0x94, 0xcd, 0x02, 0xf9, 0x18, 0xbc, 0x4f, 0xdf,
+	0xe0, 0x50, 0x4a, 0xbe, 0x20, 0xef, 0x79, 0x48, 0x17, 0x0d, 0x68, 0x5f, 0x51, 0xd4, 0x11, 0x1c,
+	0x30, 0xd7, 0x0c, 0x5a, 0x1b, 0x1e, 0xae, 0x93, 0x2c, 0x19, 0xda, 0xd4, 0x9f, 0xe4, 0x03, 0xb9,
+	0x02, 0x25, 0x17, 0xa8, 0xac, 0x47, 0x1a, 0x49, 0x1e, 0xf1, 0x5a, 0x5a, 0x9d, 0xc8, 0x40, 0x71,
+	0xf8, 0xa6, 0xc4, 0xcc, 0x70, 0x72, 0x0a, 0x49, 0xad, 0x69, 0x4d, 0x75, 0x24, 0xe1, 0xc0, 0x5c,
+	0xb3, 0xe5, 0xc1, 0x14, 0xf3, 0xb3, 0xd8, 0xd7, 0x2f, 0xf2, 0x01, 0xb8, 0x0d, 0x44, 0x62, 0x95,
+	0x35, 0x77, 0xc5, 0x23, 0x28, 0xc6, 0xbb, 0xa1, 0x71, 0x31, 0x9a, 0x42, 0x09, 0x58, 0x41, 0xd2,
+	0x36, 0xda, 0xb6, 0xaa, 0x6d, 0x11, 0xd2, 0x32, 0x19, 0x69, 0xb6, 0x5c, 0x0f, 0x7b, 0x36, 0xf1,
+	0xcd, 0x4d, 0xfd, 0x12, 0x77, 0xf9, 0x35, 0xd8, 0x08, 0x80, 0xae, 0x65, 0x20, 0xb8, 0x7b, 0x85,
+	0xf7, 0x52, 0x04, 0xc4, 0x5a, 0xec, 0x96, 0xe8, 0xea, 0xd4, 0x2d, 0x54, 0xb2, 0xa2, 0xed, 0xaa,
+	0x83, 0x16, 0xb6, 0x36, 0x89, 0x69, 0x6f, 0x50, 0xd7, 0x23, 0x75, 0xb3, 0x61, 0x3b, 0xc4, 0xd7,
+	0x2f, 0x73, 0x17, 0x17, 0xe0, 0x44, 0xe3, 0xf0, 0x42, 0x84, 0xce, 0x03, 0x98, 0x0e, 0x74, 0x09,
+	0x29, 0xed, 0xc1, 0x74, 0x6f, 0xa1, 0xb2, 0x19, 0xed, 0x1b, 0x8a, 0x7a, 0xb1, 0xe5, 0xb9, 0x1b,
+	0x50, 0xcc, 0x98, 0x41, 0xab, 0x8e, 0x19, 0x11, 0x0b, 0x84, 0xa7, 0xb8, 0xef, 0x6b, 0x90, 0xdf,
+	0x26, 0x5a, 0xeb, 0x5c, 0x49, 0x2c, 0x06, 0xa2, 0x22, 0xbb, 0x07, 0x2e, 0xd0, 0x79, 0x49, 0x18,
+	0x08, 0xe5, 0x25, 0xd4, 0xcb, 0xa2, 0xf6, 0x9e, 0xa2, 0x0e, 0x3b, 0x76, 0xd3, 0x66, 0x66, 0x0d,
+	0xd3, 0x7a, 0xdb, 0xae, 0xb3, 0x4d, 0xd3, 0xa6, 0xa6, 0x83, 0xa9, 0x3e, 0xca, 0x87, 0x64, 0x89,
+	0x17, 0x8f, 0xa0, 0x31, 0x93, 0x28, 0x2c, 0xd0, 0x45, 0x4c, 0xb3, 0x82, 0xbf, 0x8c, 0x7d, 0xca,
+	0xb0, 0xc8, 0x4c, 0x69, 0xef, 0x2a, 0xaa, 0xd6, 0xb4, 0xa9, 0xb9, 0xe9, 0x36, 0x09, 0x5c, 0x47,
+	0x6c, 0x99, 0x0d, 0x8f, 0x10, 0xdd, 0x18, 0x53, 0xc6, 0xcf, 0x4c, 0xf5, 0x5d, 0x8f, 0x6e, 0xd6,
+	0xae, 0xaf, 0xda, 0x6f, 0x93, 0x99, 0x57, 0x3f, 0x0e, 0x8d, 0x63, 0xb0, 0x13, 0x9b, 0x36, 0x7d,
+	0xcd, 0x6d, 0x92, 0x39, 0xdb, 0xdf, 0x9a, 0xf7, 0x08, 0x49, 0x57, 0x47, 0x41, 0x2e, 0xee, 0x83,
+	0xb1, 0xab, 0x40, 0xe4, 0xc4, 0xe4, 0xd8, 0x55, 0x54, 0x6c, 0xae, 0x3d, 0x50, 0xd4, 0xbe, 0x64,
+	0xbd, 0xf3, 0x63, 0x67, 0x8c, 0x1f, 0x3b, 0xbf, 0xe3, 0x29, 0x4f, 0xb2, 0x68, 0xa3, 0xc3, 0xe7,
+	0x8c, 0x97, 0x7d, 0x76, 0x43, 0x63, 0x2e, 0xa9, 0x38, 0x12, 0x99, 0xe4, 0x20, 0x8a, 0x77, 0x80,
+	0x5f, 0x38, 0x53, 0x9a, 0x84, 0xe1, 0xeb, 0x9f, 0xf5, 0x5d, 0x0a, 0xb1, 0x3b, 0x67, 0x36, 0xff,
+	0x79, 0xb4, 0x5f, 0x1d, 0x7f, 0x54, 0x53, 0x90, 0x1f, 0x09, 0x7c, 0x51, 0x66, 0xc7, 0x73, 0xb4,
+	0x37, 0xd4, 0x01, 0xec, 0xb4, 0xa1, 0xfa, 0x8a, 0x6e, 0x13, 0x28, 0x61, 0xbe, 0xfe, 0x34, 0xbf,
+	0xc4, 0x83, 0xa2, 0xf7, 0x5c, 0x04, 0xf2, 0xaa, 0x7c, 0x99, 0x30, 0x58, 0xf8, 0x43, 0x51, 0x84,
+	0xc9, 0xc9, 0x2b, 0xa8, 0xa8, 0xa8, 0xfd, 0x5b, 0x51, 0xc7, 0xe1, 0xfe, 0xa5, 0xed, 0xd9, 0x0c,
+	0x02, 0x47, 0xd3, 0x65, 0xc4, 0xac, 0x93, 0x6d, 0xdb, 0x22, 0x26, 0xc5, 0x4d, 0xe2, 0x43, 0x38,
+	0x8d, 0x0b, 0x21, 0xbd, 0x92, 0x5d, 0x2f, 0x8d, 0xdc, 0x4f, 0x1a, 0x21, 0xde, 0x66, 0x8e, 0x6c,
+	0x2f, 0x83, 0x7a, 0x27, 0x34, 0xae, 0xb8, 0x25, 0xc8, 0xb6, 0x08, 0x47, 0xef, 0xd3, 0xd9, 0xc8,
+	0x54, 0x37, 0x34, 0x5e, 0xe6, 0x04, 0x1f, 0x41, 0xb7, 0xf7, 0xa2, 0x84, 0x2a, 0xae, 0x07, 0x0f,
+	0xf4, 0x28, 0x2c, 0xb4, 0x2f, 0xa8, 0x17, 0x20, 0x8c, 0x99, 0x36, 0xad, 0x93, 0x1d, 0x13, 0x56,
+	0x72, 0xcd, 0x71, 0xad, 0x2d, 0x5f, 0xbf, 0xc2, 0xb7, 0x34, 0x2c, 0x1a, 0x0d, 0x14, 0x16, 0x00,
+	0x5f, 0xb2, 0xe9, 0x0c, 0x47, 0xd3, 0x5b, 0xdb, 0x32, 0x24, 0xcd, 0x94, 0xa3, 0xfc, 0x17, 0x49,
+	0x2c, 0x69, 0x7f, 0x85, 0x74, 0x97, 0x62, 0x6b, 0x8b, 0xd4, 0x4d, 0xea, 0x32, 0xbb, 0x61, 0x5b,
+	0x38, 0xba, 0x7f, 0xa8, 0xfb, 0x7a, 0x95, 0xcf, 0xef, 0xfb, 0x30, 0xdc, 0xc3, 0xeb, 0x91, 0xd2,
+	0xb2, 0xa0, 0xb3, 0x30, 0x07, 0xa3, 0x3d, 0x1c, 0x48, 0x91, 0x6e, 0x68, 0x5c, 0x8a, 0x42, 0xbb,
+	0x0c, 0xe6, 0x77, 0x95, 0x52, 0xa4, 0xbb, 0x5f, 0xed, 0x61, 0x71, 0xef, 0xa0, 0xda, 0x83, 0x05,
+	0x92, 0xb6, 0xa8, 0xfb, 0x1a, 0x52, 0xcf, 0x32, 0x0f, 0x37, 0x1a, 0xb6, 0x65, 0x5a, 0x0e, 0xf6,
+	0x7d, 0xfd, 0x2a, 0x1f, 0xd6, 0x6b, 0x50, 0x2f, 0xc7, 0xc0, 0x2c, 0xc8, 0xbb, 0xa1, 0xa1, 0x45,
+	0x03, 0x2a, 0x08, 0xd3, 0x8b, 0x9a, 0x9c, 0xaa, 0xf6, 0x8e, 0x3a, 0x18, 0x0f, 0xb1, 0xd9, 0x70,
+	0x9d, 0x3a, 0xf1, 0xcc, 0x16, 0x66, 0x9b, 0xfa, 0x33, 0x7c, 0xd7, 0xdf, 0x3b, 0x0c, 0x8d, 0x4b,
+	0x73, 0xa4, 0xe5, 0x11, 0x0b, 0x33, 0x52, 0x9f, 0x8b, 0x14, 0xe7, 0xb9, 0xde, 0x0a, 0x66, 0x9b,
+	0x9d, 0xd0, 0x50, 0xae, 0xa5, 0xd5, 0x79, 0xbd, 0x08, 0xbf, 0xe8, 0x36, 0x6d, 0x98, 0x24, 0xb6,
+	0x5b, 0xd1, 0x15, 0x34, 0x50, 0xc2, 0xb5, 0x2d, 0xf5, 0xbc, 0x4f, 0x98, 0xe9, 0xb8, 0x6d, 0xb3,
+	0xe5, 0xd9, 0xae, 0x67, 0xb3, 0x5d, 0xfd, 0x59, 0xbe, 0x29, 0xa6, 0x3b, 0xa1, 0xd1, 0xef, 0x13,
+	0xb6, 0xe8, 0xb6, 0x57, 0x62, 0x24, 0x8d, 0x6c, 0x79, 0x71, 0xcf, 0x14, 0xa3, 0xd0, 0x5c, 0xfb,
+	0x40, 0x51, 0x87, 0xe1, 0x96, 0x2b, 0x76, 0xd3, 0x72, 0xa9, 0x15, 0x78, 0x1e, 0xa1, 0xd6, 0xae,
+	0x3e, 0xce, 0xc7, 0xd1, 0xe7, 0x97, 0x2d, 0xb8, 0xbd, 0x84, 0x77, 0x22, 0x8e, 0xb3, 0x99, 0x0a,
+	0x1c, 0xf9, 0x4d, 0x89, 0x3c, 0x3d, 0xf2, 0x65, 0x60, 0x32, 0xe4, 0xfc, 0x76, 0x44, 0x6e, 0x17,
+	0x49, 0xad, 0xc2, 0xa5, 0xf4, 0xa0, 0xe5, 0x61, 0x7f, 0xb3, 0x50, 0x03, 0x3c, 0xc7, 0xa7, 0xe5,
+	0x43, 0x5e, 0x03, 0xcc, 0x26, 0x35, 0x80, 0x15, 0xd7, 0x00, 0xf3, 0xd1, 0xd9, 0x0c, 0xcd, 0xb2,
+	0x6c, 0x5c, 0x1a, 0x86, 0xb9, 0x4e, 0x39, 0xaf, 0xe7, 0x62, 0x58, 0xcb, 0x03, 0x25, 0x23, 0x50,
+	0x1d, 0x58, 0x71, 0x75, 0x50, 0x7d, 0x14, 0x33, 0x50, 0x1f, 0xcc, 0x46, 0xf5, 0x41, 0xc1, 0x98,
+	0xe7, 0x68, 0x3f, 0x50, 0xd4, 0x91, 0xa2, 0x7b, 0xc9, 0xb5, 0xcc, 0xf3, 0x7c, 0xfe, 0x6d, 0xb8,
+	0xed, 0x98, 0x45, 0xc2, 0x8b, 0x42, 0xde, 0x4a, 0xf1, 0x45, 0x41, 0x8a, 0xf6, 0x5a, 0x1a, 0x70,
+	0xa1, 0x91, 0xda, 0x46, 0x72, 0xcb, 0xda, 0x97, 0x14, 0x75, 0xd8, 0x67, 0x01, 0x35, 0x21, 0x73,
+	0xc2, 0x8e, 0xbd, 0x4d, 0xcc, 0x28, 0x1f, 0xf6, 0xf5, 0x17, 0xd2, 0x7c, 0x74, 0x10, 0x34, 0xee,
+	0x25, 0x0a, 0xab, 0x80, 0xaf, 0xa6, 0x59, 0x92, 0x04, 0xcb, 0x27, 0xf3, 0x42, 0x40, 0x3b, 0x31,
+	0x79, 0x67, 0x02, 0xc9, 0xac, 0x41, 0x8d, 0x5c, 0xa0, 0x01, 0x71, 0xd5, 0xd7, 0x5f, 0xe4, 0x24,
+	0x5e, 0x87, 0x44, 0x2d, 0xd7, 0x6c, 0xc9, 0xa6, 0x59, 0x2d, 0x51, 0x42, 0xc4, 0x1c, 0x31, 0x17,
+	0x50, 0xa7, 0x26, 0x50, 0xd9, 0x0e, 0x64, 0xe5, 0x7d, 0xbc, 0xf7, 0xe4, 0xa1, 0xeb, 0x1a, 0x8f,
+	0xa1, 0x75, 0xb8, 0x5a, 0x47, 0xb8, 0xbd, 0xca, 0x02, 0xe1, 0x89, 0xeb, 0x8c, 0x9f, 0x7d, 0xa6,
+	0x97, 0x51, 0x99, 0xec, 0xa1, 0xcf, 0x70, 0x05, 0x8b, 0x48, 0xb4, 0xa7, 0x6d, 0xab, 0xe7, 0xea,
+	0x98, 0xe1, 0x1a, 0xdc, 0x89, 0x45, 0x6f, 0x8e, 0xfa, 0xf5, 0x31, 0x65, 0xbc, 0x7f, 0xaa, 0x3f,
+	0x49, 0x8b, 0xd6, 0xb8, 0x94, 0xdf, 0x1e, 0xf6, 0x27, 0xaa, 0x91, 0x2c, 0x8d, 0x1c, 0x79, 0x71,
+	0x65, 0x2c, 0x2e, 0x42, 0xe2, 0xe5, 0xf1, 0xee, 0x41, 0x55, 0x41, 0x85, 0xa6, 0xda, 0x37, 0x8f,
+	0xab, 0x57, 0x20, 0x6a, 0xa4, 0xe1, 0x02, 0x8a, 0x58, 0xcb, 0x6d, 0xc2, 0x92, 0xf5, 0xc8, 0x5b,
+	0x01, 0xf1, 0x99, 0xb9, 0x65, 0xd7, 0xf4, 0x1b, 0x7c, 0x3a, 0xfe, 0xa8, 0xc4, 0x6f, 0x95, 0x4b,
+	0x78, 0x67, 0x76, 0x01, 0x45, 0xf8, 0x3d, 0x7b, 0xa6, 0x13, 0x1a, 0x46, 0x13, 0xef, 0xa4, 0x5b,
+	0x9c, 0x2d, 0xc4, 0x36, 0x32, 0x95, 0xf4, 0x14, 0x7c, 0x88, 0x9e, 0x50, 0x00, 0x3e, 0xd4, 0xe4,
+	0xc3, 0x55, 0xe2, 0xd7, 0xcf, 0x02, 0x5d, 0xf4, 0x90, 0x66, 0x35, 0x78, 0x1c, 0x1c, 0x4e, 0x9f,
+	0x60, 0x1c, 0x2c, 0x3e, 0xda, 0x4e, 0xf0, 0x0d, 0xfc, 0x11, 0x8c, 0xc4, 0x50, 0xf2, 0x84, 0xb1,
+	0x38, 0xbd, 0x2c, 0xbe, 0xdb, 0x0e, 0x61, 0x89, 0x3c, 0x4d, 0xa4, 0x65, 0xa0, 0xec, 0xe5, 0x4c,
+	0x6a, 0xa4, 0x87, 0x5c, 0xd8, 0xfa, 0x52, 0x52, 0x28, 0x6b, 0x85, 0x85, 0x47, 0xdf, 0x6d, 0xf5,
+	0x22, 0x7f, 0x65, 0x69, 0x04, 0x8e, 0x13, 0x67, 0x35, 0x2e, 0x4d, 0x4a, 0x54, 0x7d, 0x92, 0x7b,
+	0x7a, 0x17, 0xb2, 0x06, 0xd0, 0x9a, 0x0f, 0x1c, 0x87, 0xe7, 0x23, 0xf7, 0x69, 0x5c, 0x54, 0x76,
+	0x43, 0xe3, 0x72, 0x7c, 0x64, 0xc9, 0xe0, 0x0a, 0xea, 0xd1, 0x4e, 0x7b, 0x5d, 0x3d, 0xdb, 0x20,
+	0x98, 0x05, 0x1e, 0x31, 0x1b, 0x0e, 0xde, 0xf0, 0xf5, 0x29, 0xbe, 0xef, 0xae, 0xc2, 0x49, 0x1f,
+	0x03, 0xf3, 0x20, 0x4f, 0x5f, 0x64, 0x04, 0x61, 0x05, 0xe5, 0x54, 0xb4, 0xb6, 0x3a, 0x22, 0x3c,
+	0xc4, 0x44, 0x35, 0x0e, 0xa1, 0x6e, 0xb0, 0xb1, 0xa9, 0xdf, 0xe4, 0x8b, 0xf6, 0x15, 0x1e, 0x5e,
+	0x53, 0x95, 0x45, 0xd0, 0x78, 0x95, 0x2b, 0xa4, 0x59, 0x8f, 0x14, 0x4d, 0x33, 0x0a, 0x79, 0x63,
+	0x6d, 0x4b, 0x1d, 0x2a, 0x75, 0xdc, 0xc4, 0x3b, 0xfa, 0x2d, 0xde, 0xeb, 0xcb, 0x90, 0x0c, 0x16,
+	0x1a, 0x2e, 0xe1, 0x9d, 0x6e, 0x68, 0xe8, 0xb2, 0x2e, 0x97, 0xf0, 0x4e, 0xda, 0x9f, 0xa4, 0x19,
+	0x74, 0xc6, 0xdf, 0xc4, 0x2c, 0x4c, 0x61, 0xdf, 0xc6, 0xa7, 0xbc, 0xaf, 0xbf, 0x94, 0x75, 0x06,
+	0x2f, 0x5e, 0x31, 0x1c, 0x9d, 0xb8, 0x7e, 0xda, 0x59, 0x19, 0xca, 0x3a, 0x2b, 0x63, 0x50, 0xb3,
+	0xfb, 0x9b, 0x01, 0xab, 0xbb, 0x6d, 0x2a, 0x5c, 0x0e, 0xdf, 0xce, 0x6a, 0xf6, 0x04, 0x4d, 0xee,
+	0x61, 0xb3, 0xdc, 0xa5, 0x00, 0x7c, 0x5a, 0x82, 0x5b, 0xb2, 0xa2, 0x7d, 0x4e, 0xed, 0x0b, 0x5a,
+	0xb4, 0x95, 0x9e, 0x95, 0x3f, 0x99, 0xe7, 0x2b, 0xf0, 0xff, 0x0e, 0x43, 0xe3, 0x42, 0x96, 0xa6,
+	0xad, 0xaf, 0xd0, 0x95, 0xec, 0xe0, 0x54, 0xae, 0xa5, 0xb3, 0x08, 0x6d, 0x63, 0x40, 0x48, 0xcd,
+	0xf6, 0x0e, 0xaa, 0xf2, 0xc6, 0xba, 0x82, 0xce, 0x08, 0x4d, 0xb4, 0x1f, 0x29, 0x71, 0xf7, 0xc9,
+	0xcb, 0xc4, 0x07, 0xf3, 0xdc, 0xe3, 0x77, 0xf9, 0x56, 0xcf, 0x9b, 0x48, 0x5f, 0x29, 0x78, 0xf7,
+	0x63, 0x69, 0xf7, 0xe2, 0xeb, 0x82, 0xc0, 0x21, 0x8b, 0x69, 0x17, 0x7b, 0x6b, 0xc1, 0xde, 0x95,
+	0xf5, 0xa2, 0x2b, 0x48, 0xcd, 0x5a, 0x69, 0xbf, 0x50, 0xd4, 0x7e, 0x4e, 0x33, 0x7b, 0x83, 0xf8,
+	0x69, 0x44, 0xf4, 0xab, 0x3c, 0xf5, 0xcf, 0x9b, 0x10, 0xde, 0x23, 0x94, 0x6b, 0xe9, 0xa9, 0x05,
+	0xed, 0xf3, 0x2f, 0x08, 0x52, 0xb2, 0x97, 0x3f, 0x4d, 0x0f, 0x12, 0x7c, 0x79, 0x5f, 0xba, 0x82,
+	0xfa, 0xc4, 0x96, 0x19, 0xe5, 0x6c, 0x31, 0x7d, 0xd8, 0x9b, 0xb2, 0xf0, 0xea, 0x50, 0xa0, 0x9c,
+	0x7f, 0x27, 0xe8, 0x4d, 0xb9, 0x97, 0x5e, 0x99, 0x72, 0xa2, 0x99, 0x50, 0x4e, 0x97, 0x62, 0x43,
+	0x8d, 0x5e, 0x34, 0xd3, 0xcc, 0xe0, 0x67, 0xf3, 0x3c, 0x44, 0xfd, 0x4f, 0x9e, 0x2f, 0x7f, 0x14,
+	0xcc, 0x52, 0x04, 0x61, 0x31, 0x7a, 0x19, 0x92, 0xaf, 0x13, 0xfa, 0x04, 0xc4, 0xe7, 0xf7, 0x32,
+	0xe5, 0x2b, 0x11, 0xb3, 0x65, 0x31, 0xfd, 0x23, 0x18, 0x22, 0x65, 0x66, 0xe9, 0x30, 0x34, 0x2e,
+	0x67, 0x3d, 0x2e, 0xe5, 0x2f, 0x34, 0x56, 0x2c, 0x96, 0x1f, 0xa7, 0x66, 0x09, 0xcf, 0x77, 0xaf,
+	0x95, 0x15, 0x20, 0x0d, 0x1a, 0x2a, 0x24, 0x01, 0x10, 0x68, 0x7c, 0xfd, 0xe7, 0xd1, 0x2c, 0xad,
+	0x15, 0x28, 0x88, 0x87, 0x27, 0xc4, 0x0e, 0xbf, 0x40, 0xa1, 0x84, 0x97, 0xa7, 0x8a, 0x33, 0x29,
+	0xe9, 0xcd, 0xdc, 0xfb, 0xf8, 0x93, 0xd1, 0x63, 0x07, 0x9f, 0x8c, 0x1e, 0xfb, 0xf8, 0x70, 0x54,
+	0x39, 0x38, 0x1c, 0x55, 0xbe, 0xfe, 0x60, 0xf4, 0xd8, 0xfb, 0x0f, 0x46, 0x95, 0x83, 0x07, 0xa3,
+	0xc7, 0xfe, 0xf2, 0x60, 0xf4, 0xd8, 0x9b, 0xcf, 0x6d, 0xd8, 0x6c, 0x33, 0xa8, 0x5d, 0xb7, 0xdc,
+	0xe6, 0x8d, 0x34, 0x35, 0x17, 0x7e, 0x65, 0x7f, 0xd1, 0xaa, 0x9d, 0xe2, 0xff, 0xc9, 0xba, 0xf9,
+	0x9f, 0x01, 0x00, 0xfd, 0xc7, 0x61, 0x53, 0xff, 0x25, 0x00, 0x00,
 }
 
 func (m *OptionsConfiguration) Marshal() (dAtA []byte, err error) {
@@ -431,6 +440,20 @@ func (m *OptionsConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.ShutdownTimeoutS != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ShutdownTimeoutS))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxScanningFolders != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.MaxScanningFolders))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.ConnectionLimitMax != 0 {
 		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConnectionLimitMax))
 		i--
@@ -1072,6 +1095,12 @@ func (m *OptionsConfiguration) ProtoSize() (n int) {
 	if m.ConnectionLimitMax != 0 {
 		n += 2 + sovOptionsconfiguration(uint64(m.ConnectionLimitMax))
 	}
+	if m.MaxScanningFolders != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.MaxScanningFolders))
+	}
+	if m.ShutdownTimeoutS != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.ShutdownTimeoutS))
+	}
 	if m.DeprecatedUPnPEnabled {
 		n += 4
 	}
@@ -2288,6 +2317,44 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScanningFolders", wireType)
+			}
+			m.MaxScanningFolders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScanningFolders |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShutdownTimeoutS", wireType)
+			}
+			m.ShutdownTimeoutS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShutdownTimeoutS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 9000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPEnabled", wireType)
@@ -0,0 +1,36 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (m RenameDetectionMode) String() string {
+	switch m {
+	case RenameDetectionModeExact:
+		return "exact"
+	case RenameDetectionModeOff:
+		return "off"
+	case RenameDetectionModeFuzzy:
+		return "fuzzy"
+	default:
+		return "unknown"
+	}
+}
+
+func (m RenameDetectionMode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *RenameDetectionMode) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "off":
+		*m = RenameDetectionModeOff
+	case "fuzzy":
+		*m = RenameDetectionModeFuzzy
+	default:
+		*m = RenameDetectionModeExact
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ScanWindow is a daily range of local time, given as "HH:MM-HH:MM". A
+// window that ends before it starts spans midnight.
+type ScanWindow struct {
+	startHour, startMinute int
+	endHour, endMinute     int
+}
+
+func ParseScanWindow(s string) (ScanWindow, error) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 2 {
+		return ScanWindow{}, fmt.Errorf("scan window %q: expected HH:MM-HH:MM", s)
+	}
+	start, err := time.Parse("15:04", strings.TrimSpace(parts[0]))
+	if err != nil {
+		return ScanWindow{}, fmt.Errorf("scan window %q: %w", s, err)
+	}
+	end, err := time.Parse("15:04", strings.TrimSpace(parts[1]))
+	if err != nil {
+		return ScanWindow{}, fmt.Errorf("scan window %q: %w", s, err)
+	}
+	if start.Equal(end) {
+		return ScanWindow{}, fmt.Errorf("scan window %q is empty", s)
+	}
+	return ScanWindow{
+		startHour:   start.Hour(),
+		startMinute: start.Minute(),
+		endHour:     end.Hour(),
+		endMinute:   end.Minute(),
+	}, nil
+}
+
+// Until returns how long it is from t until the window starts, or zero if
+// t is within it. Times are computed in the location of t, on the actual
+// calendar days, such that DST changes are accounted for.
+func (w ScanWindow) Until(t time.Time) time.Duration {
+	spansMidnight := w.endHour*60+w.endMinute < w.startHour*60+w.startMinute
+	y, m, d := t.Date()
+	// The window starting yesterday may span into today, and the one
+	// starting today may already be over.
+	for day := d - 1; ; day++ {
+		start := time.Date(y, m, day, w.startHour, w.startMinute, 0, 0, t.Location())
+		endDay := day
+		if spansMidnight {
+			endDay++
+		}
+		end := time.Date(y, m, endDay, w.endHour, w.endMinute, 0, 0, t.Location())
+		if t.Before(start) {
+			return start.Sub(t)
+		}
+		if t.Before(end) {
+			return 0
+		}
+	}
+}
+
+// UntilScanWindow returns how long it is from t until the next of the
+// folder's scan windows starts, or zero if t is within one or there are
+// none. Invalid windows are ignored.
+func (f FolderConfiguration) UntilScanWindow(t time.Time) time.Duration {
+	var until time.Duration
+	for _, s := range f.ScanWindows {
+		w, err := ParseScanWindow(s)
+		if err != nil {
+			continue
+		}
+		d := w.Until(t)
+		if d == 0 {
+			return 0
+		}
+		if until == 0 || d < until {
+			until = d
+		}
+	}
+	return until
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestScanWindowSpanningMidnight(t *testing.T) {
+	loc := time.FixedZone("test", 2*3600)
+	w, err := ParseScanWindow("22:30-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		hour, minute int
+		until        time.Duration
+	}{
+		{0, 0, 0},
+		{5, 59, 0},
+		{6, 0, 16*time.Hour + 30*time.Minute},
+		{12, 0, 10*time.Hour + 30*time.Minute},
+		{22, 29, time.Minute},
+		{22, 30, 0},
+		{23, 59, 0},
+	}
+	for _, tc := range cases {
+		now := time.Date(2021, 3, 10, tc.hour, tc.minute, 0, 0, loc)
+		if until := w.Until(now); until != tc.until {
+			t.Errorf("%v: expected %v until the window, got %v", now.Format("15:04"), tc.until, until)
+		}
+	}
+}
+
+func TestScanWindowDST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("no time zone data:", err)
+	}
+	w, err := ParseScanWindow("03:00-04:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Clocks went from 02:00 to 03:00 on the night of March 28, 2021.
+	now := time.Date(2021, 3, 27, 22, 0, 0, 0, loc)
+	if until := w.Until(now); until != 4*time.Hour {
+		t.Errorf("expected 4h until the window, got %v", until)
+	}
+}
+
+func TestUntilScanWindow(t *testing.T) {
+	now := time.Date(2021, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	var f FolderConfiguration
+	if until := f.UntilScanWindow(now); until != 0 {
+		t.Errorf("expected no delay without windows, got %v", until)
+	}
+
+	f.ScanWindows = []string{"20:00-23:00", "invalid", "14:00-15:00"}
+	if until := f.UntilScanWindow(now); until != 2*time.Hour {
+		t.Errorf("expected 2h until the earliest window, got %v", until)
+	}
+
+	f.ScanWindows = append(f.ScanWindows, "11:00-13:00")
+	if until := f.UntilScanWindow(now); until != 0 {
+		t.Errorf("expected no delay within a window, got %v", until)
+	}
+}
+
+func TestParseScanWindowInvalid(t *testing.T) {
+	for _, s := range []string{"", "22:00", "22:00-", "25:00-06:00", "06:00-06:00", "1-2-3"} {
+		if _, err := ParseScanWindow(s); err == nil {
+			t.Errorf("expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestPrepareScanWindowsCopies(t *testing.T) {
+	windows := []string{"invalid", "20:00-23:00"}
+	f := FolderConfiguration{ID: "default", ScanWindows: windows}
+	f.prepare(protocol.LocalDeviceID, nil)
+	if !reflect.DeepEqual(f.ScanWindows, []string{"20:00-23:00"}) {
+		t.Errorf("expected only the valid window, got %v", f.ScanWindows)
+	}
+	if windows[0] != "invalid" {
+		t.Errorf("expected the given windows to be left alone, got %v", windows)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (f SourceFallback) String() string {
+	switch f {
+	case SourceFallbackAny:
+		return "any"
+	case SourceFallbackHold:
+		return "hold"
+	default:
+		return "unknown"
+	}
+}
+
+func (f SourceFallback) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+func (f *SourceFallback) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "any":
+		*f = SourceFallbackAny
+	case "hold":
+		*f = SourceFallbackHold
+	default:
+		*f = SourceFallbackAny
+	}
+	return nil
+}
@@ -22,6 +22,9 @@ type internalVersioningConfiguration struct {
 	CleanupIntervalS int               `xml:"cleanupIntervalS" default:"3600"`
 	FSPath           string            `xml:"fsPath"`
 	FSType           fs.FilesystemType `xml:"fsType"`
+
+	CleanupSkipAboveFree       Size `xml:"cleanupSkipAboveFree"`
+	CleanupAggressiveBelowFree Size `xml:"cleanupAggressiveBelowFree"`
 }
 
 type internalParam struct {
@@ -69,6 +72,8 @@ func (c *VersioningConfiguration) toInternal() internalVersioningConfiguration {
 	tmp.CleanupIntervalS = c.CleanupIntervalS
 	tmp.FSPath = c.FSPath
 	tmp.FSType = c.FSType
+	tmp.CleanupSkipAboveFree = c.CleanupSkipAboveFree
+	tmp.CleanupAggressiveBelowFree = c.CleanupAggressiveBelowFree
 	for k, v := range c.Params {
 		tmp.Params = append(tmp.Params, internalParam{k, v})
 	}
@@ -83,6 +88,8 @@ func (c *VersioningConfiguration) fromInternal(intCfg internalVersioningConfigur
 	c.CleanupIntervalS = intCfg.CleanupIntervalS
 	c.FSPath = intCfg.FSPath
 	c.FSType = intCfg.FSType
+	c.CleanupSkipAboveFree = intCfg.CleanupSkipAboveFree
+	c.CleanupAggressiveBelowFree = intCfg.CleanupAggressiveBelowFree
 	c.Params = make(map[string]string, len(intCfg.Params))
 	for _, p := range intCfg.Params {
 		c.Params[p.Key] = p.Val
@@ -31,6 +31,11 @@ type VersioningConfiguration struct {
 	CleanupIntervalS int               `protobuf:"varint,3,opt,name=cleanup_interval_s,json=cleanupIntervalS,proto3,casttype=int" json:"cleanupIntervalS" xml:"cleanupIntervalS" default:"3600"`
 	FSPath           string            `protobuf:"bytes,4,opt,name=fs_path,json=fsPath,proto3" json:"fsPath" xml:"fsPath"`
 	FSType           fs.FilesystemType `protobuf:"varint,5,opt,name=fs_type,json=fsType,proto3,enum=fs.FilesystemType" json:"fsType" xml:"fsType"`
+	// With more free space than cleanup_skip_above_free on the versions
+	// filesystem, versions aren't cleaned up. With less than
+	// cleanup_aggressive_below_free, they are pruned aggressively.
+	CleanupSkipAboveFree       Size `protobuf:"bytes,6,opt,name=cleanup_skip_above_free,json=cleanupSkipAboveFree,proto3" json:"cleanupSkipAboveFree" xml:"cleanupSkipAboveFree"`
+	CleanupAggressiveBelowFree Size `protobuf:"bytes,7,opt,name=cleanup_aggressive_below_free,json=cleanupAggressiveBelowFree,proto3" json:"cleanupAggressiveBelowFree" xml:"cleanupAggressiveBelowFree"`
 }
 
 func (m *VersioningConfiguration) Reset()         { *m = VersioningConfiguration{} }
@@ -76,39 +81,46 @@ func init() {
 }
 
 var fileDescriptor_95ba6bdb22ffea81 = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4f, 0x6b, 0xdb, 0x4e,
-	0x10, 0x95, 0xfc, 0xef, 0x87, 0x95, 0x1f, 0x4d, 0x59, 0x0a, 0x15, 0x3e, 0x68, 0x8d, 0x70, 0x8b,
-	0x0a, 0x45, 0x0e, 0x09, 0x94, 0x62, 0x0a, 0x05, 0x97, 0xa6, 0x94, 0xf6, 0x10, 0x94, 0xd0, 0x43,
-	0x7b, 0x30, 0x6b, 0x77, 0x65, 0x2f, 0x91, 0x57, 0x42, 0xbb, 0x36, 0x51, 0x3f, 0x45, 0xe8, 0x27,
-	0xe8, 0xc7, 0xf1, 0xcd, 0x3e, 0xf6, 0xb4, 0x10, 0xfb, 0xa6, 0xa3, 0x8e, 0xe9, 0xa5, 0xec, 0xae,
-	0xa2, 0x9a, 0x94, 0xde, 0xe6, 0xcd, 0x7b, 0xf3, 0x66, 0x46, 0xb3, 0xb2, 0xbc, 0x88, 0x8c, 0xfb,
-	0x93, 0x98, 0x86, 0x64, 0xda, 0x5f, 0xe2, 0x94, 0x91, 0x98, 0x12, 0x3a, 0xd5, 0x89, 0x45, 0x8a,
-	0x38, 0x89, 0xa9, 0x9f, 0xa4, 0x31, 0x8f, 0x41, 0x4b, 0x27, 0x3b, 0x40, 0x56, 0x84, 0xac, 0xcf,
-	0xb3, 0x04, 0x33, 0xcd, 0x75, 0xda, 0xf8, 0x8a, 0xeb, 0xd0, 0xfd, 0xd5, 0xb0, 0x1e, 0x7f, 0xaa,
-	0x8c, 0xde, 0xec, 0x1b, 0x81, 0x57, 0x56, 0x43, 0x56, 0xd9, 0x66, 0xd7, 0xf4, 0xda, 0x43, 0x2f,
-	0x17, 0x50, 0xe1, 0x42, 0xc0, 0xc3, 0xab, 0x79, 0x34, 0x70, 0x25, 0x78, 0x8e, 0x38, 0x4f, 0xdd,
-	0x7c, 0xdd, 0x6b, 0x57, 0x28, 0x50, 0x2a, 0x70, 0x6d, 0x5a, 0x56, 0x82, 0x52, 0x34, 0xc7, 0x1c,
-	0xa7, 0xcc, 0xae, 0x75, 0xeb, 0xde, 0xc1, 0x71, 0xdf, 0xd7, 0x63, 0xf9, 0xff, 0xe8, 0xe9, 0x9f,
-	0x55, 0x15, 0x6f, 0x29, 0x4f, 0xb3, 0xe1, 0xeb, 0x95, 0x80, 0xc6, 0x56, 0xc0, 0x96, 0x22, 0x58,
-	0x2e, 0x60, 0x4b, 0x99, 0xb2, 0x6a, 0x8a, 0xaa, 0x87, 0x5b, 0xac, 0x7b, 0x25, 0xf9, 0x7d, 0xd3,
-	0x2b, 0x0b, 0x82, 0xbd, 0x19, 0xc0, 0x37, 0x0b, 0x4c, 0x22, 0x8c, 0xe8, 0x22, 0x19, 0x11, 0xca,
-	0x71, 0xba, 0x44, 0xd1, 0x88, 0xd9, 0xf5, 0xae, 0xe9, 0x35, 0x87, 0x1f, 0x73, 0x01, 0x1f, 0x96,
-	0xec, 0xfb, 0x92, 0x3c, 0x2f, 0x04, 0x7c, 0xa2, 0x9a, 0xdc, 0x27, 0xdc, 0xee, 0x57, 0x1c, 0xa2,
-	0x45, 0xc4, 0x07, 0xee, 0xc9, 0x8b, 0xa3, 0x23, 0xf7, 0x56, 0xc0, 0x3a, 0xa1, 0xfc, 0x76, 0xdd,
-	0x6b, 0x48, 0x1c, 0xfc, 0xe5, 0x04, 0xde, 0x59, 0xff, 0x85, 0x6c, 0x94, 0x20, 0x3e, 0xb3, 0x1b,
-	0xea, 0x7b, 0xfa, 0x72, 0xab, 0xd3, 0xf3, 0x33, 0xc4, 0x67, 0x72, 0xab, 0x90, 0xc9, 0xa8, 0x10,
-	0xf0, 0x7f, 0xd5, 0x50, 0x43, 0x57, 0x2e, 0xa2, 0x35, 0x41, 0xa9, 0x00, 0x5f, 0x94, 0x91, 0x3a,
-	0x4c, 0xb3, 0x6b, 0x7a, 0x0f, 0x8e, 0x81, 0x1f, 0x32, 0xff, 0x94, 0x44, 0x98, 0x65, 0x8c, 0xe3,
-	0xf9, 0x45, 0x96, 0xe0, 0x3b, 0x73, 0x19, 0x6b, 0xf3, 0x0b, 0x7d, 0xb8, 0x3b, 0x73, 0x09, 0x4b,
-	0x73, 0x19, 0x06, 0xa5, 0xa2, 0x33, 0xb7, 0x0e, 0xef, 0x5d, 0x00, 0x3c, 0xb5, 0xea, 0x97, 0x38,
-	0x2b, 0x1f, 0xc1, 0xa3, 0x5c, 0x40, 0x09, 0x0b, 0x01, 0xdb, 0xca, 0xea, 0x12, 0x67, 0x6e, 0x20,
-	0x33, 0xc0, 0xb7, 0x9a, 0x4b, 0x14, 0x2d, 0xb0, 0x5d, 0x53, 0x4a, 0x3b, 0x17, 0x50, 0x27, 0x0a,
-	0x01, 0x0f, 0x94, 0x56, 0x21, 0x37, 0xd0, 0xd9, 0x41, 0xed, 0xa5, 0x39, 0xfc, 0xb0, 0xba, 0x71,
-	0x8c, 0xcd, 0x8d, 0x63, 0xac, 0xb6, 0x8e, 0xb9, 0xd9, 0x3a, 0xe6, 0xf5, 0xce, 0x31, 0x7e, 0xec,
-	0x1c, 0x73, 0xb3, 0x73, 0x8c, 0x9f, 0x3b, 0xc7, 0xf8, 0xfc, 0x6c, 0x4a, 0xf8, 0x6c, 0x31, 0xf6,
-	0x27, 0xf1, 0xbc, 0xcf, 0x32, 0x3a, 0xe1, 0x33, 0x42, 0xa7, 0x7b, 0xd1, 0x9f, 0xff, 0x61, 0xdc,
-	0x52, 0x2f, 0xfa, 0xe4, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x04, 0xd0, 0xd5, 0x24, 0x03,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4f, 0x6b, 0xdb, 0x4e,
+	0x10, 0xb5, 0xe2, 0xc4, 0xc1, 0x4a, 0xf8, 0xe5, 0xc7, 0x92, 0x12, 0x61, 0xa8, 0x56, 0x08, 0xb7,
+	0xa8, 0x50, 0xe4, 0x90, 0x40, 0x29, 0xa6, 0x50, 0xe2, 0x52, 0x97, 0xd2, 0x1e, 0x82, 0x1c, 0x7a,
+	0x68, 0x0f, 0x46, 0x76, 0x57, 0xf6, 0x62, 0x59, 0x12, 0xda, 0xb5, 0x1b, 0xe5, 0xd2, 0xaf, 0x10,
+	0x7a, 0x68, 0xaf, 0xfd, 0x38, 0xb9, 0xc5, 0xc7, 0x9e, 0x16, 0x12, 0xdf, 0x74, 0xf4, 0x31, 0xa7,
+	0xb2, 0x7f, 0xec, 0xaa, 0x69, 0xda, 0xdb, 0xbc, 0x99, 0x79, 0x6f, 0xde, 0xee, 0xec, 0xea, 0x4e,
+	0x88, 0x7b, 0x8d, 0x7e, 0x1c, 0x05, 0x78, 0xd0, 0x98, 0xa2, 0x94, 0xe0, 0x38, 0xc2, 0xd1, 0x40,
+	0x26, 0x26, 0xa9, 0x4f, 0x71, 0x1c, 0xb9, 0x49, 0x1a, 0xd3, 0x18, 0x54, 0x64, 0xb2, 0x06, 0x38,
+	0x23, 0x20, 0x0d, 0x9a, 0x25, 0x88, 0xc8, 0x5a, 0xed, 0x5e, 0x41, 0x85, 0xe0, 0x33, 0xa4, 0xd2,
+	0x55, 0x74, 0x4a, 0x65, 0x68, 0x7f, 0xdd, 0xd4, 0xf7, 0xde, 0xad, 0xf4, 0x5f, 0x14, 0xf5, 0xc1,
+	0x33, 0x7d, 0x9d, 0x8b, 0x19, 0x9a, 0xa5, 0x39, 0xd5, 0x96, 0x93, 0x33, 0x28, 0xf0, 0x82, 0xc1,
+	0x9d, 0xd3, 0x71, 0xd8, 0xb4, 0x39, 0x78, 0xec, 0x53, 0x9a, 0xda, 0xf9, 0x65, 0xbd, 0xba, 0x42,
+	0x9e, 0xe8, 0x02, 0xe7, 0x9a, 0xae, 0x27, 0x7e, 0xea, 0x8f, 0x11, 0x45, 0x29, 0x31, 0xd6, 0xac,
+	0xb2, 0xb3, 0x75, 0xd0, 0x70, 0xa5, 0x1b, 0xf7, 0x2f, 0x33, 0xdd, 0xe3, 0x15, 0xe3, 0x65, 0x44,
+	0xd3, 0xac, 0xf5, 0xfc, 0x82, 0xc1, 0xd2, 0x35, 0x83, 0x15, 0x51, 0x20, 0x39, 0x83, 0x15, 0x21,
+	0x4a, 0x56, 0x2e, 0x56, 0x33, 0xec, 0xc5, 0x65, 0x5d, 0x15, 0xbf, 0xcc, 0xea, 0x8a, 0xe0, 0x15,
+	0x3c, 0x80, 0x33, 0x1d, 0xf4, 0x43, 0xe4, 0x47, 0x93, 0xa4, 0x8b, 0x23, 0x8a, 0xd2, 0xa9, 0x1f,
+	0x76, 0x89, 0x51, 0xb6, 0x34, 0x67, 0xa3, 0xf5, 0x36, 0x67, 0xf0, 0x7f, 0x55, 0x7d, 0xad, 0x8a,
+	0x9d, 0x05, 0x83, 0x0f, 0xc4, 0x90, 0xdb, 0x05, 0xdb, 0xfa, 0x88, 0x02, 0x7f, 0x12, 0xd2, 0xa6,
+	0x7d, 0xf8, 0x64, 0x7f, 0xdf, 0xbe, 0x61, 0xb0, 0x8c, 0x23, 0x7a, 0x73, 0x59, 0x5f, 0xe7, 0xd8,
+	0xfb, 0x43, 0x09, 0xbc, 0xd2, 0x37, 0x03, 0xd2, 0x4d, 0x7c, 0x3a, 0x34, 0xd6, 0xc5, 0x7d, 0xba,
+	0xfc, 0x54, 0xed, 0xce, 0xb1, 0x4f, 0x87, 0xfc, 0x54, 0x01, 0xe1, 0xd1, 0x82, 0xc1, 0x6d, 0x31,
+	0x50, 0x42, 0x9b, 0x1f, 0x44, 0xf6, 0x78, 0xaa, 0x03, 0x7c, 0x10, 0x42, 0x62, 0x31, 0x1b, 0x96,
+	0xe6, 0xfc, 0x77, 0x00, 0xdc, 0x80, 0xb8, 0x6d, 0x1c, 0x22, 0x92, 0x11, 0x8a, 0xc6, 0x27, 0x59,
+	0x82, 0x96, 0xe2, 0x3c, 0x96, 0xe2, 0x27, 0x72, 0x71, 0x4b, 0x71, 0x0e, 0x95, 0x38, 0x0f, 0x3d,
+	0xd5, 0x01, 0x3e, 0xeb, 0x7b, 0xcb, 0x1b, 0x22, 0x23, 0x9c, 0x74, 0xfd, 0x5e, 0x3c, 0x45, 0xdd,
+	0x20, 0x45, 0xc8, 0xa8, 0x58, 0x9a, 0xb3, 0x75, 0xb0, 0xbd, 0x5c, 0x60, 0x07, 0x9f, 0xa1, 0x56,
+	0x93, 0x6f, 0x27, 0x67, 0x70, 0x57, 0x91, 0x3a, 0x23, 0x9c, 0x1c, 0x71, 0x4a, 0x3b, 0x45, 0x7c,
+	0x5c, 0xad, 0x78, 0x79, 0xbf, 0x15, 0x6d, 0xef, 0x4e, 0x0e, 0xf8, 0xa6, 0xe9, 0xf7, 0x97, 0x0e,
+	0xfc, 0xc1, 0x20, 0x45, 0x84, 0xe0, 0x29, 0xea, 0xf6, 0x50, 0x18, 0x7f, 0x92, 0x3e, 0x36, 0xef,
+	0xf0, 0xd1, 0x56, 0x3e, 0x6a, 0x8a, 0x7a, 0xb4, 0x62, 0xb6, 0x38, 0x51, 0xb9, 0xb1, 0x8a, 0x6e,
+	0xee, 0x68, 0xb1, 0xbd, 0x7f, 0xf0, 0x6b, 0x63, 0x7d, 0xe7, 0xd6, 0xe3, 0x04, 0x0f, 0xf5, 0xf2,
+	0x08, 0x65, 0xea, 0x7f, 0xec, 0xe6, 0x0c, 0x72, 0xb8, 0x60, 0xb0, 0x2a, 0x06, 0x8d, 0x50, 0x66,
+	0x7b, 0x3c, 0x03, 0x5c, 0x7d, 0x63, 0xea, 0x87, 0x13, 0x64, 0xac, 0x89, 0x4e, 0x23, 0x67, 0x50,
+	0x26, 0x16, 0x0c, 0x6e, 0x89, 0x5e, 0x81, 0x6c, 0x4f, 0x66, 0x9b, 0x6b, 0x4f, 0xb5, 0xd6, 0x9b,
+	0x8b, 0x2b, 0xb3, 0x34, 0xbb, 0x32, 0x4b, 0x17, 0xd7, 0xa6, 0x36, 0xbb, 0x36, 0xb5, 0xf3, 0xb9,
+	0x59, 0xfa, 0x3e, 0x37, 0xb5, 0xd9, 0xdc, 0x2c, 0xfd, 0x98, 0x9b, 0xa5, 0xf7, 0x8f, 0x06, 0x98,
+	0x0e, 0x27, 0x3d, 0xb7, 0x1f, 0x8f, 0x1b, 0x24, 0x8b, 0xfa, 0x74, 0x88, 0xa3, 0x41, 0x21, 0xfa,
+	0xf5, 0xf7, 0x7b, 0x15, 0xf1, 0xd9, 0x0f, 0x7f, 0x0e, 0x00, 0xe0, 0x9c, 0x3d, 0xb3, 0x56, 0x04,
 	0x00, 0x00,
 }
 
@@ -132,6 +144,26 @@ func (m *VersioningConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CleanupAggressiveBelowFree.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.CleanupSkipAboveFree.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.FSType != 0 {
 		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(m.FSType))
 		i--
@@ -217,6 +249,10 @@ func (m *VersioningConfiguration) ProtoSize() (n int) {
 	if m.FSType != 0 {
 		n += 1 + sovVersioningconfiguration(uint64(m.FSType))
 	}
+	l = m.CleanupSkipAboveFree.ProtoSize()
+	n += 1 + l + sovVersioningconfiguration(uint64(l))
+	l = m.CleanupAggressiveBelowFree.ProtoSize()
+	n += 1 + l + sovVersioningconfiguration(uint64(l))
 	return n
 }
 
@@ -484,6 +520,72 @@ func (m *VersioningConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanupSkipAboveFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersioningconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CleanupSkipAboveFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanupAggressiveBelowFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersioningconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CleanupAggressiveBelowFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVersioningconfiguration(dAtA[iNdEx:])
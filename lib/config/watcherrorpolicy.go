@@ -0,0 +1,38 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (p WatchErrorPolicy) String() string {
+	switch p {
+	case WatchErrorPolicyFullRescan:
+		return "fullRescan"
+	case WatchErrorPolicyTargeted:
+		return "targeted"
+	case WatchErrorPolicyEscalate:
+		return "escalate"
+	default:
+		return "unknown"
+	}
+}
+
+func (p WatchErrorPolicy) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *WatchErrorPolicy) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "fullRescan":
+		*p = WatchErrorPolicyFullRescan
+	case "targeted":
+		*p = WatchErrorPolicyTargeted
+	case "escalate":
+		*p = WatchErrorPolicyEscalate
+	default:
+		*p = WatchErrorPolicyFullRescan
+	}
+	return nil
+}
@@ -74,6 +74,12 @@ const (
 
 	// KeyTypePendingDevice <device ID in wire format> = ObservedDevice
 	KeyTypePendingDevice byte = 17
+
+	// KeyTypeDirHash <int32 folder ID> <directory name> = listing hash
+	KeyTypeDirHash byte = 18
+
+	// KeyTypeDirMtime <int32 folder ID> <directory name> = modification time
+	KeyTypeDirMtime byte = 19
 )
 
 type keyer interface {
@@ -107,6 +113,12 @@ type keyer interface {
 	// Mtimes
 	GenerateMtimesKey(key, folder []byte) (mtimesKey, error)
 
+	// Directory listing hashes
+	GenerateDirHashesKey(key, folder []byte) (dirHashesKey, error)
+
+	// Directory modification times
+	GenerateDirMtimesKey(key, folder []byte) (dirMtimesKey, error)
+
 	// Folder metadata
 	GenerateFolderMetaKey(key, folder []byte) (folderMetaKey, error)
 
@@ -321,6 +333,32 @@ func (k defaultKeyer) GenerateMtimesKey(key, folder []byte) (mtimesKey, error) {
 	return key, nil
 }
 
+type dirHashesKey []byte
+
+func (k defaultKeyer) GenerateDirHashesKey(key, folder []byte) (dirHashesKey, error) {
+	folderID, err := k.folderIdx.ID(folder)
+	if err != nil {
+		return nil, err
+	}
+	key = resize(key, keyPrefixLen+keyFolderLen)
+	key[0] = KeyTypeDirHash
+	binary.BigEndian.PutUint32(key[keyPrefixLen:], folderID)
+	return key, nil
+}
+
+type dirMtimesKey []byte
+
+func (k defaultKeyer) GenerateDirMtimesKey(key, folder []byte) (dirMtimesKey, error) {
+	folderID, err := k.folderIdx.ID(folder)
+	if err != nil {
+		return nil, err
+	}
+	key = resize(key, keyPrefixLen+keyFolderLen)
+	key[0] = KeyTypeDirMtime
+	binary.BigEndian.PutUint32(key[keyPrefixLen:], folderID)
+	return key, nil
+}
+
 type folderMetaKey []byte
 
 func (k defaultKeyer) GenerateFolderMetaKey(key, folder []byte) (folderMetaKey, error) {
@@ -595,6 +595,22 @@ func (db *Lowlevel) dropMtimes(folder []byte) error {
 	return db.dropPrefix(key)
 }
 
+func (db *Lowlevel) dropDirHashes(folder []byte) error {
+	key, err := db.keyer.GenerateDirHashesKey(nil, folder)
+	if err != nil {
+		return err
+	}
+	return db.dropPrefix(key)
+}
+
+func (db *Lowlevel) dropDirMtimes(folder []byte) error {
+	key, err := db.keyer.GenerateDirMtimesKey(nil, folder)
+	if err != nil {
+		return err
+	}
+	return db.dropPrefix(key)
+}
+
 func (db *Lowlevel) dropFolderMeta(folder []byte) error {
 	key, err := db.keyer.GenerateFolderMetaKey(nil, folder)
 	if err != nil {
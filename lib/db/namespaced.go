@@ -89,6 +89,22 @@ func (n *NamespacedKV) PutBytes(key string, val []byte) error {
 	return n.db.Put(n.prefixedKey(key), val)
 }
 
+// PutBytesMany stores the given byte slices by key in a single transaction.
+// Any existing values (even if of another type) are overwritten.
+func (n *NamespacedKV) PutBytesMany(vals map[string][]byte) error {
+	t, err := n.db.NewWriteTransaction()
+	if err != nil {
+		return err
+	}
+	defer t.Release()
+	for key, val := range vals {
+		if err := t.Put(n.prefixedKey(key), val); err != nil {
+			return err
+		}
+	}
+	return t.Commit()
+}
+
 // Bytes returns the stored value as a raw byte slice and a boolean that
 // is false if no value was stored at the key.
 func (n NamespacedKV) Bytes(key string) ([]byte, bool, error) {
@@ -124,6 +140,38 @@ func (n NamespacedKV) Delete(key string) error {
 	return n.db.Delete(n.prefixedKey(key))
 }
 
+// DeleteMany deletes the specified keys in a single transaction. It is
+// allowed to delete nonexistent keys.
+func (n NamespacedKV) DeleteMany(keys []string) error {
+	t, err := n.db.NewWriteTransaction()
+	if err != nil {
+		return err
+	}
+	defer t.Release()
+	for _, key := range keys {
+		if err := t.Delete(n.prefixedKey(key)); err != nil {
+			return err
+		}
+	}
+	return t.Commit()
+}
+
+// Iterate calls fn for all keys starting with the given prefix, in order,
+// until it returns false. The value is only valid during the call.
+func (n NamespacedKV) Iterate(prefix string, fn func(key string, val []byte) bool) error {
+	it, err := n.db.NewPrefixIterator(n.prefixedKey(prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Release()
+	for it.Next() {
+		if !fn(string(it.Key()[len(n.prefix):]), it.Value()) {
+			break
+		}
+	}
+	return it.Error()
+}
+
 func (n NamespacedKV) prefixedKey(key string) []byte {
 	return []byte(n.prefix + key)
 }
@@ -175,3 +175,60 @@ func reset(n *NamespacedKV) {
 	it.Release()
 	_ = tr.Commit()
 }
+
+func TestNamespacedIterate(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	n1 := NewNamespacedKV(ldb, "foo")
+	n2 := NewNamespacedKV(ldb, "foobar")
+
+	for _, key := range []string{"b/2", "a/1", "b/1", "b/3"} {
+		if err := n1.PutString(key, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := n2.PutString("b/4", "b/4"); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	err := n1.Iterate("b/", func(key string, val []byte) bool {
+		if key != string(val) {
+			t.Errorf("Key %v has value %s", key, val)
+		}
+		keys = append(keys, key)
+		return len(keys) < 2
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "b/1" || keys[1] != "b/2" {
+		t.Errorf("Unexpected keys %v", keys)
+	}
+}
+
+func TestNamespacedMany(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	n1 := NewNamespacedKV(ldb, "foo")
+
+	if err := n1.PutBytesMany(map[string][]byte{"a": []byte("a"), "b": []byte("b"), "c": []byte("c")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := n1.DeleteMany([]string{"a", "c", "nonexistent"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	if err := n1.Iterate("", func(key string, _ []byte) bool {
+		keys = append(keys, key)
+		return true
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != "b" {
+		t.Errorf("Unexpected keys %v", keys)
+	}
+}
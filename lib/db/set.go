@@ -402,6 +402,57 @@ func (s *FileSet) MtimeFS() fs.Filesystem {
 	return fs.NewMtimeFS(s.fs, kv)
 }
 
+// DirHashes returns a namespace holding the listing hashes of the folder's
+// directories as stored by the scanner.
+func (s *FileSet) DirHashes() *NamespacedKV {
+	opStr := fmt.Sprintf("%s DirHashes()", s.folder)
+	l.Debugf(opStr)
+	prefix, err := s.db.keyer.GenerateDirHashesKey(nil, []byte(s.folder))
+	if backend.IsClosed(err) {
+		return nil
+	} else if err != nil {
+		fatalError(err, opStr, s.db)
+	}
+	return NewNamespacedKV(s.db, string(prefix))
+}
+
+// DropDirHashes removes all stored directory listing hashes of the folder.
+func (s *FileSet) DropDirHashes() {
+	opStr := fmt.Sprintf("%s DropDirHashes()", s.folder)
+	l.Debugf(opStr)
+	if err := s.db.dropDirHashes([]byte(s.folder)); backend.IsClosed(err) {
+		return
+	} else if err != nil {
+		fatalError(err, opStr, s.db)
+	}
+}
+
+// DirMtimes returns a namespace holding the modification times of the
+// folder's directories as of the last scan that covered them.
+func (s *FileSet) DirMtimes() *NamespacedKV {
+	opStr := fmt.Sprintf("%s DirMtimes()", s.folder)
+	l.Debugf(opStr)
+	prefix, err := s.db.keyer.GenerateDirMtimesKey(nil, []byte(s.folder))
+	if backend.IsClosed(err) {
+		return nil
+	} else if err != nil {
+		fatalError(err, opStr, s.db)
+	}
+	return NewNamespacedKV(s.db, string(prefix))
+}
+
+// DropDirMtimes removes all stored directory modification times of the
+// folder.
+func (s *FileSet) DropDirMtimes() {
+	opStr := fmt.Sprintf("%s DropDirMtimes()", s.folder)
+	l.Debugf(opStr)
+	if err := s.db.dropDirMtimes([]byte(s.folder)); backend.IsClosed(err) {
+		return
+	} else if err != nil {
+		fatalError(err, opStr, s.db)
+	}
+}
+
 func (s *FileSet) ListDevices() []protocol.DeviceID {
 	return s.meta.devices()
 }
@@ -426,6 +477,8 @@ func DropFolder(db *Lowlevel, folder string) {
 	droppers := []func([]byte) error{
 		db.dropFolder,
 		db.dropMtimes,
+		db.dropDirHashes,
+		db.dropDirMtimes,
 		db.dropFolderMeta,
 		db.dropFolderIndexIDs,
 		db.folderIdx.Delete,
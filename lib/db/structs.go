@@ -131,6 +131,12 @@ func (f FileInfoTruncated) ConvertToIgnoredFileInfo() protocol.FileInfo {
 	return file
 }
 
+func (f FileInfoTruncated) ConvertToUnsupportedFileInfo() protocol.FileInfo {
+	file := f.copyToFileInfo()
+	file.SetUnsupported()
+	return file
+}
+
 func (f FileInfoTruncated) ConvertToDeletedFileInfo(by protocol.ShortID) protocol.FileInfo {
 	file := f.copyToFileInfo()
 	file.SetDeleted(by)
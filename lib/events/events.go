@@ -56,6 +56,18 @@ const (
 	ListenAddressesChanged
 	LoginAttempt
 	Failure
+	ItemRejected
+	ChronicConflictDetected
+	FolderConfigApplied
+	ScanDelayed
+	RemoteChangeSummary
+	LocalRenameDetected
+	LocalChangesReverted
+	FolderCommandOutput
+	FolderScanCompleted
+	FolderCaseConflict
+	FolderErrorChanged
+	FolderScanPhase
 
 	AllEvents = (1 << iota) - 1
 )
@@ -95,6 +107,10 @@ func (t EventType) String() string {
 		return "ItemStarted"
 	case ItemFinished:
 		return "ItemFinished"
+	case ItemRejected:
+		return "ItemRejected"
+	case ChronicConflictDetected:
+		return "ChronicConflictDetected"
 	case StateChanged:
 		return "StateChanged"
 	case FolderRejected:
@@ -123,6 +139,26 @@ func (t EventType) String() string {
 		return "FolderPaused"
 	case FolderResumed:
 		return "FolderResumed"
+	case FolderConfigApplied:
+		return "FolderConfigApplied"
+	case ScanDelayed:
+		return "ScanDelayed"
+	case RemoteChangeSummary:
+		return "RemoteChangeSummary"
+	case LocalRenameDetected:
+		return "LocalRenameDetected"
+	case LocalChangesReverted:
+		return "LocalChangesReverted"
+	case FolderCommandOutput:
+		return "FolderCommandOutput"
+	case FolderScanCompleted:
+		return "FolderScanCompleted"
+	case FolderCaseConflict:
+		return "FolderCaseConflict"
+	case FolderErrorChanged:
+		return "FolderErrorChanged"
+	case FolderScanPhase:
+		return "FolderScanPhase"
 	case ListenAddressesChanged:
 		return "ListenAddressesChanged"
 	case LoginAttempt:
@@ -180,6 +216,10 @@ func UnmarshalEventType(s string) EventType {
 		return ItemStarted
 	case "ItemFinished":
 		return ItemFinished
+	case "ItemRejected":
+		return ItemRejected
+	case "ChronicConflictDetected":
+		return ChronicConflictDetected
 	case "StateChanged":
 		return StateChanged
 	case "FolderRejected":
@@ -208,6 +248,26 @@ func UnmarshalEventType(s string) EventType {
 		return FolderPaused
 	case "FolderResumed":
 		return FolderResumed
+	case "FolderConfigApplied":
+		return FolderConfigApplied
+	case "ScanDelayed":
+		return ScanDelayed
+	case "RemoteChangeSummary":
+		return RemoteChangeSummary
+	case "LocalRenameDetected":
+		return LocalRenameDetected
+	case "LocalChangesReverted":
+		return LocalChangesReverted
+	case "FolderCommandOutput":
+		return FolderCommandOutput
+	case "FolderScanCompleted":
+		return FolderScanCompleted
+	case "FolderCaseConflict":
+		return FolderCaseConflict
+	case "FolderErrorChanged":
+		return FolderErrorChanged
+	case "FolderScanPhase":
+		return FolderScanPhase
 	case "ListenAddressesChanged":
 		return ListenAddressesChanged
 	case "LoginAttempt":
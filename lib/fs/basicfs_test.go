@@ -593,3 +593,9 @@ func TestWalkInfiniteRecursion(t *testing.T) {
 	defer os.RemoveAll(dir)
 	testWalkInfiniteRecursion(t, FilesystemTypeBasic, dir)
 }
+
+func TestWalkWorkers(t *testing.T) {
+	_, dir := setup(t)
+	defer os.RemoveAll(dir)
+	testWalkWorkers(t, FilesystemTypeBasic, dir)
+}
@@ -34,13 +34,20 @@ func (f *BasicFilesystem) Watch(name string, ignore Matcher, ctx context.Context
 		eventMask |= permEventMask
 	}
 
+	var filter func(string) bool
 	if ignore.SkipIgnoredDirs() {
+		filter = ignore.ShouldIgnore
+	} else if pruner, ok := ignore.(WatchPruner); ok {
+		filter = pruner.CanPruneWatch
+	}
+
+	if filter != nil {
 		absShouldIgnore := func(absPath string) bool {
 			rel, err := f.unrootedChecked(absPath, roots)
 			if err != nil {
 				return true
 			}
-			return ignore.ShouldIgnore(rel)
+			return filter(rel)
 		}
 		err = notify.WatchWithFilter(watchPath, backendChan, absShouldIgnore, eventMask)
 	} else {
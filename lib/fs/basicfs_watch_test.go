@@ -117,6 +117,31 @@ func TestWatchInclude(t *testing.T) {
 	testScenario(t, name, testCase, expectedEvents, allowedEvents, fakeMatcher{ignore: filepath.Join(name, ignored), include: filepath.Join(name, included)})
 }
 
+func TestWatchPrune(t *testing.T) {
+	if runtime.GOOS == "openbsd" {
+		t.Skip(failsOnOpenBSD)
+	}
+	name := "prune"
+
+	file := "file"
+	pruned := "pruned"
+	testFs.MkdirAll(filepath.Join(name, pruned), 0777)
+
+	testCase := func() {
+		createTestFile(name, filepath.Join(pruned, file))
+		sleepMs(100) // make sure an event for the above would come first
+		createTestFile(name, file)
+	}
+
+	expectedEvents := []Event{
+		{file, NonRemove},
+	}
+
+	// The matcher ignores nothing, thus the event within the pruned dir
+	// would be passed on if the dir was watched.
+	testScenario(t, name, testCase, expectedEvents, nil, fakePruningMatcher{prune: filepath.Join(name, pruned)})
+}
+
 func TestWatchRename(t *testing.T) {
 	if runtime.GOOS == "openbsd" {
 		t.Skip(failsOnOpenBSD)
@@ -442,7 +467,7 @@ func sleepMs(ms int) {
 	time.Sleep(time.Duration(ms) * time.Millisecond)
 }
 
-func testScenario(t *testing.T, name string, testCase func(), expectedEvents, allowedEvents []Event, fm fakeMatcher) {
+func testScenario(t *testing.T, name string, testCase func(), expectedEvents, allowedEvents []Event, fm Matcher) {
 	if err := testFs.MkdirAll(name, 0755); err != nil {
 		panic(fmt.Sprintf("Failed to create directory %s: %s", name, err))
 	}
@@ -524,6 +549,15 @@ func (fm fakeMatcher) SkipIgnoredDirs() bool {
 	return fm.skipIgnoredDirs
 }
 
+type fakePruningMatcher struct {
+	fakeMatcher
+	prune string
+}
+
+func (fm fakePruningMatcher) CanPruneWatch(name string) bool {
+	return name == fm.prune
+}
+
 type fakeEventInfo string
 
 func (e fakeEventInfo) Path() string {
@@ -101,6 +101,14 @@ type Matcher interface {
 	SkipIgnoredDirs() bool
 }
 
+// WatchPruner may be implemented by a Matcher passed to Watch. If the
+// matcher doesn't skip ignored dirs, paths for which CanPruneWatch returns
+// true are still not watched, e.g. ignored dirs below which nothing can be
+// unignored.
+type WatchPruner interface {
+	CanPruneWatch(name string) bool
+}
+
 type MatchResult interface {
 	IsIgnored() bool
 }
@@ -138,6 +146,8 @@ func (evType EventType) String() string {
 
 var ErrWatchNotSupported = errors.New("watching is not supported")
 
+var ErrSpaceEventsNotSupported = errors.New("disk space events are not supported")
+
 // Equivalents from os package.
 
 const ModePerm = FileMode(os.ModePerm)
@@ -0,0 +1,25 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"errors"
+	"path/filepath"
+)
+
+var errFilesystemIDUnsupported = errors.New("filesystem identification is only supported on basic filesystems")
+
+// FilesystemID returns an identifier of the underlying filesystem the given
+// item is on, e.g. to tell whether a directory is a mount point. Items on
+// the same filesystem have the same identifier. The item itself isn't
+// followed if it's a symlink.
+func FilesystemID(fs Filesystem, name string) (string, error) {
+	if fs.Type() != FilesystemTypeBasic {
+		return "", errFilesystemIDUnsupported
+	}
+	return filesystemID(filepath.Join(fs.URI(), name))
+}
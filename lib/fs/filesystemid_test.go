@@ -0,0 +1,40 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFilesystemID(t *testing.T) {
+	fs, dir := setup(t)
+	defer os.RemoveAll(dir)
+
+	if err := fs.MkdirAll(filepath.Join("dir", "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	root, err := FilesystemID(fs, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root == "" {
+		t.Fatal("Expected a filesystem ID")
+	}
+	sub, err := FilesystemID(fs, filepath.Join("dir", "sub"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub != root {
+		t.Errorf("Expected the same filesystem ID within the same filesystem, got %q and %q", root, sub)
+	}
+
+	if _, err := FilesystemID(NewFilesystem(FilesystemTypeFake, "TestFilesystemID"), "."); err == nil {
+		t.Error("Expected an error for a fake filesystem")
+	}
+}
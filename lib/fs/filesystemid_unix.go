@@ -0,0 +1,30 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// +build !windows
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// filesystemID returns the device ID of the item at the given absolute
+// path.
+func filesystemID(path string) (string, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return "", err
+	}
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return "", fmt.Errorf("%s: no device ID", path)
+	}
+	return strconv.FormatUint(uint64(st.Dev), 10), nil
+}
@@ -0,0 +1,30 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// +build windows
+
+package fs
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+// filesystemID returns the root of the volume the item at the given
+// absolute path is on. Volumes mounted into a directory are reparse points
+// there, which makes that directory the root of its volume. Directory
+// junctions and symlinks to other volumes aren't resolved.
+func filesystemID(path string) (string, error) {
+	p, err := windows.UTF16FromString(path)
+	if err != nil {
+		return "", err
+	}
+	// The volume root is at most the path plus a trailing backslash.
+	buf := make([]uint16, len(p)+1)
+	if err := windows.GetVolumePathName(&p[0], &buf[0], uint32(len(buf))); err != nil {
+		return "", err
+	}
+	return windows.UTF16ToString(buf), nil
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// IsHidden returns whether the given item or any of its parents is hidden.
+// Names starting with a dot are hidden on all platforms, such that hidden
+// items are the same everywhere. On Windows items with the hidden attribute
+// are hidden as well.
+func IsHidden(fs Filesystem, name string) bool {
+	name = filepath.Clean(name)
+	if name == "." {
+		return false
+	}
+	parts := PathComponents(name)
+	for _, part := range parts {
+		if strings.HasPrefix(part, ".") {
+			return true
+		}
+	}
+	if fs.Type() != FilesystemTypeBasic {
+		return false
+	}
+	for i := range parts {
+		if hasHiddenAttribute(filepath.Join(fs.URI(), filepath.Join(parts[:i+1]...))) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHiddenItem returns whether the given item itself is hidden, regardless
+// of its parents.
+func IsHiddenItem(fs Filesystem, name string) bool {
+	name = filepath.Clean(name)
+	if name == "." {
+		return false
+	}
+	if strings.HasPrefix(filepath.Base(name), ".") {
+		return true
+	}
+	return fs.Type() == FilesystemTypeBasic && hasHiddenAttribute(filepath.Join(fs.URI(), name))
+}
+
+// HiddenCache is like IsHidden for checking many items, checking every
+// parent directory only once. Parents becoming hidden or not afterwards
+// aren't noticed. It must not be used concurrently.
+type HiddenCache struct {
+	fs   Filesystem
+	dirs map[string]bool
+}
+
+func NewHiddenCache(fs Filesystem) *HiddenCache {
+	return &HiddenCache{
+		fs:   fs,
+		dirs: make(map[string]bool),
+	}
+}
+
+// IsHidden returns whether the given item or any of its parents is hidden.
+func (c *HiddenCache) IsHidden(name string) bool {
+	name = filepath.Clean(name)
+	if name == "." {
+		return false
+	}
+	if dir := filepath.Dir(name); dir != "." {
+		hidden, ok := c.dirs[dir]
+		if !ok {
+			hidden = c.IsHidden(dir)
+			c.dirs[dir] = hidden
+		}
+		if hidden {
+			return true
+		}
+	}
+	return IsHiddenItem(c.fs, name)
+}
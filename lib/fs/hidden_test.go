@@ -0,0 +1,56 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsHiddenDot(t *testing.T) {
+	fs, dir := setup(t)
+	defer os.RemoveAll(dir)
+
+	cases := map[string]bool{
+		".":                   false,
+		"file":                false,
+		"dir/file.txt":        false,
+		".file":               true,
+		"dir/.file":           true,
+		".dir/file":           true,
+		"dir/.sub/deeper/dir": true,
+	}
+	cache := NewHiddenCache(fs)
+	for name, hidden := range cases {
+		if res := IsHidden(fs, filepath.FromSlash(name)); res != hidden {
+			t.Errorf("IsHidden(%q) = %v, expected %v", name, res, hidden)
+		}
+		if res := cache.IsHidden(filepath.FromSlash(name)); res != hidden {
+			t.Errorf("HiddenCache.IsHidden(%q) = %v, expected %v", name, res, hidden)
+		}
+	}
+}
+
+func TestIsHiddenItemDot(t *testing.T) {
+	fs, dir := setup(t)
+	defer os.RemoveAll(dir)
+
+	cases := map[string]bool{
+		".":            false,
+		"file":         false,
+		".file":        true,
+		"dir/.file":    true,
+		".dir/file":    false,
+		".dir/sub/dir": false,
+	}
+	for name, hidden := range cases {
+		if res := IsHiddenItem(fs, filepath.FromSlash(name)); res != hidden {
+			t.Errorf("IsHiddenItem(%q) = %v, expected %v", name, res, hidden)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// +build !windows
+
+package fs
+
+// hasHiddenAttribute is always false, as there is no such attribute on
+// unix and the leading dot convention is covered by IsHidden.
+func hasHiddenAttribute(path string) bool {
+	return false
+}
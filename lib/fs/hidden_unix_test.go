@@ -0,0 +1,32 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// +build !windows
+
+package fs
+
+import (
+	"os"
+	"testing"
+)
+
+func TestIsHiddenOnlyByName(t *testing.T) {
+	fs, dir := setup(t)
+	defer os.RemoveAll(dir)
+
+	fd, err := fs.Create("file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+	// Hiding is a noop, the name alone decides.
+	if err := fs.Hide("file"); err != nil {
+		t.Fatal(err)
+	}
+	if IsHidden(fs, "file") {
+		t.Error("Expected file without leading dot not to be hidden")
+	}
+}
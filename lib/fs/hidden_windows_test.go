@@ -0,0 +1,56 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// +build windows
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsHiddenAttribute(t *testing.T) {
+	fs, dir := setup(t)
+	defer os.RemoveAll(dir)
+
+	if err := fs.MkdirAll(filepath.Join("dir", "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	fd, err := fs.Create(filepath.Join("dir", "sub", "file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+
+	if IsHidden(fs, filepath.Join("dir", "sub", "file")) {
+		t.Fatal("Expected file not to be hidden")
+	}
+
+	if err := fs.Hide("dir"); err != nil {
+		t.Fatal(err)
+	}
+	if !IsHidden(fs, "dir") {
+		t.Error("Expected dir with hidden attribute to be hidden")
+	}
+	if !IsHidden(fs, filepath.Join("dir", "sub", "file")) {
+		t.Error("Expected file in hidden dir to be hidden")
+	}
+	if !NewHiddenCache(fs).IsHidden(filepath.Join("dir", "sub", "file")) {
+		t.Error("Expected file in hidden dir to be hidden when cached")
+	}
+	if IsHiddenItem(fs, filepath.Join("dir", "sub", "file")) {
+		t.Error("Expected file in hidden dir not to be hidden itself")
+	}
+
+	if err := fs.Unhide("dir"); err != nil {
+		t.Fatal(err)
+	}
+	if IsHidden(fs, filepath.Join("dir", "sub", "file")) {
+		t.Error("Expected file not to be hidden after unhiding its parent")
+	}
+}
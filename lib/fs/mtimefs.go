@@ -146,6 +146,53 @@ func (f *mtimeFS) OpenFile(name string, flags int, mode FileMode) (File, error)
 	return mtimeFile{fd, f}, nil
 }
 
+// RepairMtime drops the stored modification time mapping of the given item
+// if it doesn't apply anymore, i.e. the item is gone or its modification
+// time on disk isn't the one the mapping was stored for. It returns true if
+// a mapping was removed. Filesystems without an mtime layer have nothing to
+// repair.
+func RepairMtime(fs Filesystem, name string) (bool, error) {
+	for {
+		switch sfs := fs.(type) {
+		case *mtimeFS:
+			return sfs.repair(name)
+		case *logFilesystem:
+			fs = sfs.Filesystem
+		case *walkFilesystem:
+			fs = sfs.Filesystem
+		default:
+			return false, nil
+		}
+	}
+}
+
+func (f *mtimeFS) repair(name string) (bool, error) {
+	real, virtual, err := f.load(name)
+	if err != nil {
+		return false, err
+	}
+	if real.IsZero() && virtual.IsZero() {
+		// Nothing stored
+		return false, nil
+	}
+
+	info, err := f.Filesystem.Lstat(name)
+	if err != nil && !IsNotExist(err) {
+		return false, err
+	}
+	if err == nil && real.Equal(info.ModTime()) && !real.Equal(virtual) {
+		return false, nil
+	}
+
+	if f.caseInsensitive {
+		name = UnicodeLowercase(name)
+	}
+	if err := f.db.Delete(name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // "real" is the on disk timestamp
 // "virtual" is what want the timestamp to be
 
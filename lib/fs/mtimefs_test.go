@@ -232,6 +232,54 @@ func TestMtimeFSInsensitive(t *testing.T) {
 	})
 }
 
+func TestMtimeFSRepair(t *testing.T) {
+	os.RemoveAll("testdata")
+	defer os.RemoveAll("testdata")
+	os.Mkdir("testdata", 0755)
+	ioutil.WriteFile("testdata/valid", []byte("hello"), 0644)
+	ioutil.WriteFile("testdata/changed", []byte("hello"), 0644)
+	ioutil.WriteFile("testdata/removed", []byte("hello"), 0644)
+
+	testTime := time.Unix(1234567890, 123456789)
+
+	db := make(mapStore)
+	mtimefs := newMtimeFS(newBasicFilesystem("."), db)
+	mtimefs.chtimes = failChtimes
+	for _, file := range []string{"testdata/valid", "testdata/changed", "testdata/removed"} {
+		if err := mtimefs.Chtimes(file, testTime, testTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(db) != 3 {
+		t.Fatalf("Expected three stored mappings, got %v", len(db))
+	}
+
+	os.Chtimes("testdata/changed", time.Now().Add(time.Hour), time.Now().Add(time.Hour))
+	os.Remove("testdata/removed")
+
+	for file, expected := range map[string]bool{
+		"testdata/valid":   false,
+		"testdata/changed": true,
+		"testdata/removed": true,
+		"testdata/unknown": false,
+	} {
+		if repaired, err := RepairMtime(mtimefs, file); err != nil {
+			t.Errorf("Repairing %v failed: %v", file, err)
+		} else if repaired != expected {
+			t.Errorf("Repairing %v returned %v, expected %v", file, repaired, expected)
+		}
+	}
+
+	if _, ok := db["testdata/valid"]; !ok || len(db) != 1 {
+		t.Errorf("Expected only the valid mapping to remain, got %v", len(db))
+	}
+	if info, err := mtimefs.Lstat("testdata/valid"); err != nil {
+		t.Error("Lstat shouldn't fail:", err)
+	} else if !info.ModTime().Equal(testTime) {
+		t.Errorf("Time mismatch; %v != expected %v", info.ModTime(), testTime)
+	}
+}
+
 // The mapStore is a simple database
 
 type mapStore map[string][]byte
@@ -0,0 +1,74 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// +build darwin
+
+package fs
+
+import (
+	"context"
+
+	"golang.org/x/sys/unix"
+)
+
+// Vfs event flags from sys/mount.h, reported for EVFILT_FS.
+const (
+	vqLowDisk     = 0x0004
+	vqVeryLowDisk = 0x0200
+	vqQuota       = 0x1000
+	vqNearLowDisk = 0x2000
+	vqDesiredDisk = 0x4000
+
+	vqSpaceEvents = vqLowDisk | vqVeryLowDisk | vqQuota | vqNearLowDisk | vqDesiredDisk
+)
+
+// SpaceEvents returns a channel that receives a value whenever the
+// operating system signals a change in available disk space or quota, on
+// any filesystem. Events are coalesced, i.e. a value in the channel means
+// one or more events happened. Only macOS is supported, using the vfs
+// events (low disk, very low disk, quota, desired disk) of kqueue; on
+// other platforms ErrSpaceEventsNotSupported is returned.
+func SpaceEvents(ctx context.Context) (<-chan struct{}, error) {
+	kq, err := unix.Kqueue()
+	if err != nil {
+		return nil, err
+	}
+	changes := []unix.Kevent_t{{
+		Filter: unix.EVFILT_FS,
+		Flags:  unix.EV_ADD | unix.EV_CLEAR,
+	}}
+	if _, err := unix.Kevent(kq, changes, nil, nil); err != nil {
+		unix.Close(kq)
+		return nil, err
+	}
+
+	c := make(chan struct{}, 1)
+	go func() {
+		defer unix.Close(kq)
+		events := make([]unix.Kevent_t, 8)
+		// Wake up regularly to notice the context being cancelled.
+		timeout := unix.NsecToTimespec(int64(1e9))
+		for ctx.Err() == nil {
+			n, err := unix.Kevent(kq, nil, events, &timeout)
+			if err == unix.EINTR {
+				continue
+			} else if err != nil {
+				l.Debugln("Waiting for disk space events:", err)
+				return
+			}
+			for _, ev := range events[:n] {
+				if ev.Fflags&vqSpaceEvents == 0 {
+					continue
+				}
+				select {
+				case c <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return c, nil
+}
@@ -12,7 +12,10 @@ package fs
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
+	"sort"
+	"sync"
 )
 
 var ErrInfiniteRecursion = errors.New("infinite filesystem recursion detected")
@@ -61,9 +64,25 @@ func (ancestors *ancestorDirList) Contains(info FileInfo) bool {
 // Walk skips the remaining files in the containing directory.
 type WalkFunc func(path string, info FileInfo, err error) error
 
+// OptionWalkWorkers makes Walk lstat the entries of a directory using the
+// given number of concurrent workers. The walk function is still called
+// sequentially and in lexical order.
+type OptionWalkWorkers struct {
+	Workers int
+}
+
+func (o *OptionWalkWorkers) apply(Filesystem) {
+	// Handled by the walkFilesystem wrapping the filesystem.
+}
+
+func (o *OptionWalkWorkers) String() string {
+	return fmt.Sprintf("walkWorkers=%d", o.Workers)
+}
+
 type walkFilesystem struct {
 	Filesystem
 	checkInfiniteRecursion bool
+	workers                int
 }
 
 func NewWalkFilesystem(next Filesystem) Filesystem {
@@ -71,9 +90,11 @@ func NewWalkFilesystem(next Filesystem) Filesystem {
 		Filesystem: next,
 	}
 	for _, opt := range next.Options() {
-		if _, ok := opt.(*OptionJunctionsAsDirs); ok {
+		switch opt := opt.(type) {
+		case *OptionJunctionsAsDirs:
 			fs.checkInfiniteRecursion = true
-			break
+		case *OptionWalkWorkers:
+			fs.workers = opt.Workers
 		}
 	}
 	return fs
@@ -112,10 +133,22 @@ func (f *walkFilesystem) walk(path string, info FileInfo, walkFn WalkFunc, ances
 	if err != nil {
 		return walkFn(path, info, err)
 	}
+	sort.Strings(names)
+
+	var infos []FileInfo
+	var errs []error
+	if f.workers > 1 && len(names) > 1 {
+		infos, errs = f.lstatConcurrently(path, names)
+	}
 
-	for _, name := range names {
+	for i, name := range names {
 		filename := filepath.Join(path, name)
-		fileInfo, err := f.Lstat(filename)
+		var fileInfo FileInfo
+		if infos != nil {
+			fileInfo, err = infos[i], errs[i]
+		} else {
+			fileInfo, err = f.Lstat(filename)
+		}
 		if err != nil {
 			if err := walkFn(filename, fileInfo, err); err != nil && err != SkipDir {
 				return err
@@ -132,6 +165,34 @@ func (f *walkFilesystem) walk(path string, info FileInfo, walkFn WalkFunc, ances
 	return nil
 }
 
+// lstatConcurrently returns the results of lstat for all names in the
+// directory at path, in the same order, using up to f.workers routines.
+func (f *walkFilesystem) lstatConcurrently(path string, names []string) ([]FileInfo, []error) {
+	infos := make([]FileInfo, len(names))
+	errs := make([]error, len(names))
+	workers := f.workers
+	if workers > len(names) {
+		workers = len(names)
+	}
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				infos[i], errs[i] = f.Lstat(filepath.Join(path, names[i]))
+			}
+		}()
+	}
+	for i := range names {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return infos, errs
+}
+
 // Walk walks the file tree rooted at root, calling walkFn for each file or
 // directory in the tree, including root. All errors that arise visiting files
 // and directories are filtered by walkFn. The files are walked in lexical
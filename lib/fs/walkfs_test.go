@@ -131,3 +131,45 @@ func testWalkInfiniteRecursion(t *testing.T, fsType FilesystemType, uri string)
 		t.Fatal("Infinite recursion not detected correctly")
 	}
 }
+
+func testWalkWorkers(t *testing.T, fsType FilesystemType, uri string) {
+	walked := func(fs Filesystem) []string {
+		var res []string
+		if err := fs.Walk(".", func(path string, info FileInfo, err error) error {
+			if err != nil {
+				t.Fatal(err)
+			}
+			res = append(res, fmt.Sprintf("%v:%v:%v", path, info.IsDir(), info.Size()))
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	fs := NewFilesystem(fsType, uri)
+	for _, dir := range []string{"a", "b/c", "d"} {
+		if err := fs.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, name := range []string{"a/1", "a/2", "a/3", "b/c/4", "b/5", "d/6", "7", "8"} {
+		fd, err := fs.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write(make([]byte, i))
+		fd.Close()
+	}
+
+	expected := walked(fs)
+	res := walked(NewFilesystem(fsType, uri, &OptionWalkWorkers{Workers: 3}))
+	if len(res) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, res)
+	}
+	for i := range res {
+		if res[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, res)
+		}
+	}
+}
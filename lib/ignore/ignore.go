@@ -71,6 +71,13 @@ type Pattern struct {
 	pattern string
 	match   glob.Glob
 	result  Result
+	// For negated patterns, the globs of the leading path segments and
+	// whether the remaining pattern may match at any depth. Used to
+	// determine whether a pattern may match below an ignored directory.
+	segments []glob.Glob
+	anyDepth bool
+	// Where the pattern comes from, as "file:line: text".
+	source string
 }
 
 func (p Pattern) String() string {
@@ -101,6 +108,49 @@ func (p Pattern) allowsSkippingIgnoredDirs() bool {
 	return !strings.Contains(strings.TrimSuffix(p.pattern, "**"), "**")
 }
 
+// mayMatchBelow returns true if the pattern may match something below the
+// given directory, given as slash separated segments.
+func (p Pattern) mayMatchBelow(dir []string) bool {
+	for i, segment := range p.segments {
+		if i == len(dir) {
+			return true
+		}
+		if !segment.Match(dir[i]) {
+			return false
+		}
+	}
+	return p.anyDepth
+}
+
+// matchesAllBelow returns true if the pattern matches everything below the
+// given directory, as do the patterns with a /** suffix that are added
+// automatically. The directory must be slash separated.
+func (p Pattern) matchesAllBelow(dir string) bool {
+	return strings.HasSuffix(p.pattern, "/**") && p.match.Match(dir+"/")
+}
+
+// segmentGlobs splits the pattern into globs for each path segment, up to
+// the first segment containing a double asterisk. Patterns that can't be
+// split reliably, because they contain alternatives or escapes, are
+// treated as if they could match at any depth.
+func segmentGlobs(pattern string) ([]glob.Glob, bool, error) {
+	if strings.ContainsAny(pattern, `{\`) {
+		return nil, true, nil
+	}
+	var globs []glob.Glob
+	for _, segment := range strings.Split(strings.TrimPrefix(pattern, "/"), "/") {
+		if strings.Contains(segment, "**") {
+			return globs, true, nil
+		}
+		g, err := glob.Compile(segment)
+		if err != nil {
+			return nil, false, err
+		}
+		globs = append(globs, g)
+	}
+	return globs, false, nil
+}
+
 type Result uint8
 
 func (r Result) IsIgnored() bool {
@@ -278,7 +328,33 @@ func (m *Matcher) Match(file string) (result Result) {
 		}()
 	}
 
-	// Check all the patterns for a match.
+	if pattern, ok := m.matchingPatternLocked(file); ok {
+		return pattern.result
+	}
+
+	// Default to not matching.
+	return resultNotMatched
+}
+
+// MatchSource is like Match, but bypasses the cache and additionally
+// returns where the matching pattern comes from, as "file:line: text".
+// The source is empty if no pattern matched.
+func (m *Matcher) MatchSource(file string) (Result, string) {
+	if file == "." {
+		return resultNotMatched, ""
+	}
+
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	if pattern, ok := m.matchingPatternLocked(file); ok {
+		return pattern.result, pattern.source
+	}
+	return resultNotMatched, ""
+}
+
+// matchingPatternLocked returns the first pattern matching the file.
+func (m *Matcher) matchingPatternLocked(file string) (Pattern, bool) {
 	file = filepath.ToSlash(file)
 	var lowercaseFile string
 	for _, pattern := range m.patterns {
@@ -287,17 +363,15 @@ func (m *Matcher) Match(file string) (result Result) {
 				lowercaseFile = strings.ToLower(file)
 			}
 			if pattern.match.Match(lowercaseFile) {
-				return pattern.result
+				return pattern, true
 			}
 		} else {
 			if pattern.match.Match(file) {
-				return pattern.result
+				return pattern, true
 			}
 		}
 	}
-
-	// Default to not matching.
-	return resultNotMatched
+	return Pattern{}, false
 }
 
 // Lines return a list of the unprocessed lines in .stignore at last load
@@ -372,6 +446,39 @@ func (m *Matcher) SkipIgnoredDirs() bool {
 	return m.skipIgnoredDirs
 }
 
+// CanSkipDir returns true if nothing below the given ignored directory can
+// be included again by a negated pattern, i.e. there is no need to descend
+// into it. Negated patterns after a pattern that ignores everything below
+// the directory never match, as the first matching pattern wins.
+func (m *Matcher) CanSkipDir(dir string) bool {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	if m.skipIgnoredDirs {
+		return true
+	}
+
+	dir = filepath.ToSlash(dir)
+	var lowercaseDir string
+	for _, pattern := range m.patterns {
+		name := dir
+		if pattern.result.IsCaseFolded() {
+			if lowercaseDir == "" {
+				lowercaseDir = strings.ToLower(dir)
+			}
+			name = lowercaseDir
+		}
+		if pattern.result.IsIgnored() {
+			if pattern.matchesAllBelow(name) {
+				return true
+			}
+		} else if pattern.mayMatchBelow(strings.Split(name, "/")) {
+			return false
+		}
+	}
+	return true
+}
+
 func hashPatterns(patterns []Pattern) string {
 	h := sha256.New()
 	for _, pat := range patterns {
@@ -504,11 +611,22 @@ func parseLine(line string) ([]Pattern, error) {
 func parseIgnoreFile(fs fs.Filesystem, fd io.Reader, currentFile string, cd ChangeDetector, linesSeen map[string]struct{}) ([]string, []Pattern, error) {
 	var patterns []Pattern
 
+	var source string
 	addPattern := func(line string) error {
 		newPatterns, err := parseLine(line)
 		if err != nil {
 			return fmt.Errorf("invalid pattern %q in ignore file: %w", line, err)
 		}
+		for i := range newPatterns {
+			newPatterns[i].source = source
+			if newPatterns[i].result.IsIgnored() {
+				continue
+			}
+			newPatterns[i].segments, newPatterns[i].anyDepth, err = segmentGlobs(newPatterns[i].pattern)
+			if err != nil {
+				return fmt.Errorf("invalid pattern %q in ignore file: %w", line, parseError(err))
+			}
+		}
 		patterns = append(patterns, newPatterns...)
 		return nil
 	}
@@ -524,7 +642,7 @@ func parseIgnoreFile(fs fs.Filesystem, fd io.Reader, currentFile string, cd Chan
 	}
 
 	var err error
-	for _, line := range lines {
+	for i, line := range lines {
 		if _, ok := linesSeen[line]; ok {
 			continue
 		}
@@ -535,6 +653,7 @@ func parseIgnoreFile(fs fs.Filesystem, fd io.Reader, currentFile string, cd Chan
 		case strings.HasPrefix(line, "//"):
 			continue
 		}
+		source = fmt.Sprintf("%s:%d: %s", currentFile, i+1, line)
 
 		line = filepath.ToSlash(line)
 		switch {
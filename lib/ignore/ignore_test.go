@@ -1174,6 +1174,85 @@ func TestSkipIgnoredDirs(t *testing.T) {
 	}
 }
 
+func TestCanSkipDir(t *testing.T) {
+	tcs := []struct {
+		stignore string
+		dir      string
+		expected bool
+	}{
+		// Without negated patterns everything may be skipped
+		{"foo", "foo", true},
+		// The negation comes after all of foo is ignored, so never matches
+		{"/foo\n!/foo/keep", "foo", true},
+		{"!/foo/keep\n/foo", "foo", false},
+		{"!/foo/keep\n*", "foo", false},
+		{"!/foo/keep\n*", "bar", true},
+		{"!/foo/keep\n*", "foo/keep", false},
+		{"!/foo/keep\n*", "foo/sub", true},
+		{"!/foo/*/keep\n*", "foo/sub", false},
+		{"!/foo/*/keep\n*", "foo/sub/sub", true},
+		{"!/f*/keep\n*", "foo", false},
+		{"!/f*/keep\n*", "bar", true},
+		{"!/foo/**/keep\n*", "foo/sub/sub", false},
+		{"!/foo/**/keep\n*", "bar", true},
+		{"!/foo/**\n*", "foo/sub", false},
+		// Unrooted negations may match anywhere
+		{"!keep\n*", "bar", false},
+		{"!foo/keep\n*", "bar/sub", false},
+		{"(?i)!/FOO/keep\n*", "Foo", false},
+		{"(?i)!/FOO/keep\n*", "bar", true},
+		// Alternatives aren't analysed, so descend to be safe
+		{"!/{foo,bar}/keep\n*", "baz", false},
+		// The canonical gitignore example of including only foo/bar, with
+		// the patterns in reverse order as the first match wins here
+		{"!/foo/bar\n/foo/*\n!/foo\n/*", "foo/baz", true},
+		{"!/foo/bar\n/foo/*\n!/foo\n/*", "foo/bar", false},
+		{"!/foo/bar\n/foo/*\n!/foo\n/*", "baz", true},
+	}
+
+	for _, tc := range tcs {
+		pats := New(fs.NewFilesystem(fs.FilesystemTypeFake, ""))
+		if err := pats.Parse(strings.NewReader(tc.stignore), ".stignore"); err != nil {
+			t.Fatal(err)
+		}
+		if got := pats.CanSkipDir(filepath.FromSlash(tc.dir)); got != tc.expected {
+			t.Errorf("%q, %v: got %v, expected %v", tc.stignore, tc.dir, got, tc.expected)
+		}
+	}
+}
+
+func TestNegatedDescendants(t *testing.T) {
+	// Everything but foo/bar is ignored
+	stignore := `
+	!/foo/bar
+	/foo/*
+	!/foo
+	/*
+	`
+	pats := New(fs.NewFilesystem(fs.FilesystemTypeFake, ""))
+	if err := pats.Parse(strings.NewReader(stignore), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		name    string
+		ignored bool
+	}{
+		{"foo", false},
+		{"foo/bar", false},
+		{"foo/bar/baz", false},
+		{"foo/baz", true},
+		{"foo/baz/bar", true},
+		{"baz", true},
+		{"baz/foo/bar", true},
+	}
+	for _, tc := range tcs {
+		if got := pats.Match(filepath.FromSlash(tc.name)).IsIgnored(); got != tc.ignored {
+			t.Errorf("%v: got ignored %v, expected %v", tc.name, got, tc.ignored)
+		}
+	}
+}
+
 func TestEmptyPatterns(t *testing.T) {
 	// These patterns are all invalid and should be rejected as such (without panicking...)
 	tcs := []string{
@@ -1193,3 +1272,30 @@ func TestEmptyPatterns(t *testing.T) {
 		}
 	}
 }
+
+func TestMatchSource(t *testing.T) {
+	pats := New(fs.NewFilesystem(fs.FilesystemTypeBasic, "testdata"), WithCache(true))
+	if err := pats.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		file, source string
+	}{
+		{"bfile", ".stignore:3: bfile"},
+		{filepath.Join("bfile", "sub"), ".stignore:3: bfile"},
+		{filepath.Join("x", "efile"), ".stignore:5: **/efile"},
+		{filepath.Join("dir2", "dfile"), "excludes:1: dir2/dfile"},
+		{"dir3", "further-excludes:1: dir3"},
+		{"afile", ""},
+	}
+	for _, tc := range cases {
+		res, source := pats.MatchSource(tc.file)
+		if source != tc.source {
+			t.Errorf("%v: expected source %q, got %q", tc.file, tc.source, source)
+		}
+		if res.IsIgnored() != (tc.source != "") {
+			t.Errorf("%v: unexpected result %v", tc.file, res)
+		}
+	}
+}
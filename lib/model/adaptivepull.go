@@ -0,0 +1,151 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	adaptivePullWindow = 2 * time.Second
+	// Round trip times this many times the lowest one seen indicate
+	// congestion.
+	adaptivePullRTTFactor = 2
+)
+
+// PullConcurrency describes the amount of block requests a folder keeps
+// pending while pulling.
+type PullConcurrency struct {
+	Adaptive      bool    `json:"adaptive"`
+	PendingKiB    int     `json:"pendingKiB"`
+	MaxPendingKiB int     `json:"maxPendingKiB"`
+	ThroughputKiB float64 `json:"throughputKiBps"`
+	MinRTT        float64 `json:"minRTTS"`
+}
+
+// pullConcurrency adapts the capacity of the request limiter similar to TCP
+// congestion control: It grows while throughput increases and shrinks on
+// timeouts, closed connections or inflated round trip times.
+type pullConcurrency struct {
+	min, max int // bytes
+	current  int
+	limiter  *byteSemaphore
+
+	windowStart    time.Time
+	windowBytes    int64
+	windowRTT      time.Duration
+	windowRequests int
+	lastThroughput float64 // bytes per second
+	minRTT         time.Duration
+	mut            sync.Mutex
+}
+
+func newPullConcurrency(start, max int) *pullConcurrency {
+	if max < start {
+		max = start
+	}
+	return &pullConcurrency{
+		min:     protocol.MinBlockSize,
+		max:     max,
+		current: start,
+		mut:     sync.NewMutex(),
+	}
+}
+
+// newLimiter returns a request limiter with the current capacity, which is
+// adjusted as requests complete.
+func (c *pullConcurrency) newLimiter() *byteSemaphore {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.limiter = newByteSemaphore(c.current)
+	return c.limiter
+}
+
+// requestDone records a completed block request.
+func (c *pullConcurrency) requestDone(bytes int, rtt time.Duration, err error, now time.Time) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if err != nil {
+		if errors.Is(err, protocol.ErrTimeout) || errors.Is(err, protocol.ErrClosed) {
+			c.setLocked(c.current / 2)
+			c.resetWindowLocked(now)
+		}
+		return
+	}
+
+	if c.windowStart.IsZero() {
+		c.windowStart = now
+	}
+	if c.minRTT == 0 || rtt < c.minRTT {
+		c.minRTT = rtt
+	}
+	c.windowBytes += int64(bytes)
+	c.windowRTT += rtt
+	c.windowRequests++
+
+	elapsed := now.Sub(c.windowStart)
+	if elapsed < adaptivePullWindow {
+		return
+	}
+
+	throughput := float64(c.windowBytes) / elapsed.Seconds()
+	avgRTT := c.windowRTT / time.Duration(c.windowRequests)
+	switch {
+	case avgRTT > adaptivePullRTTFactor*c.minRTT:
+		c.setLocked(c.current * 3 / 4)
+	case throughput > c.lastThroughput*1.05:
+		c.setLocked(c.current * 5 / 4)
+	}
+	c.lastThroughput = throughput
+	c.resetWindowLocked(now)
+}
+
+func (c *pullConcurrency) setLocked(current int) {
+	if current < c.min {
+		current = c.min
+	} else if current > c.max {
+		current = c.max
+	}
+	if current == c.current {
+		return
+	}
+	l.Debugf("adjusting pull concurrency from %d to %d bytes", c.current, current)
+	c.current = current
+	if c.limiter != nil {
+		c.limiter.setCapacity(current)
+	}
+}
+
+func (c *pullConcurrency) resetWindowLocked(now time.Time) {
+	c.windowStart = now
+	c.windowBytes = 0
+	c.windowRTT = 0
+	c.windowRequests = 0
+}
+
+func (c *pullConcurrency) status() PullConcurrency {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return PullConcurrency{
+		Adaptive:      true,
+		PendingKiB:    c.current / 1024,
+		MaxPendingKiB: c.max / 1024,
+		ThroughputKiB: c.lastThroughput / 1024,
+		MinRTT:        c.minRTT.Seconds(),
+	}
+}
+
+// PullConcurrency returns nothing by default, as e.g. send only folders
+// don't pull.
+func (f *folder) PullConcurrency() PullConcurrency {
+	return PullConcurrency{}
+}
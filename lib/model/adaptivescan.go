@@ -0,0 +1,87 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const scanActivityWindow = time.Minute
+
+// scanActivity tracks the rate of local changes found by scanning. While
+// the rate is above the threshold, the folder is rescanned at the shorter
+// boosted interval. Once no burst has been seen for a quiet period, the
+// interval doubles every quiet period until it's back at the configured
+// one.
+type scanActivity struct {
+	threshold int
+	boosted   time.Duration
+	quiet     time.Duration
+
+	windowStart time.Time
+	changes     int
+	lastBurst   time.Time
+	mut         sync.Mutex
+}
+
+func newScanActivity(cfg config.FolderConfiguration) *scanActivity {
+	return &scanActivity{
+		threshold: cfg.AdaptiveScanThreshold,
+		boosted:   time.Duration(cfg.AdaptiveScanIntervalS) * time.Second,
+		quiet:     time.Duration(cfg.AdaptiveScanQuietS) * time.Second,
+		mut:       sync.NewMutex(),
+	}
+}
+
+// record counts changes found at the given time and returns true if that
+// started a burst of activity.
+func (a *scanActivity) record(changes int, now time.Time) bool {
+	if a.threshold <= 0 || changes == 0 {
+		return false
+	}
+
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	if now.Sub(a.windowStart) >= scanActivityWindow {
+		a.windowStart = now
+		a.changes = 0
+	}
+	a.changes += changes
+	if a.changes < a.threshold {
+		return false
+	}
+	started := a.lastBurst.IsZero()
+	a.lastBurst = now
+	return started
+}
+
+// interval returns the interval to the next scan at the given time, based
+// on the configured interval.
+func (a *scanActivity) interval(configured time.Duration, now time.Time) time.Duration {
+	if a.threshold <= 0 || configured == 0 {
+		return configured
+	}
+
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	if a.lastBurst.IsZero() {
+		return configured
+	}
+	quietPeriods := now.Sub(a.lastBurst) / a.quiet
+	if quietPeriods < 32 {
+		if interval := a.boosted << quietPeriods; interval < configured {
+			return interval
+		}
+	}
+	a.lastBurst = time.Time{}
+	return configured
+}
@@ -0,0 +1,259 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// Audit log entries are stored in the folder's statistics namespace, keyed
+// by auditLogKeyPrefix followed by the big endian unix nanoseconds and a
+// sequence number, thus in chronological order.
+const auditLogKeyPrefix = "audit/"
+
+// An AuditEntry is a change applied to a folder from another device.
+type AuditEntry struct {
+	Time time.Time `json:"time"`
+	Path string    `json:"path"`
+	// One of created, modified, deleted or renamed.
+	Action string `json:"action"`
+	// The previous path of a renamed item.
+	OldPath    string `json:"oldPath,omitempty"`
+	Type       string `json:"type"`
+	ModifiedBy string `json:"modifiedBy"`
+	Size       int64  `json:"size"`
+}
+
+// An AuditQuery selects entries of the audit log, zero values matching
+// all of them.
+type AuditQuery struct {
+	Since      time.Time
+	Until      time.Time
+	Prefix     string
+	ModifiedBy string
+	// Only the most recent Limit matching entries are returned.
+	Limit int
+}
+
+func (q AuditQuery) matches(e AuditEntry) bool {
+	if !q.Since.IsZero() && e.Time.Before(q.Since) {
+		return false
+	}
+	if q.ModifiedBy != "" && e.ModifiedBy != q.ModifiedBy {
+		return false
+	}
+	return q.Prefix == "" || strings.HasPrefix(e.Path, q.Prefix) || strings.HasPrefix(e.OldPath, q.Prefix)
+}
+
+type auditLog struct {
+	ns      *db.NamespacedKV
+	entries int // -1 until counted
+	seq     uint32
+	mut     sync.Mutex
+}
+
+func newAuditLog(ns *db.NamespacedKV) *auditLog {
+	return &auditLog{
+		ns:      ns,
+		entries: -1,
+		mut:     sync.NewMutex(),
+	}
+}
+
+// record appends the entries to the log, then drops the oldest ones
+// exceeding maxEntries or maxAge, if positive.
+func (a *auditLog) record(entries []AuditEntry, maxEntries int, maxAge time.Duration, now time.Time) error {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	if a.entries < 0 {
+		count := 0
+		if err := a.ns.Iterate(auditLogKeyPrefix, func(string, []byte) bool {
+			count++
+			return true
+		}); err != nil {
+			return err
+		}
+		a.entries = count
+	}
+
+	vals := make(map[string][]byte, len(entries))
+	for _, e := range entries {
+		bs, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		vals[a.nextKeyLocked(e.Time)] = bs
+	}
+	if err := a.ns.PutBytesMany(vals); err != nil {
+		return err
+	}
+	a.entries += len(vals)
+
+	return a.pruneLocked(maxEntries, maxAge, now)
+}
+
+func (a *auditLog) nextKeyLocked(t time.Time) string {
+	var key [12]byte
+	binary.BigEndian.PutUint64(key[:], uint64(t.UnixNano()))
+	binary.BigEndian.PutUint32(key[8:], a.seq)
+	a.seq++
+	return auditLogKeyPrefix + string(key[:])
+}
+
+func (a *auditLog) pruneLocked(maxEntries int, maxAge time.Duration, now time.Time) error {
+	var cutoff uint64
+	if maxAge > 0 {
+		cutoff = uint64(now.Add(-maxAge).UnixNano())
+	}
+	var drop []string
+	err := a.ns.Iterate(auditLogKeyPrefix, func(key string, _ []byte) bool {
+		excess := maxEntries > 0 && a.entries-len(drop) > maxEntries
+		expired := binary.BigEndian.Uint64([]byte(key[len(auditLogKeyPrefix):])) < cutoff
+		if !excess && !expired {
+			return false
+		}
+		drop = append(drop, key)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if len(drop) == 0 {
+		return nil
+	}
+	if err := a.ns.DeleteMany(drop); err != nil {
+		return err
+	}
+	a.entries -= len(drop)
+	return nil
+}
+
+// query returns the matching entries in chronological order.
+func (a *auditLog) query(q AuditQuery) ([]AuditEntry, error) {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	res := make([]AuditEntry, 0)
+	var err error
+	iterErr := a.ns.Iterate(auditLogKeyPrefix, func(key string, val []byte) bool {
+		var e AuditEntry
+		if err = json.Unmarshal(val, &e); err != nil {
+			return false
+		}
+		if !q.Until.IsZero() && e.Time.After(q.Until) {
+			return false
+		}
+		if !q.matches(e) {
+			return true
+		}
+		res = append(res, e)
+		if q.Limit > 0 && len(res) > q.Limit {
+			res = res[1:]
+		}
+		return true
+	})
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	return res, err
+}
+
+// auditEntries returns the audit log entries for the given pulled files,
+// which are about to be written to the database. A deletion and a creation
+// with the same contents within the files are recorded as a rename.
+func (f *folder) auditEntries(files []protocol.FileInfo, now time.Time) ([]AuditEntry, error) {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+
+	entries := make([]AuditEntry, 0, len(files))
+	deleted := make(map[string]int) // blocks hash to index in entries
+	created := make(map[int]string) // index in entries to blocks hash
+	for _, file := range files {
+		if file.IsInvalid() {
+			continue
+		}
+		cur, ok := snap.Get(protocol.LocalDeviceID, file.Name)
+		if ok && cur.Version.Equal(file.Version) {
+			continue
+		}
+		exists := ok && !cur.IsDeleted() && !cur.IsInvalid()
+		e := AuditEntry{
+			Time:       now,
+			Path:       file.Name,
+			Action:     "modified",
+			Type:       auditItemType(file),
+			ModifiedBy: file.ModifiedBy.String(),
+			Size:       file.Size,
+		}
+		switch {
+		case file.IsDeleted():
+			if !exists {
+				continue
+			}
+			e.Action = "deleted"
+			e.Type = auditItemType(cur)
+			e.Size = cur.Size
+			if cur.Type == protocol.FileInfoTypeFile && len(cur.BlocksHash) > 0 {
+				deleted[string(cur.BlocksHash)] = len(entries)
+			}
+		case !exists:
+			e.Action = "created"
+			if file.Type == protocol.FileInfoTypeFile && len(file.Blocks) > 0 {
+				created[len(entries)] = string(protocol.BlocksHash(file.Blocks))
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	renamedFrom := make(map[int]struct{})
+	for i := range entries {
+		hash, ok := created[i]
+		if !ok {
+			continue
+		}
+		j, ok := deleted[hash]
+		if !ok {
+			continue
+		}
+		delete(deleted, hash)
+		entries[i].Action = "renamed"
+		entries[i].OldPath = entries[j].Path
+		renamedFrom[j] = struct{}{}
+	}
+	if len(renamedFrom) == 0 {
+		return entries, nil
+	}
+	res := entries[:0]
+	for i, e := range entries {
+		if _, ok := renamedFrom[i]; !ok {
+			res = append(res, e)
+		}
+	}
+	return res, nil
+}
+
+func auditItemType(file protocol.FileInfo) string {
+	switch {
+	case file.IsSymlink():
+		return "symlink"
+	case file.IsDirectory():
+		return "dir"
+	default:
+		return "file"
+	}
+}
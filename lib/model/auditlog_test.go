@@ -0,0 +1,118 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestAuditLogRetention(t *testing.T) {
+	ns := db.NewNamespacedKV(backend.OpenMemory(), "audit")
+	a := newAuditLog(ns)
+	now := time.Now()
+
+	for i := 0; i < 5; i++ {
+		e := AuditEntry{Time: now.Add(time.Duration(i) * time.Hour), Path: fmt.Sprint(i)}
+		if err := a.record([]AuditEntry{e}, 4, 0, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	entries, err := a.query(AuditQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 4 || entries[0].Path != "1" || entries[3].Path != "4" {
+		t.Fatal("Expected the oldest entry to be dropped, got", entries)
+	}
+
+	// Restarting keeps the entries, and expired ones are dropped.
+	a = newAuditLog(ns)
+	if err := a.record(nil, 4, 90*time.Minute, now.Add(4*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	entries, err = a.query(AuditQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Path != "3" {
+		t.Fatal("Expected expired entries to be dropped, got", entries)
+	}
+
+	entries, err = a.query(AuditQuery{Until: now.Add(3 * time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Path != "3" {
+		t.Fatal("Unexpected entries until the given time", entries)
+	}
+}
+
+func TestAuditLogPulledChanges(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.AuditLog = true
+
+	by := device1.Short()
+	file := func(name string, content string, deleted bool, version protocol.Vector) protocol.FileInfo {
+		fi := protocol.FileInfo{
+			Name:       name,
+			Type:       protocol.FileInfoTypeFile,
+			Size:       int64(len(content)),
+			Deleted:    deleted,
+			Version:    version.Update(by),
+			ModifiedBy: by,
+		}
+		if !deleted {
+			fi.Blocks = []protocol.BlockInfo{{Size: len(content), Hash: []byte(content)}}
+		}
+		return fi
+	}
+
+	a := file("a", "aaa", false, protocol.Vector{})
+	c := file("c", "ccc", false, protocol.Vector{})
+	f.updateLocalsFromPulling([]protocol.FileInfo{a, c})
+	f.updateLocalsFromPulling([]protocol.FileInfo{
+		file("a", "aaaa", false, a.Version),
+		file("c", "", true, c.Version),
+		file("dir/b", "ccc", false, protocol.Vector{}),
+	})
+
+	entries, err := f.AuditEntries(AuditQuery{})
+	must(t, err)
+	expected := []AuditEntry{
+		{Path: "a", Action: "created", Size: 3},
+		{Path: "c", Action: "created", Size: 3},
+		{Path: "a", Action: "modified", Size: 4},
+		{Path: "dir/b", Action: "renamed", OldPath: "c", Size: 3},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %v entries, got %v", len(expected), entries)
+	}
+	for i, e := range entries {
+		exp := expected[i]
+		if e.Path != exp.Path || e.Action != exp.Action || e.OldPath != exp.OldPath || e.Size != exp.Size || e.ModifiedBy != by.String() {
+			t.Errorf("Entry %v: Expected %+v, got %+v", i, exp, e)
+		}
+	}
+
+	entries, err = f.AuditEntries(AuditQuery{Prefix: "c"})
+	must(t, err)
+	if len(entries) != 2 {
+		t.Errorf("Expected the creation and rename of c, got %v", entries)
+	}
+	entries, err = f.AuditEntries(AuditQuery{ModifiedBy: device2.Short().String()})
+	must(t, err)
+	if len(entries) != 0 {
+		t.Errorf("Expected no entries by device2, got %v", entries)
+	}
+}
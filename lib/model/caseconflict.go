@@ -0,0 +1,139 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// caseIndex finds items that only differ in case from another item in the
+// same directory, in the database or scanned before. Directories are
+// indexed from the database the first time a new item is found in them.
+type caseIndex struct {
+	snap *db.Snapshot
+	dirs map[string]map[string]string // directory to folded to real base name
+	// Directories not scanned due to a conflict, thus neither are their
+	// contents.
+	conflictingDirs []string
+}
+
+func newCaseIndex(snap *db.Snapshot) *caseIndex {
+	return &caseIndex{
+		snap: snap,
+		dirs: make(map[string]map[string]string),
+	}
+}
+
+// existing returns the name of the item that only differs in case from the
+// given one, if any. Otherwise the given name is remembered for subsequent
+// calls.
+func (c *caseIndex) existing(name string) (string, bool) {
+	if cur, ok := c.snap.Get(protocol.LocalDeviceID, name); ok && !cur.IsDeleted() {
+		return "", false
+	}
+
+	dir, base := filepath.Split(name)
+	names, ok := c.dirs[dir]
+	if !ok {
+		names = make(map[string]string)
+		prefix := strings.TrimSuffix(dir, string(fs.PathSeparator))
+		c.snap.WithPrefixedHaveTruncated(protocol.LocalDeviceID, prefix, func(intf protocol.FileIntf) bool {
+			if intf.IsDeleted() || intf.IsInvalid() {
+				return true
+			}
+			if d, b := filepath.Split(intf.FileName()); d == dir {
+				names[fs.UnicodeLowercase(b)] = b
+			}
+			return true
+		})
+		c.dirs[dir] = names
+	}
+
+	folded := fs.UnicodeLowercase(base)
+	if other, ok := names[folded]; ok && other != base {
+		return dir + other, true
+	}
+	names[folded] = base
+	return "", false
+}
+
+// replace records that name replaced the item with the same folded name.
+func (c *caseIndex) replace(name string) {
+	dir, base := filepath.Split(name)
+	if names, ok := c.dirs[dir]; ok {
+		names[fs.UnicodeLowercase(base)] = base
+	}
+}
+
+// addConflictingDir records a directory that wasn't scanned due to a
+// conflict.
+func (c *caseIndex) addConflictingDir(name string) {
+	c.conflictingDirs = append(c.conflictingDirs, name)
+}
+
+// belowConflictingDir returns whether the item is within a directory that
+// wasn't scanned due to a conflict.
+func (c *caseIndex) belowConflictingDir(name string) bool {
+	for _, dir := range c.conflictingDirs {
+		if fs.IsParent(name, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCaseConflict returns the item still present on disk that the
+// scanned item only differs from in case, if any. Such items can't both
+// exist on case insensitive filesystems and would cause endless changes,
+// there and here. If the other item was renamed to only differ in case, it
+// isn't present anymore and there's no conflict.
+func (f *folder) checkCaseConflict(index *caseIndex, name string) (string, bool) {
+	existing, ok := index.existing(name)
+	if !ok {
+		return "", false
+	}
+	if !f.existsInCase(existing) {
+		index.replace(name)
+		return "", false
+	}
+	return existing, true
+}
+
+// existsInCase returns whether the directory contains an item with exactly
+// the given name, as opposed to one that only differs in case.
+func (f *folder) existsInCase(name string) bool {
+	names, err := f.mtimefs.DirNames(filepath.Dir(name))
+	if err != nil {
+		return false
+	}
+	base := filepath.Base(name)
+	for _, n := range names {
+		if n == base {
+			return true
+		}
+	}
+	return false
+}
+
+// reportCaseConflict records a scan error for the item that wasn't
+// scanned due to a case conflict and emits an event about it.
+func (f *folder) reportCaseConflict(name, existing string) {
+	f.newScanError(name, fmt.Errorf("name only differs in case from %q, which is ambiguous on case insensitive filesystems", existing))
+	f.evLogger.Log(events.FolderCaseConflict, map[string]string{
+		"folder":   f.ID,
+		"label":    f.Label,
+		"path":     filepath.FromSlash(name),
+		"existing": filepath.FromSlash(existing),
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/binary"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// The modification time of the folder root is stored under this key, as
+// no directory in the database has that name.
+const dirMtimesRootKey = "."
+
+// changedSubdirs returns the directories whose modification time differs
+// from the one stored by storeDirMtimes, or that are gone. The directory
+// of an added, removed or renamed item changes, thus scanning these
+// subtrees catches all such changes, including deletions within subtrees
+// that are otherwise pruned. If the root changed, the result is a single
+// blank subdir, meaning the entire folder. It also returns the current
+// modification times to be stored once the scan succeeded.
+func (f *folder) changedSubdirs() ([]string, map[string]int64, error) {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return nil, nil, err
+	}
+	var dirs []string
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(fi protocol.FileIntf) bool {
+		if fi.IsDirectory() && !fi.IsDeleted() && !fi.IsInvalid() {
+			dirs = append(dirs, fi.FileName())
+		}
+		return true
+	})
+	snap.Release()
+
+	ns := f.fset.DirMtimes()
+	stored := make(map[string]int64)
+	if err := ns.Iterate("", func(key string, val []byte) bool {
+		if len(val) == 8 {
+			stored[key] = int64(binary.BigEndian.Uint64(val))
+		}
+		return true
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	current := make(map[string]int64, len(dirs)+1)
+	changed := func(name, key string) bool {
+		info, err := f.mtimefs.Lstat(name)
+		if err != nil || !info.IsDir() {
+			return true
+		}
+		mtime := info.ModTime().UnixNano()
+		current[key] = mtime
+		old, ok := stored[key]
+		return !ok || old != mtime
+	}
+
+	if changed(".", dirMtimesRootKey) {
+		// Still stat everything to have a complete set of times to store.
+		for _, dir := range dirs {
+			changed(dir, dir)
+		}
+		return []string{""}, current, nil
+	}
+	var subDirs []string
+	for _, dir := range dirs {
+		select {
+		case <-f.ctx.Done():
+			return nil, nil, f.ctx.Err()
+		default:
+		}
+		if changed(dir, dir) {
+			subDirs = append(subDirs, dir)
+		}
+	}
+	return subDirs, current, nil
+}
+
+// storeDirMtimes replaces the stored directory modification times with the
+// given ones.
+func (f *folder) storeDirMtimes(mtimes map[string]int64) error {
+	ns := f.fset.DirMtimes()
+	var gone []string
+	if err := ns.Iterate("", func(key string, val []byte) bool {
+		mtime, ok := mtimes[key]
+		if !ok {
+			gone = append(gone, key)
+		} else if len(val) == 8 && int64(binary.BigEndian.Uint64(val)) == mtime {
+			delete(mtimes, key)
+		}
+		return true
+	}); err != nil {
+		return err
+	}
+	for _, key := range gone {
+		if err := ns.Delete(key); err != nil {
+			return err
+		}
+	}
+	for key, mtime := range mtimes {
+		if err := ns.PutInt64(key, mtime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	conflictHistoryKey = "conflictHistory"
+
+	// Bounds of the persisted conflict history, to keep a folder with
+	// lots of conflicts from growing it forever.
+	maxConflictHistoryPaths = 1000
+	maxConflictHistoryTimes = 100
+)
+
+// A ChronicConflict is a file that conflicted more often than the folder's
+// ChronicConflictThreshold within its ChronicConflictWindowS.
+type ChronicConflict struct {
+	Path          string    `json:"path"`
+	Conflicts     int       `json:"conflicts"`
+	FirstConflict time.Time `json:"firstConflict"`
+	LastConflict  time.Time `json:"lastConflict"`
+}
+
+// conflictHistory keeps track of when conflicts happened per path. It is
+// persisted in the folder's statistics namespace, so that chronic conflicts
+// are recognized across restarts.
+type conflictHistory struct {
+	ns      *db.NamespacedKV
+	history map[string][]time.Time
+	mut     sync.Mutex
+}
+
+func newConflictHistory(ns *db.NamespacedKV) *conflictHistory {
+	return &conflictHistory{
+		ns:  ns,
+		mut: sync.NewMutex(),
+	}
+}
+
+// record adds a conflict for the given path at the given time, dropping
+// any history older than the window, and returns the number of conflicts
+// within the window.
+func (h *conflictHistory) record(name string, now time.Time, window time.Duration) (int, error) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if err := h.loadLocked(); err != nil {
+		return 0, err
+	}
+	h.pruneLocked(now, window)
+
+	times := append(h.history[name], now)
+	if len(times) > maxConflictHistoryTimes {
+		times = times[len(times)-maxConflictHistoryTimes:]
+	}
+	h.history[name] = times
+
+	if len(h.history) > maxConflictHistoryPaths {
+		h.evictLocked(len(h.history) - maxConflictHistoryPaths)
+	}
+
+	return len(times), h.saveLocked()
+}
+
+// chronic returns all paths with more than threshold conflicts within the
+// window, sorted by path.
+func (h *conflictHistory) chronic(now time.Time, window time.Duration, threshold int) ([]ChronicConflict, error) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if err := h.loadLocked(); err != nil {
+		return nil, err
+	}
+	h.pruneLocked(now, window)
+
+	res := make([]ChronicConflict, 0)
+	if threshold <= 0 {
+		return res, nil
+	}
+	for name, times := range h.history {
+		if len(times) <= threshold {
+			continue
+		}
+		res = append(res, ChronicConflict{
+			Path:          name,
+			Conflicts:     len(times),
+			FirstConflict: times[0],
+			LastConflict:  times[len(times)-1],
+		})
+	}
+	sort.Slice(res, func(a, b int) bool {
+		return res[a].Path < res[b].Path
+	})
+	return res, nil
+}
+
+func (h *conflictHistory) loadLocked() error {
+	if h.history != nil {
+		return nil
+	}
+	h.history = make(map[string][]time.Time)
+	bs, ok, err := h.ns.Bytes(conflictHistoryKey)
+	if err != nil || !ok {
+		return err
+	}
+	if err := json.Unmarshal(bs, &h.history); err != nil {
+		l.Debugln("Discarding unreadable conflict history:", err)
+		h.history = make(map[string][]time.Time)
+	}
+	return nil
+}
+
+func (h *conflictHistory) saveLocked() error {
+	bs, err := json.Marshal(h.history)
+	if err != nil {
+		return err
+	}
+	return h.ns.PutBytes(conflictHistoryKey, bs)
+}
+
+func (h *conflictHistory) pruneLocked(now time.Time, window time.Duration) {
+	cutoff := now.Add(-window)
+	for name, times := range h.history {
+		i := sort.Search(len(times), func(i int) bool {
+			return times[i].After(cutoff)
+		})
+		if i == len(times) {
+			delete(h.history, name)
+		} else if i > 0 {
+			h.history[name] = times[i:]
+		}
+	}
+}
+
+// evictLocked drops the n paths whose last conflict is the oldest.
+func (h *conflictHistory) evictLocked(n int) {
+	names := make([]string, 0, len(h.history))
+	for name := range h.history {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(a, b int) bool {
+		ta := h.history[names[a]]
+		tb := h.history[names[b]]
+		return ta[len(ta)-1].Before(tb[len(tb)-1])
+	})
+	for _, name := range names[:n] {
+		delete(h.history, name)
+	}
+}
+
+// ignorePatternFor returns an ignore pattern matching exactly the given
+// file, relative to the folder root.
+func ignorePatternFor(name string) string {
+	name = filepath.ToSlash(name)
+	var b strings.Builder
+	b.WriteByte('/')
+	for _, r := range name {
+		if strings.ContainsRune(`*?[]{}\`, r) {
+			if runtime.GOOS == "windows" {
+				// There is no escape character on Windows; match the
+				// special character with a wildcard instead.
+				b.WriteByte('?')
+				continue
+			}
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+)
+
+func TestConflictHistory(t *testing.T) {
+	ns := db.NewNamespacedKV(backend.OpenMemory(), "conflicts")
+	h := newConflictHistory(ns)
+	window := time.Hour
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if _, err := h.record("a", now.Add(time.Duration(i)*time.Minute), window); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := h.record("b", now, window); err != nil {
+		t.Fatal(err)
+	} else if n != 1 {
+		t.Fatal("Expected one conflict, got", n)
+	}
+
+	chronic, err := h.chronic(now.Add(5*time.Minute), window, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chronic) != 1 || chronic[0].Path != "a" || chronic[0].Conflicts != 3 {
+		t.Fatal("Unexpected chronic conflicts", chronic)
+	}
+
+	// The history survives a restart.
+	h = newConflictHistory(ns)
+	if chronic, err := h.chronic(now.Add(5*time.Minute), window, 2); err != nil {
+		t.Fatal(err)
+	} else if len(chronic) != 1 {
+		t.Fatal("Expected persisted chronic conflict, got", chronic)
+	}
+
+	// Conflicts age out of the window.
+	if chronic, err := h.chronic(now.Add(window+time.Minute), window, 2); err != nil {
+		t.Fatal(err)
+	} else if len(chronic) != 0 {
+		t.Fatal("Expected no chronic conflicts, got", chronic)
+	}
+}
+
+func TestConflictHistoryBounded(t *testing.T) {
+	h := newConflictHistory(db.NewNamespacedKV(backend.OpenMemory(), "conflicts"))
+	now := time.Now()
+
+	for i := 0; i < maxConflictHistoryPaths+10; i++ {
+		if _, err := h.record(fmt.Sprintf("file%d", i), now.Add(time.Duration(i)*time.Second), time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if l := len(h.history); l != maxConflictHistoryPaths {
+		t.Errorf("Expected %d paths, got %d", maxConflictHistoryPaths, l)
+	}
+	if _, ok := h.history["file0"]; ok {
+		t.Error("Expected oldest path to be evicted")
+	}
+
+	later := now.Add(time.Hour)
+	for i := 0; i < maxConflictHistoryTimes+10; i++ {
+		if _, err := h.record("a", later, 2*time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if l := len(h.history["a"]); l != maxConflictHistoryTimes {
+		t.Errorf("Expected %d conflicts, got %d", maxConflictHistoryTimes, l)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// Folders with fewer items in the database are never suspected to be empty
+// mountpoints.
+const emptyPathGuardMinItems = 10
+
+var (
+	ErrEmptyPathSuspected = errors.New("folder path is empty or nearly so while the database is not, suspecting a failed mount (this indicates potential data loss, acknowledge to scan anyway)")
+	errEmptyPathEnough    = errors.New("found enough items")
+)
+
+// checkEmptyPath returns an error if the folder has at least
+// EmptyPathGuardRatio times as many items in the database as on disk.
+// Once the check passed or was acknowledged, it isn't done again until
+// the folder restarts.
+func (f *folder) checkEmptyPath() error {
+	if f.EmptyPathGuardRatio <= 0 || atomic.LoadInt32(&f.emptyPathVerified) == 1 {
+		return nil
+	}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	counts := snap.LocalSize()
+	snap.Release()
+
+	expected := counts.Files + counts.Directories + counts.Symlinks
+	if expected >= emptyPathGuardMinItems {
+		found := 0
+		err := f.mtimefs.Walk(".", func(path string, info fs.FileInfo, err error) error {
+			if path == "." {
+				return err
+			}
+			if fs.IsInternal(path) {
+				if err == nil && info.IsDir() {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			found++
+			if found*f.EmptyPathGuardRatio > expected {
+				return errEmptyPathEnough
+			}
+			return nil
+		})
+		if err != nil && err != errEmptyPathEnough {
+			return err
+		}
+		if err == nil {
+			l.Warnf("Folder %v: Found only %d items on disk, but %d in the database", f.Description(), found, expected)
+			return ErrEmptyPathSuspected
+		}
+	}
+
+	atomic.StoreInt32(&f.emptyPathVerified, 1)
+	return nil
+}
+
+// AcknowledgeEmptyPath disables the empty path check until the folder
+// restarts and scans the folder.
+func (f *folder) AcknowledgeEmptyPath() error {
+	if atomic.SwapInt32(&f.emptyPathVerified, 1) == 0 {
+		l.Infof("Folder %v: Empty folder path acknowledged", f.Description())
+	}
+	return f.Scan(nil)
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/syncthing/syncthing/lib/db"
+)
+
+const (
+	fileErrorsKey = "fileErrors"
+
+	// Bound of each persisted error list if MaxFileErrors doesn't set a
+	// lower one.
+	maxPersistedFileErrors = 1000
+)
+
+// persistedFileErrors are the scan and pull errors of a folder as kept in
+// its statistics namespace, such that they survive a restart.
+type persistedFileErrors struct {
+	Scan        []FileError `json:"scan,omitempty"`
+	Pull        []FileError `json:"pull,omitempty"`
+	ScanDropped int         `json:"scanDropped,omitempty"`
+	PullDropped int         `json:"pullDropped,omitempty"`
+}
+
+// persistFileErrorsLocked stores the current scan and pull errors, or
+// removes the stored ones if there are none, unless that's what was stored
+// last. It must be called with errorsMut held.
+func (f *folder) persistFileErrorsLocked() {
+	kv := db.NewFolderStatisticsNamespace(f.model.db, f.ID)
+	if len(f.scanErrors) == 0 && len(f.pullErrors) == 0 && f.scanErrorsDropped == 0 && f.pullErrorsDropped == 0 {
+		if f.persistedErrors != nil && len(f.persistedErrors) == 0 {
+			return
+		}
+		if err := kv.Delete(fileErrorsKey); err != nil {
+			l.Debugln("Deleting file errors:", err)
+			return
+		}
+		f.persistedErrors = []byte{}
+		return
+	}
+	scan, scanDropped := f.boundFileErrors(f.scanErrors)
+	pull, pullDropped := f.boundFileErrors(f.pullErrors)
+	bs, err := json.Marshal(persistedFileErrors{
+		Scan:        scan,
+		Pull:        pull,
+		ScanDropped: f.scanErrorsDropped + scanDropped,
+		PullDropped: f.pullErrorsDropped + pullDropped,
+	})
+	if err == nil && bytes.Equal(bs, f.persistedErrors) {
+		return
+	}
+	if err == nil {
+		err = kv.PutBytes(fileErrorsKey, bs)
+	}
+	if err != nil {
+		l.Debugln("Persisting file errors:", err)
+		return
+	}
+	f.persistedErrors = bs
+}
+
+// persistFileErrors is like persistFileErrorsLocked, taking errorsMut.
+func (f *folder) persistFileErrors() {
+	f.errorsMut.Lock()
+	f.persistFileErrorsLocked()
+	f.errorsMut.Unlock()
+}
+
+// restoreFileErrors loads the errors persisted before a restart. They are
+// marked stale until the next scan respectively pull replaces them.
+func (f *folder) restoreFileErrors() {
+	bs, ok, err := db.NewFolderStatisticsNamespace(f.model.db, f.ID).Bytes(fileErrorsKey)
+	if err != nil || !ok {
+		if err != nil {
+			l.Debugln("Getting file errors:", err)
+		}
+		return
+	}
+	var p persistedFileErrors
+	if err := json.Unmarshal(bs, &p); err != nil {
+		l.Debugln("Decoding file errors:", err)
+		return
+	}
+	var dropped int
+	f.scanErrors, dropped = f.boundFileErrors(markStale(p.Scan))
+	f.scanErrorsDropped = p.ScanDropped + dropped
+	f.pullErrors, dropped = f.boundFileErrors(markStale(p.Pull))
+	f.pullErrorsDropped = p.PullDropped + dropped
+}
+
+// boundFileErrors truncates the errors to the folder's MaxFileErrors, or
+// maxPersistedFileErrors, returning how many were dropped.
+func (f *folder) boundFileErrors(errs []FileError) ([]FileError, int) {
+	max := maxPersistedFileErrors
+	if f.MaxFileErrors > 0 && f.MaxFileErrors < max {
+		max = f.MaxFileErrors
+	}
+	if len(errs) <= max {
+		return errs, 0
+	}
+	return errs[:max], len(errs) - max
+}
+
+func markStale(errs []FileError) []FileError {
+	for i := range errs {
+		errs[i].Stale = true
+	}
+	return errs
+}
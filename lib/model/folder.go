@@ -7,15 +7,21 @@
 package model
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/rand"
+	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
+	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
+	metrics "github.com/rcrowley/go-metrics"
+	"golang.org/x/time/rate"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db"
@@ -34,13 +40,21 @@ import (
 	"github.com/syncthing/syncthing/lib/watchaggregator"
 )
 
+// scanSlotWaitTimer keeps track of how long folders wait to start scanning
+// due to the limit on concurrently scanning folders.
+var scanSlotWaitTimer = metrics.GetOrRegisterTimer("model/folder/scanslotwait", nil)
+
+var errScanTruncated = errors.New("scan stopped after exceeding the maximum duration")
+
 type folder struct {
 	stateTracker
 	config.FolderConfiguration
 	*stats.FolderStatisticsReference
-	ioLimiter *byteSemaphore
+	ioLimiter       *byteSemaphore
+	scanReadLimiter *rate.Limiter // limits reading files while scanning, nil if unlimited
 
-	localFlags uint32
+	localFlags        uint32
+	emptyPathVerified int32
 
 	model         *model
 	shortID       protocol.ShortID
@@ -48,37 +62,98 @@ type folder struct {
 	ignores       *ignore.Matcher
 	mtimefs       fs.Filesystem
 	modTimeWindow time.Duration
+	conflicts     *conflictHistory
+	audit         *auditLog
+	scanProgress  *scanProgress
+	scanPhases    *scanPhases
+	scanStats     scanStats       // of the current scan, only accessed while scanning
+	renameCache   *renameCache    // of the current scan, only accessed while scanning
 	ctx           context.Context // used internally, only accessible on serve lifetime
 	done          chan struct{}   // used externally, accessible regardless of serve
 
+	drainRequested chan struct{} // closed once asked to drain
+
 	scanInterval           time.Duration
+	scanActivity           *scanActivity
 	scanTimer              *time.Timer
-	scanDelay              chan time.Duration
+	scanDelay              chan scanDelayRequest
+	scanDelayReason        string
+	scanDelayedUntil       time.Time
+	scanDelayMut           sync.Mutex
 	initialScanFinished    chan struct{}
+	initialScanCallbacks   []func() // run once initialScanFinished is closed
+	initialScanMut         sync.Mutex
+	scanTimerRequested     bool             // the scan timer was set to fire right away on request, serve loop only
+	timerScans             int              // rescan timer fires since the last full scan on it, serve loop only
+	scanResume             *scanResumePoint // where the last timer scan ran out of time, serve loop only
 	versionCleanupInterval time.Duration
 	versionCleanupTimer    *time.Timer
+	versionCleanupCursor   versionCleanupCursor
+	revertInterval         time.Duration
+	revertTimer            *time.Timer
 
 	pullScheduled chan struct{}
 	pullPause     time.Duration
+	pullBackoff   int32 // accessed atomically, number of times pullPause was doubled
 	pullFailTimer *time.Timer
-
-	scanErrors []FileError
-	pullErrors []FileError
-	errorsMut  sync.Mutex
+	pullEvents    pullEventThrottle
+	pullFailures  int32 // accessed atomically, consecutive failed pulls
+	pullRetryAt   int64 // accessed atomically, unix nanoseconds of the scheduled retry
+	pulledBytes   int64 // accessed atomically, data pulled from other devices
+	pullHalted    int32 // accessed atomically, 1 after MaxPullRetries failed retries
+	pullPaused    int32 // accessed atomically, 1 while pulling is paused through the API
+	draining      int32 // accessed atomically, 1 once asked to drain
+
+	scanErrors        []FileError
+	pullErrors        []FileError
+	scanErrorsDropped int // errors not kept due to MaxFileErrors
+	pullErrorsDropped int
+	scanErrorCounts   map[string]int // items per error text in the current scan
+	skippedSymlinks   map[string]struct{}
+	persistedErrors   []byte // as last stored, empty if none, nil if unknown
+	errorsMut         sync.Mutex
+
+	skippedMountPoints []string          // in the current scan
+	scannedDirHashes   map[string][]byte // stored once the current scan completed
+	truncatedAt        string            // where the current scan stopped walking, if truncated
 
 	doInSyncChan chan syncRequest
 
+	postPullCommandScheduled chan struct{}
+
 	forcedRescanRequested chan struct{}
 	forcedRescanPaths     map[string]struct{}
 	forcedRescanPathsMut  sync.Mutex
 
+	verifyPaths    map[string]struct{} // to scan before the next pull
+	verifyAll      bool                // too many verifyPaths, scan everything instead
+	verifyPathsMut sync.Mutex
+
+	rehash               rehash
+	rehashMut            sync.Mutex
+	rehashBatchRequested chan struct{}
+
 	watchCancel      context.CancelFunc
 	watchChan        chan []string
 	restartWatchChan chan struct{}
 	watchErr         error
+	watchNextRetry   time.Time
+	watchFailures    int
 	watchMut         sync.Mutex
 
+	// Subdirs requested to be scanned by the watcher, accumulated while a
+	// scan is queued or running such that bursts result in a single scan.
+	scanPending        []string
+	scanPendingFull    bool
+	scanPendingMut     sync.Mutex
+	scanPendingChanged chan struct{}
+
+	// Ignore patterns as of the last complete scan, thus the ones the
+	// database reflects.
+	ignoresHashScanned string
+
 	puller    puller
+	reverter  reverter // nil unless the folder is receive only
 	versioner versioner.Versioner
 }
 
@@ -91,12 +166,17 @@ type puller interface {
 	pull() (bool, error) // true when successful and should not be retried
 }
 
+type reverter interface {
+	revert() error // discards local changes in favour of the global state
+}
+
 func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg config.FolderConfiguration, evLogger events.Logger, ioLimiter *byteSemaphore, ver versioner.Versioner) folder {
 	f := folder{
 		stateTracker:              newStateTracker(cfg.ID, evLogger),
 		FolderConfiguration:       cfg,
 		FolderStatisticsReference: stats.NewFolderStatisticsReference(model.db, cfg.ID),
 		ioLimiter:                 ioLimiter,
+		scanReadLimiter:           newScanReadLimiter(cfg.MaxScanReadBandwidth),
 
 		model:         model,
 		shortID:       model.shortID,
@@ -104,32 +184,56 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 		ignores:       ignores,
 		mtimefs:       fset.MtimeFS(),
 		modTimeWindow: cfg.ModTimeWindow(),
+		conflicts:     newConflictHistory(db.NewFolderStatisticsNamespace(model.db, cfg.ID)),
+		audit:         newAuditLog(db.NewFolderStatisticsNamespace(model.db, cfg.ID)),
+		scanProgress:  newScanProgress(),
+		scanPhases:    newScanPhases(cfg.ID, evLogger),
 		done:          make(chan struct{}),
 
 		scanInterval:           time.Duration(cfg.RescanIntervalS) * time.Second,
+		scanActivity:           newScanActivity(cfg),
 		scanTimer:              time.NewTimer(0), // The first scan should be done immediately.
-		scanDelay:              make(chan time.Duration),
+		scanDelay:              make(chan scanDelayRequest),
+		scanDelayMut:           sync.NewMutex(),
 		initialScanFinished:    make(chan struct{}),
+		initialScanMut:         sync.NewMutex(),
 		versionCleanupInterval: time.Duration(cfg.Versioning.CleanupIntervalS) * time.Second,
 		versionCleanupTimer:    time.NewTimer(time.Duration(cfg.Versioning.CleanupIntervalS) * time.Second),
+		versionCleanupCursor:   versionCleanupCursor{db.NewFolderStatisticsNamespace(model.db, cfg.ID)},
+		revertInterval:         time.Duration(cfg.ReceiveOnlyRevertIntervalS) * time.Second,
+		revertTimer:            time.NewTimer(time.Duration(cfg.ReceiveOnlyRevertIntervalS) * time.Second),
 
 		pullScheduled: make(chan struct{}, 1), // This needs to be 1-buffered so that we queue a pull if we're busy when it comes.
 
 		errorsMut: sync.NewMutex(),
 
+		drainRequested: make(chan struct{}),
+
 		doInSyncChan: make(chan syncRequest),
 
+		postPullCommandScheduled: make(chan struct{}, 1),
+
 		forcedRescanRequested: make(chan struct{}, 1),
 		forcedRescanPaths:     make(map[string]struct{}),
 		forcedRescanPathsMut:  sync.NewMutex(),
 
+		verifyPaths:    make(map[string]struct{}),
+		verifyPathsMut: sync.NewMutex(),
+
+		rehashMut:            sync.NewMutex(),
+		rehashBatchRequested: make(chan struct{}, 1),
+
 		watchCancel:      func() {},
 		restartWatchChan: make(chan struct{}, 1),
 		watchMut:         sync.NewMutex(),
 
+		scanPendingMut:     sync.NewMutex(),
+		scanPendingChanged: make(chan struct{}, 1),
+
 		versioner: ver,
 	}
-	f.pullPause = f.pullBasePause()
+	f.restorePullBackoff()
+	f.restoreFileErrors()
 	f.pullFailTimer = time.NewTimer(0)
 	<-f.pullFailTimer.C
 	return f
@@ -139,7 +243,19 @@ func (f *folder) Serve(ctx context.Context) error {
 	atomic.AddInt32(&f.model.foldersRunning, 1)
 	defer atomic.AddInt32(&f.model.foldersRunning, -1)
 
-	f.ctx = ctx
+	// Draining cancels everything the folder does like stopping it, but
+	// the folder only exits once actually stopped.
+	drainCtx, drainCancel := context.WithCancel(ctx)
+	defer drainCancel()
+	f.ctx = drainCtx
+	go func() {
+		select {
+		case <-f.drainRequested:
+			l.Debugln(f, "draining")
+			drainCancel()
+		case <-drainCtx.Done():
+		}
+	}()
 
 	l.Debugln(f, "starting")
 	defer l.Debugln(f, "exiting")
@@ -147,6 +263,7 @@ func (f *folder) Serve(ctx context.Context) error {
 	defer func() {
 		f.scanTimer.Stop()
 		f.versionCleanupTimer.Stop()
+		f.revertTimer.Stop()
 		f.setState(FolderIdle)
 	}()
 
@@ -154,6 +271,26 @@ func (f *folder) Serve(ctx context.Context) error {
 		f.startWatch()
 	}
 
+	if f.PostPullCommand != "" {
+		go f.postPullCommandRoutine(ctx)
+	}
+
+	if f.VerifyOnStartup && f.getHealthErrorAndLoadIgnores() == nil {
+		if _, err := f.verifyOnStartup(); svcutil.IsFatal(err) {
+			return err
+		}
+	}
+
+	if !f.SkipUnchangedDirs {
+		// Directory listing hashes aren't kept up to date while this is
+		// disabled, thus they mustn't be trusted if it's enabled again.
+		f.fset.DropDirHashes()
+	}
+	if !f.ScanChangedDirsOnly {
+		// Likewise for directory modification times.
+		f.fset.DropDirMtimes()
+	}
+
 	// If we're configured to not do version cleanup, or we don't have a
 	// versioner, cancel and drain that timer now.
 	if f.versionCleanupInterval == 0 || f.versioner == nil {
@@ -162,35 +299,83 @@ func (f *folder) Serve(ctx context.Context) error {
 		}
 	}
 
+	// Likewise if we aren't configured to revert periodically or there is
+	// nothing to revert.
+	if f.revertInterval == 0 || f.reverter == nil {
+		if !f.revertTimer.Stop() {
+			<-f.revertTimer.C
+		}
+	}
+
 	initialCompleted := f.initialScanFinished
 
+	var spaceEvents <-chan struct{}
+	if f.WatchDiskSpace {
+		var err error
+		spaceEvents, err = fs.SpaceEvents(ctx)
+		if err != nil {
+			// Running out of space is then only noticed when scanning or
+			// pulling, as usual.
+			l.Debugln(f, "not watching disk space:", err)
+		}
+	}
+
+	// Scans and pulls are both run synchronously from this loop, thus they
+	// never overlap within a folder: A scan requested during a pull, be it
+	// by the timer, the watcher or the API, only starts once the pull is
+	// done and vice versa. Between folders, the ioLimiter applies.
 	for {
 		var err error
 
 		select {
 		case <-f.ctx.Done():
 			close(f.done)
+			// Returning would restart the folder if it was drained.
+			<-ctx.Done()
 			return nil
 
+		// While pulling is paused, pulls are skipped without touching the
+		// backoff. Unpausing schedules a pull.
 		case <-f.pullScheduled:
+			if f.PullPaused() {
+				break
+			}
 			_, err = f.pull()
 
 		case <-f.pullFailTimer.C:
+			if f.PullPaused() {
+				break
+			}
 			var success bool
 			success, err = f.pull()
 			if (err != nil || !success) && f.pullPause < 60*f.pullBasePause() {
 				// Back off from retrying to pull
 				f.pullPause *= 2
+				f.setPullBackoff(f.PullBackoff() + 1)
 			}
 
 		case <-initialCompleted:
 			// Initial scan has completed, we should do a pull
 			initialCompleted = nil // never hit this case again
+			if f.PullPaused() {
+				break
+			}
+			if f.PullBackoff() > 0 {
+				// Pulling kept failing before the restart, thus wait as
+				// long as we would have without it.
+				l.Infof("Folder %v didn't make sync progress before restart - retrying in %v.", f.Description(), util.NiceDurationString(f.pullPause))
+				f.pullFailTimer.Reset(f.pullPause)
+				atomic.StoreInt64(&f.pullRetryAt, time.Now().Add(f.pullPause).UnixNano())
+				break
+			}
 			_, err = f.pull()
 
 		case <-f.forcedRescanRequested:
 			err = f.handleForcedRescans()
 
+		case <-f.rehashBatchRequested:
+			err = f.rehashBatch()
+
 		case <-f.scanTimer.C:
 			l.Debugln(f, "Scanning due to timer")
 			err = f.scanTimerFired()
@@ -200,21 +385,41 @@ func (f *folder) Serve(ctx context.Context) error {
 			err = req.fn()
 			req.err <- err
 
-		case next := <-f.scanDelay:
-			l.Debugln(f, "Delaying scan")
-			f.scanTimer.Reset(next)
+		case req := <-f.scanDelay:
+			l.Debugln(f, "Delaying scan:", req.reason)
+			f.scanTimer.Reset(req.next)
+			f.scanTimerRequested = req.next == 0
+			f.setScanDelay(req)
 
-		case fsEvents := <-f.watchChan:
+		case <-f.scanPendingChanged:
+			if f.ScanWindowsDeferWatcher && f.deferScanOutsideWindow() {
+				// Kept pending until the window starts.
+				break
+			}
+			subDirs, ok := f.takePendingScan()
+			if !ok {
+				// Already covered by a full scan in the meantime.
+				break
+			}
 			l.Debugln(f, "Scan due to watcher")
-			err = f.scanSubdirs(fsEvents)
+			err = f.scanSubdirsWithOptions(subDirs, scanOptions{
+				deleteGrace: f.FSWatcherDeleteGrace(),
+			})
 
 		case <-f.restartWatchChan:
 			l.Debugln(f, "Restart watcher")
 			err = f.restartWatch()
 
+		case <-spaceEvents:
+			l.Debugln(f, "Checking disk space due to event")
+			err = f.spaceChanged()
+
 		case <-f.versionCleanupTimer.C:
 			l.Debugln(f, "Doing version cleanup")
 			f.versionCleanupTimerFired()
+
+		case <-f.revertTimer.C:
+			err = f.revertTimerFired()
 		}
 
 		if err != nil {
@@ -230,21 +435,113 @@ func (f *folder) BringToFront(string) {}
 
 func (f *folder) Override() {}
 
-func (f *folder) Revert() {}
+// Revert discards the local changes of a receive only folder, restoring the
+// global state. It does nothing for other folder types.
+func (f *folder) Revert() {
+	if f.reverter == nil {
+		return
+	}
+	f.doInSync(f.reverter.revert)
+}
+
+// defaultScanDelayReason is used when delaying a scan without giving a
+// reason.
+const defaultScanDelayReason = "requested"
+
+type scanDelayRequest struct {
+	next   time.Duration
+	reason string
+}
 
 func (f *folder) DelayScan(next time.Duration) {
+	f.DelayScanWithReason(next, defaultScanDelayReason)
+}
+
+// DelayScanWithReason delays the next scan like DelayScan, recording the
+// given reason until the scan happens.
+func (f *folder) DelayScanWithReason(next time.Duration, reason string) {
 	select {
-	case f.scanDelay <- next:
+	case f.scanDelay <- scanDelayRequest{next, reason}:
 	case <-f.done:
 	}
 }
 
+// How long to postpone a scan while pulling, if DeferScanWhilePulling.
+const deferScanWhilePullingDelay = 10 * time.Second
+
+// deferScanWhilePulling reschedules the timer triggered scan a bit later and
+// returns true if it should be skipped, because another folder is pulling.
+// The initial scan is never deferred.
+func (f *folder) deferScanWhilePulling() bool {
+	if !f.DeferScanWhilePulling || atomic.LoadInt32(&f.model.pullsHoldingIO) == 0 {
+		return false
+	}
+	select {
+	case <-f.initialScanFinished:
+	default:
+		return false
+	}
+	req := scanDelayRequest{deferScanWhilePullingDelay, "another folder is pulling"}
+	l.Debugln(f, "Delaying scan:", req.reason)
+	f.scanTimer.Reset(req.next)
+	f.setScanDelay(req)
+	return true
+}
+
+// deferScanOutsideWindow reschedules the scan timer to the start of the
+// next scan window and returns true if it's currently outside all of them.
+// The initial scan is never deferred.
+func (f *folder) deferScanOutsideWindow() bool {
+	next := f.UntilScanWindow(time.Now())
+	if next == 0 {
+		return false
+	}
+	select {
+	case <-f.initialScanFinished:
+	default:
+		return false
+	}
+	req := scanDelayRequest{next, "outside scan window"}
+	l.Debugln(f, "Delaying scan:", req.reason)
+	f.scanTimer.Reset(req.next)
+	f.setScanDelay(req)
+	return true
+}
+
+func (f *folder) setScanDelay(req scanDelayRequest) {
+	until := time.Now().Add(req.next)
+	f.scanDelayMut.Lock()
+	f.scanDelayReason = req.reason
+	f.scanDelayedUntil = until
+	f.scanDelayMut.Unlock()
+	f.evLogger.Log(events.ScanDelayed, map[string]interface{}{
+		"folder": f.ID,
+		"reason": req.reason,
+		"until":  until,
+	})
+}
+
+// ScanDelay returns why and until when the next scan was delayed, if it
+// was delayed.
+func (f *folder) ScanDelay() (string, time.Time) {
+	f.scanDelayMut.Lock()
+	defer f.scanDelayMut.Unlock()
+	return f.scanDelayReason, f.scanDelayedUntil
+}
+
 func (f *folder) ignoresUpdated() {
 	if f.FSWatcherEnabled {
 		f.scheduleWatchRestart()
 	}
 }
 
+// ModTimeWindow returns the modification time window in effect, i.e. the
+// configured one or the one detected for the filesystem when the folder was
+// created.
+func (f *folder) ModTimeWindow() time.Duration {
+	return f.modTimeWindow
+}
+
 func (f *folder) SchedulePull() {
 	select {
 	case f.pullScheduled <- struct{}{}:
@@ -256,6 +553,27 @@ func (f *folder) SchedulePull() {
 	}
 }
 
+// SetPullPaused pauses or resumes pulling, while scanning and exchanging
+// indexes continue. It isn't persisted, i.e. pulling resumes on restart.
+func (f *folder) SetPullPaused(paused bool) {
+	if !paused {
+		if atomic.CompareAndSwapInt32(&f.pullPaused, 1, 0) {
+			l.Infof("Folder %v: Resuming pulling", f.Description())
+			f.SchedulePull()
+		}
+		return
+	}
+	if atomic.CompareAndSwapInt32(&f.pullPaused, 0, 1) {
+		l.Infof("Folder %v: Pausing pulling", f.Description())
+	}
+}
+
+func (f *folder) PullPaused() bool {
+	return atomic.LoadInt32(&f.pullPaused) == 1
+}
+
+// Jobs is empty unless overridden by a folder type with a puller, which
+// reports the jobs of its queue.
 func (f *folder) Jobs(_, _ int) ([]string, []string, int) {
 	return nil, nil, 0
 }
@@ -265,6 +583,78 @@ func (f *folder) Scan(subdirs []string) error {
 	return f.doInSync(func() error { return f.scanSubdirs(subdirs) })
 }
 
+// ForceScan scans the given subdirectories, or the entire folder, without
+// skipping directories whose listing didn't change.
+func (f *folder) ForceScan(subdirs []string) error {
+	<-f.initialScanFinished
+	return f.doInSync(func() error { return f.scanSubdirsWithOptions(subdirs, scanOptions{force: true}) })
+}
+
+// ScanWithHashers scans the given subdirectories, or the entire folder,
+// using the given number of hasher routines instead of the configured ones,
+// e.g. to speed up a scan someone is waiting for. It's bounded by the
+// number of CPUs. The scan still holds the folder I/O limiter like any other
+// and later scans use the configured number again.
+func (f *folder) ScanWithHashers(subdirs []string, hashers int) error {
+	if max := runtime.NumCPU(); hashers < 1 || hashers > max {
+		return fmt.Errorf("%w: %d, must be between 1 and %d", ErrHashersOutOfRange, hashers, max)
+	}
+	<-f.initialScanFinished
+	return f.doInSync(func() error { return f.scanSubdirsWithOptions(subdirs, scanOptions{hashers: hashers}) })
+}
+
+// ScanMatching scans the entire folder, but only the files whose name, or
+// path if the pattern contains a separator, matches the given glob pattern.
+// All other items are left untouched in the database, i.e. they are
+// neither rehashed nor marked deleted.
+func (f *folder) ScanMatching(pattern string) error {
+	pattern = filepath.FromSlash(pattern)
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return err
+	}
+	matching := func(name string) bool {
+		if !strings.ContainsRune(pattern, filepath.Separator) {
+			name = filepath.Base(name)
+		}
+		ok, _ := filepath.Match(pattern, name)
+		return ok
+	}
+	<-f.initialScanFinished
+	return f.doInSync(func() error {
+		return f.scanSubdirsWithOptions(nil, scanOptions{force: true, matching: matching})
+	})
+}
+
+// ScanDryRun scans the given subdirectories, or the entire folder, and
+// returns the resulting changes without applying them.
+func (f *folder) ScanDryRun(subdirs []string) ([]protocol.FileInfo, error) {
+	<-f.initialScanFinished
+	files := make([]protocol.FileInfo, 0)
+	seen := make(map[string]int)
+	err := f.doInSync(func() error {
+		return f.scanSubdirsWithOptions(subdirs, scanOptions{
+			force: true,
+			dryRun: func(fs []protocol.FileInfo) {
+				for _, fi := range fs {
+					// As the database isn't updated, the deletion of
+					// a renamed file is found again when looking for
+					// deleted files.
+					if i, ok := seen[fi.Name]; ok {
+						files[i] = fi
+						continue
+					}
+					seen[fi.Name] = len(files)
+					files = append(files, fi)
+				}
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
 // doInSync allows to run functions synchronously in folder.serve from exported,
 // asynchronously called methods.
 func (f *folder) doInSync(fn func() error) error {
@@ -281,17 +671,44 @@ func (f *folder) doInSync(fn func() error) error {
 	}
 }
 
+// Drain makes the folder stop what it's doing at the next point where
+// that's safe, i.e. after flushing the current database batch and releasing
+// its limiters, as when stopping, but without exiting. The returned channel
+// is closed once that's done.
+func (f *folder) Drain() <-chan struct{} {
+	if atomic.CompareAndSwapInt32(&f.draining, 0, 1) {
+		close(f.drainRequested)
+	}
+	return f.done
+}
+
 func (f *folder) Reschedule() {
-	if f.scanInterval == 0 {
+	f.scanTimerRequested = false
+	interval := f.rescanInterval(time.Now())
+	if interval == 0 {
 		return
 	}
-	// Sleep a random time between 3/4 and 5/4 of the configured interval.
-	sleepNanos := (f.scanInterval.Nanoseconds()*3 + rand.Int63n(2*f.scanInterval.Nanoseconds())) / 4
-	interval := time.Duration(sleepNanos) * time.Nanosecond
 	l.Debugln(f, "next rescan in", interval)
 	f.scanTimer.Reset(interval)
 }
 
+// rescanInterval returns how long from now until the next timer scan, or
+// zero if there are no timer scans.
+func (f *folder) rescanInterval(now time.Time) time.Duration {
+	scanInterval := f.scanActivity.interval(f.scanInterval, now)
+	if scanInterval == 0 {
+		return 0
+	}
+	interval := scanInterval
+	if !f.DeterministicRescan {
+		// Sleep a random time between 3/4 and 5/4 of the configured interval.
+		sleepNanos := (scanInterval.Nanoseconds()*3 + rand.Int63n(2*scanInterval.Nanoseconds())) / 4
+		interval = time.Duration(sleepNanos) * time.Nanosecond
+	}
+	// Don't wake up outside the scan windows just to be deferred.
+	return interval + f.UntilScanWindow(now.Add(interval))
+}
+
 func (f *folder) getHealthErrorAndLoadIgnores() error {
 	if err := f.getHealthErrorWithoutIgnores(); err != nil {
 		return err
@@ -312,8 +729,16 @@ func (f *folder) getHealthErrorWithoutIgnores() error {
 		return err
 	}
 
+	if err := f.checkEmptyPath(); err != nil {
+		return err
+	}
+
+	if err := f.checkNestedFolder(); err != nil {
+		return err
+	}
+
 	dbPath := locations.Get(locations.Database)
-	if usage, err := fs.NewFilesystem(fs.FilesystemTypeBasic, dbPath).Usage("."); err == nil {
+	if usage, err := fs.NewFilesystem(fs.FilesystemTypeBasic, dbPath).Usage("."); err == nil && !f.databaseSpaceCheckedByFolder(usage) {
 		if err = config.CheckFreeSpace(f.model.cfg.Options().MinHomeDiskFree, usage); err != nil {
 			return errors.Wrapf(err, "insufficient space on disk for database (%v)", dbPath)
 		}
@@ -322,6 +747,40 @@ func (f *folder) getHealthErrorWithoutIgnores() error {
 	return nil
 }
 
+// databaseSpaceCheckedByFolder returns true if the free space of the
+// database volume with the given usage doesn't need checking, as it is the
+// same volume as the folder's and that is checked already. Volumes are
+// considered the same if their total size matches and the free space is
+// close, allowing for writes between getting both.
+func (f *folder) databaseSpaceCheckedByFolder(dbUsage fs.Usage) bool {
+	if !f.SkipSharedVolumeDatabaseSpaceCheck || f.MinDiskFree.BaseValue() <= 0 {
+		return false
+	}
+	usage, err := f.Filesystem().Usage(".")
+	if err != nil || usage.Total != dbUsage.Total {
+		return false
+	}
+	diff := int64(usage.Free) - int64(dbUsage.Free)
+	if diff < 0 {
+		diff = -diff
+	}
+	return uint64(diff) <= usage.Total/1000
+}
+
+// spaceChanged returns an error if there isn't enough free space for the
+// folder or the database, and otherwise schedules a pull to resume syncing
+// in case it was held up by a lack of space.
+func (f *folder) spaceChanged() error {
+	if err := f.getHealthErrorWithoutIgnores(); err != nil {
+		return err
+	}
+	if err := f.CheckAvailableSpace(0); err != nil {
+		return err
+	}
+	f.SchedulePull()
+	return nil
+}
+
 func (f *folder) pull() (success bool, err error) {
 	f.pullFailTimer.Stop()
 	select {
@@ -336,10 +795,20 @@ func (f *folder) pull() (success bool, err error) {
 		return true, nil
 	}
 
+	if f.VerifyBeforePull {
+		if err := f.verifyBeforePull(); err != nil {
+			return false, err
+		}
+	}
+
 	defer func() {
 		if success {
 			// We're good, reset the pause interval.
 			f.pullPause = f.pullBasePause()
+			if f.PullBackoff() > 0 {
+				f.setPullBackoff(0)
+			}
+			f.resetPullRetries()
 		}
 	}()
 
@@ -358,10 +827,16 @@ func (f *folder) pull() (success bool, err error) {
 		// Clears pull failures on items that were needed before, but aren't anymore.
 		f.errorsMut.Lock()
 		f.pullErrors = nil
+		f.pullErrorsDropped = 0
+		f.persistFileErrorsLocked()
 		f.errorsMut.Unlock()
 		return true, nil
 	}
 
+	if atomic.LoadInt32(&f.pullHalted) == 1 {
+		return false, f.pullHaltedError()
+	}
+
 	// Abort early (before acquiring a token) if there's a folder error
 	err = f.getHealthErrorWithoutIgnores()
 	if err != nil {
@@ -379,9 +854,12 @@ func (f *folder) pull() (success bool, err error) {
 			return true, err
 		}
 		defer f.ioLimiter.give(1)
+		atomic.AddInt32(&f.model.pullsHoldingIO, 1)
+		defer atomic.AddInt32(&f.model.pullsHoldingIO, -1)
 	}
 
 	startTime := time.Now()
+	pulledBefore := atomic.LoadInt64(&f.pulledBytes)
 
 	// Check if the ignore patterns changed.
 	oldHash := f.ignores.Hash()
@@ -397,25 +875,93 @@ func (f *folder) pull() (success bool, err error) {
 	}
 
 	success, err = f.puller.pull()
+	f.flushPullEventSummary()
 
 	if success && err == nil {
+		f.schedulePostPullCommand()
+		if err := f.PullCompleted(); err != nil {
+			l.Debugln(f, "recording pull completion:", err)
+		}
 		return true, nil
 	}
 
 	// Pulling failed, try again later.
+	if failures := atomic.AddInt32(&f.pullFailures, 1); f.MaxPullRetries > 0 && int(failures) > f.MaxPullRetries {
+		atomic.StoreInt32(&f.pullHalted, 1)
+		atomic.StoreInt64(&f.pullRetryAt, 0)
+		l.Warnf("Folder %v isn't making sync progress - stopped retrying after %d attempts.", f.Description(), failures)
+		if err == nil {
+			err = f.pullHaltedError()
+		}
+		return false, err
+	}
+	if f.AdaptivePullPause {
+		f.adaptPullPause(atomic.LoadInt64(&f.pulledBytes)-pulledBefore, time.Since(startTime), f.model.cfg.Options().MaxRecvKbps)
+	}
 	delay := f.pullPause + time.Since(startTime)
 	l.Infof("Folder %v isn't making sync progress - retrying in %v.", f.Description(), util.NiceDurationString(delay))
 	f.pullFailTimer.Reset(delay)
+	atomic.StoreInt64(&f.pullRetryAt, time.Now().Add(delay).UnixNano())
 
 	return false, err
 }
 
+// scanOptions modify the behaviour of a single scan.
+type scanOptions struct {
+	// deleteGrace is how long to wait before checking again and acting on
+	// items that seem to be deleted. Used for watcher triggered scans, as
+	// some programs replace files by deleting and then renaming a
+	// temporary file into place.
+	deleteGrace time.Duration
+	// force walks all directories, even those that would be skipped as
+	// their listing didn't change since the last scan.
+	force bool
+	// rehashed are the files as they were before being marked for
+	// rescanning by a rehash, to keep their version if unchanged.
+	rehashed map[string]protocol.FileInfo
+	// dryRun, if set, is passed the changes instead of the database. Such
+	// a scan leaves no trace besides the folder state.
+	dryRun func([]protocol.FileInfo)
+	// matching, if set, restricts the scan to the items it returns true
+	// for, leaving all others untouched in the database.
+	matching func(name string) bool
+	// maxDuration, if set, stops walking after that long. What was scanned
+	// until then is kept, but deletions aren't detected and
+	// errScanTruncated is returned, with the item the walk stopped at in
+	// f.truncatedAt.
+	maxDuration time.Duration
+	// resumeAt, if set, skips walking the items before it, as they were
+	// walked by the truncated scan it continues.
+	resumeAt string
+	// hashers, if set, overrides the number of hasher routines.
+	hashers int
+}
+
 func (f *folder) scanSubdirs(subDirs []string) error {
+	return f.scanSubdirsWithOptions(subDirs, scanOptions{})
+}
+
+func (f *folder) scanSubdirsWithOptions(subDirs []string, opts scanOptions) (err error) {
 	l.Debugf("%v scanning", f)
 
+	if opts.dryRun == nil {
+		f.scanStats = scanStats{}
+		f.skippedMountPoints = nil
+	} else {
+		// Keep what the last regular scan found.
+		defer func(stats scanStats, mountPoints []string) {
+			f.scanStats = stats
+			f.skippedMountPoints = mountPoints
+		}(f.scanStats, f.skippedMountPoints)
+	}
+	f.scanPhases.reset(opts.dryRun == nil)
+	f.scannedDirHashes = nil
+	f.truncatedAt = ""
+	f.renameCache = newRenameCache(f.RenameCacheEntries)
+	defer func() { f.renameCache = nil }()
 	oldHash := f.ignores.Hash()
 
-	err := f.getHealthErrorAndLoadIgnores()
+	err = f.getHealthErrorAndLoadIgnores()
 	if err != nil {
 		// If there is a health error we set it as the folder error. We do not
 		// clear the folder error if there is no health error, as there might be
@@ -423,13 +969,15 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 		// we do not use the CheckHealth() convenience function here.
 		return err
 	}
-	f.setError(nil)
+	// Pulling stays halted until resumed, thus keep signalling that.
+	f.setError(f.pullHaltedError())
+	ignoresHash := f.ignores.Hash()
 
 	// Check on the way out if the ignore patterns changed as part of scanning
 	// this folder. If they did we should schedule a pull of the folder so that
 	// we request things we might have suddenly become unignored and so on.
 	defer func() {
-		if f.ignores.Hash() != oldHash {
+		if f.ignores.Hash() != oldHash && opts.dryRun == nil {
 			l.Debugln("Folder", f.Description(), "ignore patterns change detected while scanning; triggering puller")
 			f.ignoresUpdated()
 			f.SchedulePull()
@@ -439,6 +987,11 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	f.setState(FolderScanWaiting)
 	defer f.setState(FolderIdle)
 
+	if err := f.takeScanSlot(); err != nil {
+		return err
+	}
+	defer f.model.folderScanLimiter.give(1)
+
 	if err := f.ioLimiter.takeWithContext(f.ctx, 1); err != nil {
 		return err
 	}
@@ -457,6 +1010,11 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 		subDirs[i] = sub
 	}
 
+	if len(subDirs) == 0 && opts.dryRun == nil && opts.matching == nil {
+		// Everything the watcher asked for so far is covered by this scan.
+		f.clearPendingScan()
+	}
+
 	// Clean the list of subitems to ensure that we start at a known
 	// directory, and don't scan subdirectories of things we've already
 	// scanned.
@@ -471,31 +1029,56 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	snap.Release()
 
 	f.setState(FolderScanning)
-	f.clearScanErrors(subDirs)
+	if opts.dryRun == nil {
+		f.clearScanErrors(subDirs)
+		f.clearSkippedSymlinks(subDirs)
+
+		start := time.Now()
+		scannedSubDirs := subDirs
+		defer func() {
+			f.persistFileErrors()
+			f.logScanErrorSummary()
+			f.emitScanCompleted(scannedSubDirs, time.Since(start), err)
+		}()
+	}
 
 	batch := newFileInfoBatch(func(fs []protocol.FileInfo) error {
 		if err := f.getHealthErrorWithoutIgnores(); err != nil {
 			l.Debugf("Stopping scan of folder %s due to: %s", f.Description(), err)
 			return err
 		}
+		if opts.dryRun != nil {
+			opts.dryRun(fs)
+			return nil
+		}
 		f.updateLocalsFromScanning(fs)
 		return nil
 	})
 
-	batchAppend := f.scanSubdirsBatchAppendFunc(batch)
+	batchAppend := f.scanSubdirsBatchAppendFunc(batch, opts.dryRun != nil)
 
 	// Schedule a pull after scanning, but only if we actually detected any
-	// changes.
+	// changes and pulling has something to do.
 	changes := 0
 	defer func() {
 		l.Debugf("%v finished scanning, detected %v changes", f, changes)
-		if changes > 0 {
+		if changes > 0 && opts.dryRun == nil && f.pullHasWork() {
 			f.SchedulePull()
 		}
 	}()
 
-	changesHere, err := f.scanSubdirsChangedAndNew(subDirs, batch, batchAppend)
+	changesHere, err := f.scanSubdirsChangedAndNew(subDirs, batch, batchAppend, opts)
 	changes += changesHere
+	if err == errScanTruncated {
+		// Items not walked yet would look deleted, thus only keep what was
+		// scanned.
+		l.Infof("Folder %v: Stopped scanning after %v, continuing with the next scan", f.Description(), opts.maxDuration)
+		f.scanPhases.enter(scanPhaseFlushing)
+		if err := batch.flush(); err != nil {
+			return err
+		}
+		return errScanTruncated
+	}
 	if err != nil {
 		return err
 	}
@@ -513,23 +1096,89 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	// Do a scan of the database for each prefix, to check for deleted and
 	// ignored files.
 
-	changesHere, err = f.scanSubdirsDeletedAndIgnored(subDirs, batch, batchAppend)
+	changesHere, reappeared, err := f.scanSubdirsDeletedAndIgnored(subDirs, batch, batchAppend, opts)
 	changes += changesHere
 	if err != nil {
+		if f.ctx.Err() != nil {
+			// Stopping or draining, keep what was detected until then.
+			if err := batch.flush(); err != nil {
+				return err
+			}
+		}
 		return err
 	}
 
+	if len(reappeared) > 0 {
+		// Items that were gone but came back within the grace period might
+		// have changed in the meantime.
+		l.Debugf("%v rescanning %v items that reappeared within the delete grace period", f, len(reappeared))
+		reappeared = unifySubs(reappeared, func(string) bool { return true })
+		changesHere, err = f.scanSubdirsChangedAndNew(reappeared, batch, batchAppend, scanOptions{force: true, dryRun: opts.dryRun, matching: opts.matching})
+		changes += changesHere
+		if err != nil {
+			return err
+		}
+	}
+
+	f.scanPhases.enter(scanPhaseFlushing)
 	if err := batch.flush(); err != nil {
 		return err
 	}
 
+	if opts.dryRun != nil {
+		return nil
+	}
+
+	if len(subDirs) == 0 {
+		f.ignoresHashScanned = ignoresHash
+	}
+	f.storeDirHashes()
+
 	f.ScanCompleted()
 	return nil
 }
 
 type batchAppendFunc func(protocol.FileInfo, *db.Snapshot) bool
 
-func (f *folder) scanSubdirsBatchAppendFunc(batch *fileInfoBatch) batchAppendFunc {
+// pullHasWork returns whether pulling has anything to do, i.e. any item is
+// needed, or there are pull errors to clear as nothing is needed anymore.
+// Scanned changes often just update local bookkeeping, like the flags of
+// items in receive encrypted folders, which a pull has no business with.
+func (f *folder) pullHasWork() bool {
+	f.errorsMut.Lock()
+	pullErrors := len(f.pullErrors) > 0 || f.pullErrorsDropped > 0
+	f.errorsMut.Unlock()
+	if pullErrors {
+		return true
+	}
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		// Let the pull deal with it.
+		return true
+	}
+	defer snap.Release()
+	needed := false
+	snap.WithNeedTruncated(protocol.LocalDeviceID, func(protocol.FileIntf) bool {
+		needed = true
+		return false
+	})
+	return needed
+}
+
+// takeScanSlot waits until the folder may scan given the limit on
+// concurrently scanning folders, recording how long that took.
+func (f *folder) takeScanSlot() error {
+	start := time.Now()
+	if err := f.model.folderScanLimiter.takeWithContext(f.ctx, 1); err != nil {
+		return err
+	}
+	wait := time.Since(start)
+	scanSlotWaitTimer.Update(wait)
+	l.Debugf("%v waited %v for a scan slot", f, wait)
+	return nil
+}
+
+func (f *folder) scanSubdirsBatchAppendFunc(batch *fileInfoBatch, dryRun bool) batchAppendFunc {
 	// Resolve items which are identical with the global state.
 	switch f.Type {
 	case config.FolderTypeReceiveOnly:
@@ -559,7 +1208,7 @@ func (f *folder) scanSubdirsBatchAppendFunc(batch *fileInfoBatch) batchAppendFun
 			// We don't track it, but check if anything still exists
 			// within and delete it otherwise.
 			if fi.IsDirectory() && protocol.IsEncryptedParent(fs.PathComponents(fi.Name)) {
-				if names, err := f.mtimefs.DirNames(fi.Name); err == nil && len(names) == 0 {
+				if names, err := f.mtimefs.DirNames(fi.Name); err == nil && len(names) == 0 && !dryRun {
 					f.mtimefs.Remove(fi.Name)
 				}
 				return false
@@ -578,7 +1227,7 @@ func (f *folder) scanSubdirsBatchAppendFunc(batch *fileInfoBatch) batchAppendFun
 	}
 }
 
-func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *fileInfoBatch, batchAppend batchAppendFunc) (int, error) {
+func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *fileInfoBatch, batchAppend batchAppendFunc, opts scanOptions) (int, error) {
 	changes := 0
 	snap, err := f.dbSnapshot()
 	if err != nil {
@@ -588,25 +1237,88 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *fileInfoBatch
 
 	// If we return early e.g. due to a folder health error, the scan needs
 	// to be cancelled.
-	scanCtx, scanCancel := context.WithCancel(f.ctx)
+	var scanCtx context.Context
+	var scanCancel context.CancelFunc
+	if opts.maxDuration > 0 {
+		scanCtx, scanCancel = context.WithTimeout(f.ctx, opts.maxDuration)
+	} else {
+		scanCtx, scanCancel = context.WithCancel(f.ctx)
+	}
 	defer scanCancel()
 
+	// Files aren't hashed in receive encrypted folders, thus progress is
+	// tracked in files there.
+	f.scanProgress.start(f.Type == config.FolderTypeReceiveEncrypted, time.Now())
+	defer f.scanProgress.stop()
+
 	scanConfig := scanner.Config{
 		Folder:                f.ID,
 		Subs:                  subDirs,
 		Matcher:               f.ignores,
-		TempLifetime:          time.Duration(f.model.cfg.Options().KeepTemporariesH) * time.Hour,
+		IgnoreHidden:          f.IgnoreHidden,
+		TempLifetime:          f.tempLifetime(),
+		Skip:                  []string{config.DefaultMarkerName},
 		CurrentFiler:          cFiler{snap},
-		Filesystem:            f.mtimefs,
+		Filesystem:            f.scanFilesystem(scanCtx),
 		IgnorePerms:           f.IgnorePerms,
 		AutoNormalize:         f.AutoNormalize,
 		Hashers:               f.model.numHashers(f.ID),
+		HashNewestFirst:       f.HashNewestFirst,
+		MaxOpenFiles:          f.maxScanOpenFiles(),
 		ShortID:               f.shortID,
 		ProgressTickIntervalS: f.ScanProgressIntervalS,
 		LocalFlags:            f.localFlags,
 		ModTimeWindow:         f.modTimeWindow,
+		IgnoreSymlinks:        f.SymlinkPolicy == config.SymlinkPolicyIgnore,
+		ResolveSymlinks:       f.SymlinkPolicy == config.SymlinkPolicyResolve,
+		SkippedSymlink:        f.skipSymlink,
 		EventLogger:           f.evLogger,
+		HashTotal: func(files int, bytes int64) {
+			f.scanProgress.setTotal(files, bytes)
+			f.scanPhases.enter(scanPhaseHashing)
+		},
+		Restrict:          opts.matching,
+		OneFilesystem:     f.OneFilesystem,
+		SkippedMountPoint: f.skipMountPoint,
+	}
+	if opts.hashers > 0 {
+		scanConfig.Hashers = opts.hashers
+	}
+	scanConfig.ResumeAt = opts.resumeAt
+	var stoppedAt string
+	if opts.maxDuration > 0 {
+		scanConfig.Stopped = func(resumeAt string) {
+			stoppedAt = resumeAt
+		}
+	}
+	if f.SkipUnchangedDirs && !opts.force && opts.dryRun == nil {
+		if f.scannedDirHashes == nil {
+			f.scannedDirHashes = make(map[string][]byte)
+		}
+		scanConfig.DirHashes = dirHashStore{f.fset.DirHashes(), f.scannedDirHashes}
+	}
+	if opts.dryRun != nil {
+		scanConfig.KeepTemporaries = true
+	}
+	if opts.dryRun != nil {
+		scanConfig.SkippedSymlink = nil
+	}
+	var recentFiles []string
+	var recentUntil time.Time
+	recentMut := sync.NewMutex()
+	if f.MinFileAgeS > 0 {
+		minFileAge := time.Duration(f.MinFileAgeS) * time.Second
+		scanConfig.MinFileAge = minFileAge
+		scanConfig.SkippedRecentFile = func(path string, modTime time.Time) {
+			recentMut.Lock()
+			recentFiles = append(recentFiles, path)
+			if until := modTime.Add(minFileAge); until.After(recentUntil) {
+				recentUntil = until
+			}
+			recentMut.Unlock()
+		}
 	}
+	f.scanPhases.enter(scanPhaseWalking)
 	var fchan chan scanner.ScanResult
 	if f.Type == config.FolderTypeReceiveEncrypted {
 		fchan = scanner.WalkWithoutHashing(scanCtx, scanConfig)
@@ -614,10 +1326,25 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *fileInfoBatch
 		fchan = scanner.Walk(scanCtx, scanConfig)
 	}
 
+	// Rename candidates come from the database, which only lacks items
+	// that were ignored since the last complete scan.
+	checkIgnores := !f.SkipRenameIgnoreCheck || f.ignores.Hash() != f.ignoresHashScanned
 	alreadyUsedOrExisting := make(map[string]struct{})
+	var caseIdx *caseIndex
+	if !f.CaseSensitiveFS {
+		caseIdx = newCaseIndex(snap)
+	}
 	for res := range fchan {
 		if res.Err != nil {
-			f.newScanError(res.Path, res.Err)
+			if errors.Is(res.Err, context.DeadlineExceeded) {
+				// Interrupted by running out of time, not an actual error.
+				continue
+			}
+			if opts.dryRun == nil {
+				f.newScanError(res.Path, res.Err)
+			} else {
+				l.Debugf("%v dry run: scanning %v failed: %v", f, res.Path, res.Err)
+			}
 			continue
 		}
 
@@ -630,6 +1357,27 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *fileInfoBatch
 			return changes, err
 		}
 
+		if caseIdx != nil && !res.File.IsDeleted() {
+			if caseIdx.belowConflictingDir(res.File.Name) {
+				continue
+			}
+			if existing, ok := f.checkCaseConflict(caseIdx, res.File.Name); ok {
+				if res.File.IsDirectory() {
+					caseIdx.addConflictingDir(res.File.Name)
+				}
+				if opts.dryRun == nil {
+					f.reportCaseConflict(res.File.Name, existing)
+				}
+				continue
+			}
+		}
+
+		f.scanProgress.scanned(res.File)
+		f.scanStats.scanned(res.File, f.Type != config.FolderTypeReceiveEncrypted)
+
+		if opts.rehashed != nil {
+			f.keepVersionIfUnchanged(opts.rehashed, &res.File)
+		}
 		if batchAppend(res.File, snap) {
 			changes++
 		}
@@ -637,7 +1385,11 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *fileInfoBatch
 		switch f.Type {
 		case config.FolderTypeReceiveOnly, config.FolderTypeReceiveEncrypted:
 		default:
-			if nf, ok := f.findRename(snap, res.File, alreadyUsedOrExisting); ok {
+			if nf, ok := f.findRename(snap, res.File, alreadyUsedOrExisting, checkIgnores); ok {
+				if opts.dryRun == nil {
+					f.emitRenameEvent(nf, res.File)
+				}
+				f.scanStats.renamed++
 				if batchAppend(nf, snap) {
 					changes++
 				}
@@ -645,18 +1397,97 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *fileInfoBatch
 		}
 	}
 
+	recentMut.Lock()
+	if len(recentFiles) > 0 && opts.dryRun == nil {
+		f.scanRecentFilesLater(recentFiles, recentUntil)
+	}
+	recentMut.Unlock()
+
+	if stoppedAt != "" && f.ctx.Err() == nil {
+		f.truncatedAt = stoppedAt
+		return changes, errScanTruncated
+	}
 	return changes, nil
 }
 
-func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *fileInfoBatch, batchAppend batchAppendFunc) (int, error) {
+// scanRecentFilesLater scans files that were skipped for being modified too
+// recently once they are old enough. If they changed again by then, they
+// are skipped and scheduled again. Nothing is scanned if the folder stopped
+// in the meantime.
+func (f *folder) scanRecentFilesLater(paths []string, until time.Time) {
+	ctx := f.ctx
+	delay := time.Until(until)
+	l.Debugf("%v scanning %d recently modified files in %v", f, len(paths), delay)
+	timer := time.NewTimer(delay)
+	go func() {
+		select {
+		case <-timer.C:
+			f.addPendingScan(paths)
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}()
+}
+
+// tempLifetime returns how long temporary files are kept, as configured
+// for the folder or else globally.
+func (f *folder) tempLifetime() time.Duration {
+	if f.KeepTemporariesH > 0 {
+		return time.Duration(f.KeepTemporariesH) * time.Hour
+	}
+	return time.Duration(f.model.cfg.Options().KeepTemporariesH) * time.Hour
+}
+
+// maxScanOpenFiles returns how many files may be open at the same time while
+// hashing. Unless configured, it's a quarter of the open files limit of the
+// process, leaving room for connections, the database and other folders.
+func (f *folder) maxScanOpenFiles() int {
+	if f.MaxScanOpenFiles > 0 {
+		return f.MaxScanOpenFiles
+	}
+	limit, err := osutil.OpenFileLimit()
+	if err != nil || limit <= 0 {
+		return 0
+	}
+	if limit < 4 {
+		return 1
+	}
+	return limit / 4
+}
+
+// scanSubdirsDeletedAndIgnored checks the database for items that were
+// deleted or became ignored. If opts.deleteGrace is non-zero, items that
+// seem deleted are only checked again and marked deleted once the grace
+// period has passed. Those that exist again at that point are returned.
+func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *fileInfoBatch, batchAppend batchAppendFunc, opts scanOptions) (int, []string, error) {
+	f.scanPhases.enter(scanPhaseDetectingDeletes)
+	deleteGrace := opts.deleteGrace
 	var toIgnore []db.FileInfoTruncated
+	var maybeDeleted []db.FileInfoTruncated
 	ignoredParent := ""
 	changes := 0
 	snap, err := f.dbSnapshot()
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 	defer snap.Release()
+	ignoresHidden := f.hiddenIgnorer()
+
+	markDeleted := func(file db.FileInfoTruncated) {
+		nf := file.ConvertToDeletedFileInfo(f.shortID)
+		nf.LocalFlags = f.localFlags
+		if file.ShouldConflict() {
+			// We do not want to override the global version with
+			// the deleted file. Setting to an empty version makes
+			// sure the file gets in sync on the following pull.
+			nf.Version = protocol.Vector{}
+		}
+		l.Debugln("marking file as deleted", nf)
+		if batchAppend(nf, snap) {
+			changes++
+			f.scanStats.deleted++
+		}
+	}
 
 	for _, sub := range subDirs {
 		var iterError error
@@ -669,6 +1500,13 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *fileInfoB
 			}
 
 			file := fi.(db.FileInfoTruncated)
+			if opts.matching != nil && !opts.matching(file.Name) {
+				return true
+			}
+			if f.belowSkippedMountPoint(file.Name) {
+				// Not scanned, thus neither deleted nor ignored.
+				return true
+			}
 
 			if err := batch.flushIfFull(); err != nil {
 				iterError = err
@@ -691,7 +1529,7 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *fileInfoB
 				ignoredParent = ""
 			}
 
-			switch ignored := f.ignores.Match(file.Name).IsIgnored(); {
+			switch ignored := f.ignores.Match(file.Name).IsIgnored() || ignoresHidden(file.Name); {
 			case file.IsIgnored() && ignored:
 				return true
 			case !file.IsIgnored() && ignored:
@@ -728,20 +1566,21 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *fileInfoB
 						toIgnore = toIgnore[:0]
 						ignoredParent = ""
 					}
+					if file.IsSymlink() && f.SymlinkPolicy == config.SymlinkPolicyIgnore {
+						// Scanned before symlinks were ignored, thus
+						// stop announcing it like on Windows.
+						l.Debugln("marking symlink as unsupported", file)
+						if batchAppend(file.ConvertToUnsupportedFileInfo(), snap) {
+							changes++
+						}
+					}
 					return true
 				}
-				nf := file.ConvertToDeletedFileInfo(f.shortID)
-				nf.LocalFlags = f.localFlags
-				if file.ShouldConflict() {
-					// We do not want to override the global version with
-					// the deleted file. Setting to an empty version makes
-					// sure the file gets in sync on the following pull.
-					nf.Version = protocol.Vector{}
-				}
-				l.Debugln("marking file as deleted", nf)
-				if batchAppend(nf, snap) {
-					changes++
+				if deleteGrace > 0 {
+					maybeDeleted = append(maybeDeleted, file)
+					return true
 				}
+				markDeleted(file)
 			case file.IsDeleted() && file.IsReceiveOnlyChanged() && f.Type == config.FolderTypeReceiveOnly && len(snap.Availability(file.Name)) == 0:
 				file.Version = protocol.Vector{}
 				file.LocalFlags &^= protocol.FlagLocalReceiveOnly
@@ -764,7 +1603,7 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *fileInfoB
 
 		select {
 		case <-f.ctx.Done():
-			return changes, f.ctx.Err()
+			return changes, nil, f.ctx.Err()
 		default:
 		}
 
@@ -783,72 +1622,151 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *fileInfoB
 		}
 
 		if iterError != nil {
-			return changes, iterError
+			return changes, nil, iterError
 		}
 	}
 
-	return changes, nil
+	if len(maybeDeleted) == 0 {
+		return changes, nil, nil
+	}
+
+	l.Debugf("%v waiting %v before checking %v seemingly deleted items again", f, deleteGrace, len(maybeDeleted))
+	t := time.NewTimer(deleteGrace)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-f.ctx.Done():
+		return changes, nil, f.ctx.Err()
+	}
+
+	var reappeared []string
+	for _, file := range maybeDeleted {
+		if !osutil.IsDeleted(f.mtimefs, file.Name) {
+			reappeared = append(reappeared, file.Name)
+			continue
+		}
+		markDeleted(file)
+		if err := batch.flushIfFull(); err != nil {
+			return changes, nil, err
+		}
+	}
+
+	return changes, reappeared, nil
 }
 
-func (f *folder) findRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUsedOrExisting map[string]struct{}) (protocol.FileInfo, bool) {
-	if len(file.Blocks) == 0 || file.Size == 0 {
+func (f *folder) findRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUsedOrExisting map[string]struct{}, checkIgnores bool) (protocol.FileInfo, bool) {
+	if len(file.Blocks) == 0 || file.Size == 0 || f.RenameDetectionMode == config.RenameDetectionModeOff {
 		return protocol.FileInfo{}, false
 	}
 
+	var nf protocol.FileInfo
 	found := false
-	nf := protocol.FileInfo{}
-
-	snap.WithBlocksHash(file.BlocksHash, func(ifi protocol.FileIntf) bool {
-		fi := ifi.(protocol.FileInfo)
 
-		select {
-		case <-f.ctx.Done():
-			return false
-		default:
+	if names, ok := f.renameCache.get(file.BlocksHash); ok {
+		for _, name := range names {
+			if f.ctx.Err() != nil {
+				break
+			}
+			// The cached candidate may have changed since, e.g. when it was
+			// cached from the snapshot of an earlier pass of this scan.
+			fi, ok := snap.Get(protocol.LocalDeviceID, name)
+			if !ok || fi.IsDeleted() || !bytes.Equal(fi.BlocksHash, file.BlocksHash) {
+				continue
+			}
+			if nf, found = f.checkRenameCandidate(fi, file, alreadyUsedOrExisting, checkIgnores); found {
+				break
+			}
 		}
+	} else {
+		var names []string
+		complete := true
+		snap.WithBlocksHash(file.BlocksHash, func(ifi protocol.FileIntf) bool {
+			select {
+			case <-f.ctx.Done():
+				complete = false
+				return false
+			default:
+			}
 
-		if fi.Name == file.Name {
-			alreadyUsedOrExisting[fi.Name] = struct{}{}
-			return true
+			fi := ifi.(protocol.FileInfo)
+			if f.renameCache != nil {
+				names = append(names, fi.Name)
+			}
+			if !found {
+				nf, found = f.checkRenameCandidate(fi, file, alreadyUsedOrExisting, checkIgnores)
+			}
+			// With a cache all candidates are collected, not just up to
+			// the first match.
+			return !found || f.renameCache != nil
+		})
+		if complete {
+			f.renameCache.add(file.BlocksHash, names)
 		}
+	}
 
-		if _, ok := alreadyUsedOrExisting[fi.Name]; ok {
-			return true
-		}
+	if !found && f.RenameDetectionMode == config.RenameDetectionModeFuzzy {
+		return f.findFuzzyRename(snap, file, alreadyUsedOrExisting, checkIgnores)
+	}
+	return nf, found
+}
 
-		if fi.ShouldConflict() {
-			return true
-		}
+// checkRenameCandidate returns the deleted version of fi if file is a rename
+// of it, i.e. it has the same contents and doesn't exist on disk anymore.
+func (f *folder) checkRenameCandidate(fi, file protocol.FileInfo, alreadyUsedOrExisting map[string]struct{}, checkIgnores bool) (protocol.FileInfo, bool) {
+	if fi.Name == file.Name {
+		alreadyUsedOrExisting[fi.Name] = struct{}{}
+		return protocol.FileInfo{}, false
+	}
 
-		if f.ignores.Match(fi.Name).IsIgnored() {
-			return true
-		}
+	if _, ok := alreadyUsedOrExisting[fi.Name]; ok {
+		return protocol.FileInfo{}, false
+	}
 
-		// Only check the size.
-		// No point checking block equality, as that uses BlocksHash comparison if that is set (which it will be).
-		// No point checking BlocksHash comparison as WithBlocksHash already does that.
-		if file.Size != fi.Size {
-			return true
-		}
+	if fi.ShouldConflict() {
+		return protocol.FileInfo{}, false
+	}
 
-		alreadyUsedOrExisting[fi.Name] = struct{}{}
+	if checkIgnores && f.ignores.Match(fi.Name).IsIgnored() {
+		return protocol.FileInfo{}, false
+	}
 
-		if !osutil.IsDeleted(f.mtimefs, fi.Name) {
-			return true
-		}
+	// Only check the size.
+	// No point checking block equality, as that uses BlocksHash comparison if that is set (which it will be).
+	// No point checking BlocksHash comparison as candidates are looked up by it.
+	if file.Size != fi.Size {
+		return protocol.FileInfo{}, false
+	}
 
-		nf = fi
-		nf.SetDeleted(f.shortID)
-		nf.LocalFlags = f.localFlags
-		found = true
-		return false
-	})
+	alreadyUsedOrExisting[fi.Name] = struct{}{}
 
-	return nf, found
+	if !osutil.IsDeleted(f.mtimefs, fi.Name) {
+		return protocol.FileInfo{}, false
+	}
+
+	fi.SetDeleted(f.shortID)
+	fi.LocalFlags = f.localFlags
+	return fi, true
 }
 
 func (f *folder) scanTimerFired() error {
-	err := f.scanSubdirs(nil)
+	// Scans requested to happen right away aren't held back by the scan
+	// windows, only those due to the timer.
+	if f.deferScanWhilePulling() || (!f.scanTimerRequested && f.deferScanOutsideWindow()) {
+		return nil
+	}
+
+	f.scanDelayMut.Lock()
+	f.scanDelayReason = ""
+	f.scanDelayedUntil = time.Time{}
+	f.scanDelayMut.Unlock()
+
+	err := f.scanTimerSubdirs()
+
+	if f.ScanWindowsDeferWatcher {
+		// Pick up watcher changes deferred until now, unless the scan
+		// covered them already.
+		f.addPendingScan(nil)
+	}
 
 	select {
 	case <-f.initialScanFinished:
@@ -858,7 +1776,14 @@ func (f *folder) scanTimerFired() error {
 			status = "Failed"
 		}
 		l.Infoln(status, "initial scan of", f.Type.String(), "folder", f.Description())
+		f.initialScanMut.Lock()
 		close(f.initialScanFinished)
+		callbacks := f.initialScanCallbacks
+		f.initialScanCallbacks = nil
+		f.initialScanMut.Unlock()
+		for _, fn := range callbacks {
+			fn()
+		}
 	}
 
 	f.Reschedule()
@@ -866,6 +1791,107 @@ func (f *folder) scanTimerFired() error {
 	return err
 }
 
+// OnInitialScanComplete registers fn to be called once the initial scan
+// finished, successfully or not. Until then, fn is called from the folder's
+// routine and thus must not block. Afterwards it's called right away.
+func (f *folder) OnInitialScanComplete(fn func()) {
+	f.initialScanMut.Lock()
+	select {
+	case <-f.initialScanFinished:
+		f.initialScanMut.Unlock()
+		fn()
+	default:
+		f.initialScanCallbacks = append(f.initialScanCallbacks, fn)
+		f.initialScanMut.Unlock()
+	}
+}
+
+// scanResumePoint is where a truncated timer scan stopped walking, to
+// continue there on the next timer scan.
+type scanResumePoint struct {
+	subDirs []string
+	at      string
+	mtimes  map[string]int64
+}
+
+// scanTimerSubdirs scans the entire folder, or with ScanChangedDirsOnly
+// just the changed subtrees except for every FullRescanEvery fire, to
+// catch filesystems not updating directory modification times reliably.
+// If the last timer scan ran out of time, it's continued instead.
+func (f *folder) scanTimerSubdirs() error {
+	// The initial scan always completes, as pulling waits for it.
+	var opts scanOptions
+	select {
+	case <-f.initialScanFinished:
+		opts.maxDuration = f.MaxScanDuration()
+	default:
+	}
+	return f.scanTimerSubdirsWithOptions(opts)
+}
+
+func (f *folder) scanTimerSubdirsWithOptions(opts scanOptions) error {
+	if resume := f.scanResume; resume != nil {
+		l.Debugln(f, "resuming timer scan at", resume.at)
+		f.scanResume = nil
+		opts.resumeAt = resume.at
+		return f.runTimerScan(resume.subDirs, resume.mtimes, opts)
+	}
+
+	if !f.ScanChangedDirsOnly {
+		return f.runTimerScan(nil, nil, opts)
+	}
+
+	full := f.timerScans == 0
+	select {
+	case <-f.initialScanFinished:
+	default:
+		full = true
+	}
+	subDirs, mtimes, err := f.changedSubdirs()
+	if err != nil {
+		l.Debugln(f, "falling back to full scan, checking directories failed:", err)
+		full = true
+	}
+	if full {
+		subDirs = nil
+	} else if len(subDirs) == 0 {
+		l.Debugln(f, "skipping timer scan, no directory changed")
+		f.timerScans = (f.timerScans + 1) % f.FullRescanEvery
+		return nil
+	}
+
+	return f.runTimerScan(subDirs, mtimes, opts)
+}
+
+// runTimerScan scans subDirs for scanTimerSubdirs. If the scan runs out of
+// time, the point it stopped at is kept to resume there, and the directory
+// modification times are only stored once it completed.
+func (f *folder) runTimerScan(subDirs []string, mtimes map[string]int64, opts scanOptions) error {
+	// Scanning cleans up the subdirectories in place.
+	err := f.scanSubdirsWithOptions(append([]string(nil), subDirs...), opts)
+	if err == errScanTruncated {
+		f.scanResume = &scanResumePoint{
+			subDirs: subDirs,
+			at:      f.truncatedAt,
+			mtimes:  mtimes,
+		}
+		return nil
+	}
+	if !f.ScanChangedDirsOnly {
+		return err
+	}
+	if err != nil {
+		// Start over with a full scan next time.
+		f.timerScans = 0
+		return err
+	}
+	f.timerScans = (f.timerScans + 1) % f.FullRescanEvery
+	if mtimes == nil {
+		return nil
+	}
+	return f.storeDirMtimes(mtimes)
+}
+
 func (f *folder) versionCleanupTimerFired() {
 	f.setState(FolderCleanWaiting)
 	defer f.setState(FolderIdle)
@@ -877,25 +1903,141 @@ func (f *folder) versionCleanupTimerFired() {
 
 	f.setState(FolderCleaning)
 
-	if err := f.versioner.Clean(f.ctx); err != nil {
+	pressure, err := versioner.CleanupPressure(f.FolderConfiguration)
+	if err != nil {
+		l.Debugf("%v checking space for version cleanup: %v", f, err)
+	} else if pressure != versioner.SpacePressureNormal {
+		l.Debugf("%v cleaning versions with %v space pressure", f, pressure)
+	}
+
+	if err := f.versioner.Clean(f.ctx, pressure, f.versionCleanupCursor); err != nil {
 		l.Infoln("Failed to clean versions in %s: %v", f.Description(), err)
 	}
 
 	f.versionCleanupTimer.Reset(f.versionCleanupInterval)
 }
 
+// revertTimerFired reverts the local changes of a receive only folder, if
+// there are any.
+func (f *folder) revertTimerFired() error {
+	defer f.revertTimer.Reset(f.revertInterval)
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	changed := snap.ReceiveOnlyChangedSize().TotalItems()
+	snap.Release()
+	if changed == 0 {
+		return nil
+	}
+
+	l.Debugf("%v reverting %d locally changed items due to timer", f, changed)
+	return f.reverter.revert()
+}
+
+const versionCleanupCursorKey = "versionCleanupCursor"
+
+// versionCleanupCursor persists the progress of version cleanups in the
+// database, such that they resume after a restart.
+type versionCleanupCursor struct {
+	kv *db.NamespacedKV
+}
+
+func (c versionCleanupCursor) Get() string {
+	dir, _, err := c.kv.String(versionCleanupCursorKey)
+	if err != nil {
+		l.Debugln("Getting version cleanup cursor:", err)
+	}
+	return dir
+}
+
+func (c versionCleanupCursor) Set(dir string) {
+	var err error
+	if dir == "" {
+		err = c.kv.Delete(versionCleanupCursorKey)
+	} else {
+		err = c.kv.PutString(versionCleanupCursorKey, dir)
+	}
+	if err != nil {
+		l.Debugln("Setting version cleanup cursor:", err)
+	}
+}
+
+// ScanProgress returns how many bytes of the in-flight scan were hashed out
+// of how many, and the rate in bytes per second. Receive encrypted folders
+// don't hash, thus count files instead. It's all zero when not scanning,
+// and the total is zero until it is known.
+func (f *folder) ScanProgress() (current, total int64, rate float64) {
+	return f.scanProgress.get(time.Now())
+}
+
 func (f *folder) WatchError() error {
 	f.watchMut.Lock()
 	defer f.watchMut.Unlock()
 	return f.watchErr
 }
 
+// WatchStatus returns the current watch error, when watching is retried
+// next and how many consecutive failures there were. The retry time and
+// failures are zero while watching is healthy.
+func (f *folder) WatchStatus() (error, time.Time, int) {
+	f.watchMut.Lock()
+	defer f.watchMut.Unlock()
+	return f.watchErr, f.watchNextRetry, f.watchFailures
+}
+
+// addPendingScan merges the given subdirs into the set of pending watcher
+// triggered scans and makes sure the serve loop picks them up. An empty
+// subdir means the entire folder, which subsumes everything else.
+func (f *folder) addPendingScan(subDirs []string) {
+	f.scanPendingMut.Lock()
+	if !f.scanPendingFull {
+		for _, sub := range subDirs {
+			if sub == "" {
+				f.scanPendingFull = true
+				f.scanPending = nil
+				break
+			}
+		}
+	}
+	if !f.scanPendingFull {
+		f.scanPending = unifySubs(append(f.scanPending, subDirs...), func(string) bool { return true })
+	}
+	f.scanPendingMut.Unlock()
+
+	select {
+	case f.scanPendingChanged <- struct{}{}:
+	default:
+		// A scan is already queued, it will pick up the merged subdirs.
+	}
+}
+
+// takePendingScan returns and resets the pending subdirs to scan. A nil
+// slice with true means the entire folder, false means there is nothing to
+// scan.
+func (f *folder) takePendingScan() ([]string, bool) {
+	f.scanPendingMut.Lock()
+	defer f.scanPendingMut.Unlock()
+	subDirs, full := f.scanPending, f.scanPendingFull
+	f.scanPending = nil
+	f.scanPendingFull = false
+	return subDirs, full || len(subDirs) > 0
+}
+
+func (f *folder) clearPendingScan() {
+	f.scanPendingMut.Lock()
+	f.scanPending = nil
+	f.scanPendingFull = false
+	f.scanPendingMut.Unlock()
+}
+
 // stopWatch immediately aborts watching and may be called asynchronously
 func (f *folder) stopWatch() {
 	f.watchMut.Lock()
 	f.watchCancel()
 	f.watchMut.Unlock()
-	f.setWatchError(nil, 0)
+	f.setWatchError(nil, 0, 0)
 }
 
 // scheduleWatchRestart makes sure watching is restarted from the main for loop
@@ -918,20 +2060,68 @@ func (f *folder) restartWatch() error {
 	return f.scanSubdirs(nil)
 }
 
+// RestartWatchNow restarts watching like scheduleWatchRestart, but waits
+// until the new watch started or failed to and returns the resulting watch
+// error. The full scan following the restart happens asynchronously.
+func (f *folder) RestartWatchNow(ctx context.Context) error {
+	if !f.FSWatcherEnabled {
+		return errWatchNotEnabled
+	}
+	var started <-chan struct{}
+	err := f.doInSync(func() error {
+		f.stopWatch()
+		started = f.startWatch()
+		f.scanTimer.Reset(0)
+		f.scanTimerRequested = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	select {
+	case <-started:
+		return f.WatchError()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // startWatch should only ever be called synchronously. If you want to use
 // this asynchronously, you should probably use scheduleWatchRestart instead.
-func (f *folder) startWatch() {
+// The returned channel is closed after the first attempt to watch.
+func (f *folder) startWatch() <-chan struct{} {
 	ctx, cancel := context.WithCancel(f.ctx)
 	f.watchMut.Lock()
 	f.watchChan = make(chan []string)
 	f.watchCancel = cancel
 	f.watchMut.Unlock()
-	go f.monitorWatch(ctx)
+	started := make(chan struct{})
+	go f.monitorWatch(ctx, started)
+	go f.collectWatchEvents(ctx, f.watchChan)
+	return started
+}
+
+// collectWatchEvents keeps receiving from the aggregator while the folder is
+// busy, coalescing the requested subdirs into the pending scan.
+func (f *folder) collectWatchEvents(ctx context.Context, watchChan <-chan []string) {
+	for {
+		select {
+		case fsEvents := <-watchChan:
+			f.addPendingScan(fsEvents)
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
 // monitorWatch starts the filesystem watching and retries every minute on failure.
 // It should not be used except in startWatch.
-func (f *folder) monitorWatch(ctx context.Context) {
+func (f *folder) monitorWatch(ctx context.Context, started chan<- struct{}) {
+	defer func() {
+		if started != nil {
+			close(started)
+		}
+	}()
 	failTimer := time.NewTimer(0)
 	aggrCtx, aggrCancel := context.WithCancel(ctx)
 	var err error
@@ -940,14 +2130,26 @@ func (f *folder) monitorWatch(ctx context.Context) {
 	warnedOutside := false
 	var lastWatch time.Time
 	pause := time.Minute
+	// Consecutive errors, reset once watching was stable or the entire
+	// folder got rescanned.
+	failures := 0
 	for {
 		select {
 		case <-failTimer.C:
-			eventChan, errChan, err = f.Filesystem().Watch(".", f.ignores, ctx, f.IgnorePerms)
+			eventChan, errChan, err = f.Filesystem().Watch(".", f.watchMatcher(), ctx, f.IgnorePerms)
 			// We do this once per minute initially increased to
 			// max one hour in case of repeat failures.
-			f.scanOnWatchErr()
-			f.setWatchError(err, pause)
+			if f.scanOnWatchErr(failures) {
+				failures = 0
+			}
+			if err != nil {
+				failures++
+			}
+			f.setWatchError(err, pause, failures)
+			if started != nil {
+				close(started)
+				started = nil
+			}
 			if err != nil {
 				failTimer.Reset(pause)
 				if pause < 60*time.Minute {
@@ -963,14 +2165,16 @@ func (f *folder) monitorWatch(ctx context.Context) {
 			if dur := time.Since(lastWatch); dur > pause {
 				pause = time.Minute
 				next = 0
+				failures = 0
 			} else {
 				next = pause - dur
 				if pause < 60*time.Minute {
 					pause *= 2
 				}
 			}
+			failures++
 			failTimer.Reset(next)
-			f.setWatchError(err, next)
+			f.setWatchError(err, next, failures)
 			// This error was previously a panic and should never occur, so generate
 			// a warning, but don't do it repetitively.
 			var errOutside *fs.ErrWatchEventOutsideRoot
@@ -990,12 +2194,39 @@ func (f *folder) monitorWatch(ctx context.Context) {
 	}
 }
 
+// watchMatcher returns the matcher to filter watch events with.
+func (f *folder) watchMatcher() fs.Matcher {
+	if f.WatchDropIgnoredEvents {
+		return pruningWatchMatcher{f.ignores}
+	}
+	return f.ignores
+}
+
+// pruningWatchMatcher makes the watcher drop events in ignored directories
+// below which nothing can be unignored, even if negated patterns unignore
+// something elsewhere and thus ignored directories aren't skipped in
+// general.
+type pruningWatchMatcher struct {
+	*ignore.Matcher
+}
+
+func (m pruningWatchMatcher) CanPruneWatch(name string) bool {
+	return m.ShouldIgnore(name) && m.CanSkipDir(name)
+}
+
 // setWatchError sets the current error state of the watch and should be called
 // regardless of whether err is nil or not.
-func (f *folder) setWatchError(err error, nextTryIn time.Duration) {
+func (f *folder) setWatchError(err error, nextTryIn time.Duration, failures int) {
 	f.watchMut.Lock()
 	prevErr := f.watchErr
 	f.watchErr = err
+	if err != nil {
+		f.watchNextRetry = time.Now().Add(nextTryIn)
+		f.watchFailures = failures
+	} else {
+		f.watchNextRetry = time.Time{}
+		f.watchFailures = 0
+	}
 	f.watchMut.Unlock()
 	if err != prevErr {
 		data := map[string]interface{}{
@@ -1020,14 +2251,30 @@ func (f *folder) setWatchError(err error, nextTryIn time.Duration) {
 	l.Debugf(msg)
 }
 
-// scanOnWatchErr schedules a full scan immediately if an error occurred while watching.
-func (f *folder) scanOnWatchErr() {
+// scanOnWatchErr schedules a full scan immediately if an error occurred while
+// watching, unless the watch error policy says otherwise given the number of
+// consecutive failures. It returns whether a scan was scheduled. Without a
+// full scan, only what the watcher reported before failing is scanned and
+// anything else is picked up by the next periodic full scan.
+func (f *folder) scanOnWatchErr(failures int) bool {
 	f.watchMut.Lock()
 	err := f.watchErr
 	f.watchMut.Unlock()
-	if err != nil {
-		f.DelayScan(0)
+	if err == nil {
+		return false
+	}
+	switch f.WatchErrorPolicy {
+	case config.WatchErrorPolicyTargeted:
+		l.Debugf("%v not rescanning after watch error due to policy %v", f, f.WatchErrorPolicy)
+		return false
+	case config.WatchErrorPolicyEscalate:
+		if failures < f.WatchErrorRescanAfter {
+			l.Debugf("%v not rescanning after %d of %d watch errors", f, failures, f.WatchErrorRescanAfter)
+			return false
+		}
 	}
+	f.DelayScanWithReason(0, "watcher recovery")
+	return true
 }
 
 func (f *folder) setError(err error) {
@@ -1062,6 +2309,95 @@ func (f *folder) setError(err error) {
 	}
 
 	f.stateTracker.setError(err)
+
+	f.evLogger.Log(events.FolderErrorChanged, map[string]string{
+		"folder":   f.ID,
+		"label":    f.Label,
+		"previous": errString(oldErr),
+		"error":    errString(err),
+	})
+}
+
+// errString returns the error's text, or an empty string for nil.
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// PullBackoff returns how many times the pause between retrying failed
+// pulls was doubled, zero if the last pull succeeded.
+func (f *folder) PullBackoff() int {
+	return int(atomic.LoadInt32(&f.pullBackoff))
+}
+
+const pullBackoffKey = "pullBackoff"
+
+// setPullBackoff sets how many times the pull pause was doubled and
+// persists it, such that failing pulls aren't retried at once after a
+// restart.
+func (f *folder) setPullBackoff(backoff int) {
+	atomic.StoreInt32(&f.pullBackoff, int32(backoff))
+	kv := db.NewFolderStatisticsNamespace(f.model.db, f.ID)
+	var err error
+	if backoff == 0 {
+		err = kv.Delete(pullBackoffKey)
+	} else {
+		err = kv.PutInt64(pullBackoffKey, int64(backoff))
+	}
+	if err != nil {
+		l.Debugln("Setting pull backoff:", err)
+	}
+}
+
+// restorePullBackoff sets the pull pause according to the persisted
+// backoff. As that's the number of doublings, a changed base pause is
+// taken into account.
+func (f *folder) restorePullBackoff() {
+	f.pullPause = f.pullBasePause()
+	backoff, _, err := db.NewFolderStatisticsNamespace(f.model.db, f.ID).Int64(pullBackoffKey)
+	if err != nil {
+		l.Debugln("Getting pull backoff:", err)
+	}
+	var restored int32
+	for ; int64(restored) < backoff && f.pullPause < 60*f.pullBasePause(); restored++ {
+		f.pullPause *= 2
+	}
+	atomic.StoreInt32(&f.pullBackoff, restored)
+}
+
+// Thresholds in percent of the receive rate limit, below which the pause
+// before retrying a failed pull is extended respectively above which it's
+// shortened, with AdaptivePullPause.
+const (
+	adaptivePullPauseLowPct  = 25
+	adaptivePullPauseHighPct = 90
+)
+
+// adaptPullPause adjusts the pause before retrying a failed pull to the
+// rate it achieved relative to the receive rate limit in KiB/s: Pulling
+// far below the limit suggests a congested or metered link, thus retrying
+// is postponed, while pulling close to it retries sooner. The pause stays
+// between the base pause and the usual ceiling. Without a limit there's
+// nothing to compare to and the pause is left alone.
+func (f *folder) adaptPullPause(pulled int64, elapsed time.Duration, limitKiBps int) {
+	if limitKiBps <= 0 || elapsed <= 0 {
+		return
+	}
+	pct := float64(pulled) / elapsed.Seconds() / float64(limitKiBps*1024) * 100
+	switch {
+	case pct < adaptivePullPauseLowPct:
+		f.pullPause *= 2
+	case pct >= adaptivePullPauseHighPct:
+		f.pullPause /= 2
+	}
+	if base := f.pullBasePause(); f.pullPause < base {
+		f.pullPause = base
+	} else if f.pullPause > 60*base {
+		f.pullPause = 60 * base
+	}
+	l.Debugf("%v pulled at %.0f%% of the rate limit, pausing %v before retrying", f, pct, f.pullPause)
 }
 
 func (f *folder) pullBasePause() time.Duration {
@@ -1075,19 +2411,111 @@ func (f *folder) String() string {
 	return fmt.Sprintf("%s/%s@%p", f.Type, f.folderID, f)
 }
 
+// How many items failing with the same error are logged individually per
+// scan, the rest is only included in the summary at the end of the scan.
+const scanErrorLogThreshold = 10
+
+// IsPathIgnored returns whether the given path is ignored and, if an ignore
+// pattern decided that, where the pattern comes from as "file:line: text".
+// Negated patterns are returned as well, with ignored being false. Internal
+// and temporary items, as well as hidden ones if the folder ignores those,
+// are ignored without a pattern. The patterns are those loaded by the last
+// scan or pull, they aren't reloaded.
+func (f *folder) IsPathIgnored(path string) (bool, string, error) {
+	path, err := fs.Canonicalize(filepath.FromSlash(path))
+	if err != nil {
+		return false, "", err
+	}
+	if f.Type == config.FolderTypeReceiveEncrypted {
+		return false, "", nil
+	}
+	if fs.IsInternal(path) || fs.IsTemporary(path) || f.ignoresHidden(path) {
+		return true, "", nil
+	}
+	res, source := f.ignores.MatchSource(path)
+	return res.IsIgnored(), source, nil
+}
+
+// ignoresHidden returns whether the item is to be treated as ignored,
+// because it's hidden and the folder ignores hidden items.
+func (f *folder) ignoresHidden(name string) bool {
+	return f.IgnoreHidden && fs.IsHidden(f.mtimefs, name)
+}
+
+// hiddenIgnorer returns a function like ignoresHidden for checking many
+// items in one go, which checks every parent directory only once.
+func (f *folder) hiddenIgnorer() func(name string) bool {
+	if !f.IgnoreHidden {
+		return func(string) bool { return false }
+	}
+	return fs.NewHiddenCache(f.mtimefs).IsHidden
+}
+
 func (f *folder) newScanError(path string, err error) {
 	f.errorsMut.Lock()
-	l.Infof("Scanner (folder %s, item %q): %v", f.Description(), path, err)
-	f.scanErrors = append(f.scanErrors, FileError{
-		Err:  err.Error(),
-		Path: path,
-	})
+	text := scanErrorText(err)
+	if f.scanErrorCounts == nil {
+		f.scanErrorCounts = make(map[string]int)
+	}
+	f.scanErrorCounts[text]++
+	if f.scanErrorCounts[text] <= scanErrorLogThreshold {
+		l.Infof("Scanner (folder %s, item %q): %v", f.Description(), path, err)
+	} else {
+		l.Debugf("Scanner (folder %s, item %q): %v", f.Description(), path, err)
+	}
+	if f.MaxFileErrors > 0 && len(f.scanErrors) >= f.MaxFileErrors {
+		f.scanErrorsDropped++
+	} else {
+		f.scanErrors = append(f.scanErrors, FileError{
+			Err:  err.Error(),
+			Path: path,
+		})
+	}
 	f.errorsMut.Unlock()
 }
 
+// logScanErrorSummary logs how many items failed with each error text
+// during the scan, if it was more than one, and starts counting anew.
+func (f *folder) logScanErrorSummary() {
+	f.errorsMut.Lock()
+	counts := f.scanErrorCounts
+	f.scanErrorCounts = nil
+	f.errorsMut.Unlock()
+
+	texts := make([]string, 0, len(counts))
+	for text, count := range counts {
+		if count > 1 {
+			texts = append(texts, text)
+		}
+	}
+	sort.Strings(texts)
+	for _, text := range texts {
+		if count := counts[text]; count > scanErrorLogThreshold {
+			l.Infof("Scanner (folder %s): %d items failed with: %v (only the first %d were logged)", f.Description(), count, text, scanErrorLogThreshold)
+		} else {
+			l.Infof("Scanner (folder %s): %d items failed with: %v", f.Description(), count, text)
+		}
+	}
+}
+
+// scanErrorText returns the error's text without the item's path, such that
+// the same problem with different items reads the same.
+func scanErrorText(err error) string {
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Op + ": " + pathErr.Err.Error()
+	}
+	return err.Error()
+}
+
 func (f *folder) clearScanErrors(subDirs []string) {
 	f.errorsMut.Lock()
 	defer f.errorsMut.Unlock()
+	// It's unknown where the dropped errors were, thus forget them even
+	// when only clearing some subdirs. They are counted again if they
+	// still occur when those are scanned.
+	f.scanErrorsDropped = 0
+	defer f.persistFileErrorsLocked()
 	if len(subDirs) == 0 {
 		f.scanErrors = nil
 		return
@@ -1105,6 +2533,62 @@ outer:
 	f.scanErrors = filtered
 }
 
+func (f *folder) skipSymlink(path string) {
+	f.errorsMut.Lock()
+	if f.skippedSymlinks == nil {
+		f.skippedSymlinks = make(map[string]struct{})
+	}
+	f.skippedSymlinks[path] = struct{}{}
+	f.errorsMut.Unlock()
+}
+
+func (f *folder) clearSkippedSymlinks(subDirs []string) {
+	f.errorsMut.Lock()
+	defer f.errorsMut.Unlock()
+	if len(subDirs) == 0 {
+		f.skippedSymlinks = nil
+		return
+	}
+	for path := range f.skippedSymlinks {
+		for _, sub := range subDirs {
+			if path == sub || fs.IsParent(path, sub) {
+				delete(f.skippedSymlinks, path)
+				break
+			}
+		}
+	}
+}
+
+// SkippedSymlinks returns the symlinks that weren't scanned at last scan
+// due to the folder's symlink policy.
+func (f *folder) SkippedSymlinks() []string {
+	f.errorsMut.Lock()
+	defer f.errorsMut.Unlock()
+	paths := make([]string, 0, len(f.skippedSymlinks))
+	for path := range f.skippedSymlinks {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// skipMountPoint is called by the scanner, whose results are consumed
+// before the mount points are looked at in the same scan.
+func (f *folder) skipMountPoint(path string) {
+	f.skippedMountPoints = append(f.skippedMountPoints, path)
+}
+
+// belowSkippedMountPoint returns whether the item is a mount point skipped
+// in the current scan, or within one.
+func (f *folder) belowSkippedMountPoint(name string) bool {
+	for _, mountPoint := range f.skippedMountPoints {
+		if name == mountPoint || fs.IsParent(name, mountPoint) {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *folder) Errors() []FileError {
 	f.errorsMut.Lock()
 	defer f.errorsMut.Unlock()
@@ -1113,9 +2597,54 @@ func (f *folder) Errors() []FileError {
 	copy(errors[:scanLen], f.scanErrors)
 	copy(errors[scanLen:], f.pullErrors)
 	sort.Sort(fileErrorList(errors))
+	if dropped := f.scanErrorsDropped + f.pullErrorsDropped; dropped > 0 {
+		errors = append(errors, FileError{
+			Err: fmt.Sprintf("and %d more errors not shown", dropped),
+		})
+	}
 	return errors
 }
 
+// RetryPullError drops the pull error of the given path, then rescans the
+// file and pulls again.
+func (f *folder) RetryPullError(path string) error {
+	if !f.removePullError(path) {
+		return errNoPullError
+	}
+	f.ScheduleForceRescan(path)
+	f.SchedulePull()
+	return nil
+}
+
+// ClearPullError dismisses the pull error of the given path until the next
+// pull.
+func (f *folder) ClearPullError(path string) {
+	f.removePullError(path)
+}
+
+func (f *folder) removePullError(path string) bool {
+	f.errorsMut.Lock()
+	removed := false
+	for i, fe := range f.pullErrors {
+		if fe.Path == path {
+			f.pullErrors = append(f.pullErrors[:i:i], f.pullErrors[i+1:]...)
+			removed = true
+			break
+		}
+	}
+	if removed {
+		f.persistFileErrorsLocked()
+	}
+	f.errorsMut.Unlock()
+	if removed {
+		f.evLogger.Log(events.FolderErrors, map[string]interface{}{
+			"folder": f.ID,
+			"errors": f.Errors(),
+		})
+	}
+	return removed
+}
+
 // ScheduleForceRescan marks the file such that it gets rehashed on next scan, and schedules a scan.
 func (f *folder) ScheduleForceRescan(path string) {
 	f.forcedRescanPathsMut.Lock()
@@ -1128,18 +2657,218 @@ func (f *folder) ScheduleForceRescan(path string) {
 	}
 }
 
+// Verifying more items than this before pulling is done by scanning the
+// entire folder instead.
+const maxVerifyPaths = 10000
+
+// ScheduleVerifyBeforePull makes the next pull scan the given items first,
+// such that local changes to them are detected and turned into conflicts
+// instead of being overwritten. Beyond maxVerifyPaths items, e.g. due to a
+// full index being received, the entire folder is scanned instead.
+func (f *folder) ScheduleVerifyBeforePull(names []string) {
+	f.verifyPathsMut.Lock()
+	defer f.verifyPathsMut.Unlock()
+	if f.verifyAll {
+		return
+	}
+	for _, name := range names {
+		f.verifyPaths[name] = struct{}{}
+	}
+	if len(f.verifyPaths) > maxVerifyPaths {
+		f.verifyAll = true
+		f.verifyPaths = make(map[string]struct{})
+	}
+}
+
+// verifyBeforePull scans the items scheduled by ScheduleVerifyBeforePull
+// that are known locally. This is a regular scan, i.e. it only changes the
+// database if the items changed on disk, thus it doesn't cause index
+// updates that in turn make other devices verify and so on. If verifying
+// fails, the items are scheduled again for the next pull.
+func (f *folder) verifyBeforePull() (err error) {
+	f.verifyPathsMut.Lock()
+	names, all := f.verifyPaths, f.verifyAll
+	f.verifyPaths = make(map[string]struct{})
+	f.verifyAll = false
+	f.verifyPathsMut.Unlock()
+	defer func() {
+		if err != nil {
+			f.rescheduleVerify(names, all)
+		}
+	}()
+	if all {
+		l.Debugf("%v verifying all items before pulling", f)
+		return f.scanSubdirs(nil)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	paths := make([]string, 0, len(names))
+	for name := range names {
+		// Scanning items unknown locally would scan their parent instead.
+		if _, ok := snap.Get(protocol.LocalDeviceID, name); ok {
+			paths = append(paths, name)
+		}
+	}
+	snap.Release()
+	if len(paths) == 0 {
+		return nil
+	}
+	l.Debugf("%v verifying %d items before pulling", f, len(paths))
+	return f.scanSubdirs(paths)
+}
+
+// rescheduleVerify schedules the items taken by verifyBeforePull again.
+func (f *folder) rescheduleVerify(names map[string]struct{}, all bool) {
+	if all {
+		f.verifyPathsMut.Lock()
+		f.verifyAll = true
+		f.verifyPaths = make(map[string]struct{})
+		f.verifyPathsMut.Unlock()
+		return
+	}
+	list := make([]string, 0, len(names))
+	for name := range names {
+		list = append(list, name)
+	}
+	f.ScheduleVerifyBeforePull(list)
+}
+
+// RepairMtimes drops stored modification time mappings of local items which
+// don't apply anymore and returns how many were removed. It runs in the
+// folder's routine, so it can't race a scan.
+func (f *folder) RepairMtimes() (int, error) {
+	var removed int
+	err := f.doInSync(func() error {
+		var err error
+		removed, err = f.repairMtimes()
+		return err
+	})
+	return removed, err
+}
+
+// ChronicConflicts returns the files that conflicted more often than the
+// configured threshold within the configured window.
+func (f *folder) ChronicConflicts() ([]ChronicConflict, error) {
+	return f.conflicts.chronic(time.Now(), f.ChronicConflictWindow(), int(f.ChronicConflictThreshold))
+}
+
+// AuditEntries returns the matching entries of the audit log of changes
+// applied from other devices.
+func (f *folder) AuditEntries(q AuditQuery) ([]AuditEntry, error) {
+	return f.audit.query(q)
+}
+
+func (f *folder) repairMtimes() (int, error) {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	var names []string
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(fi protocol.FileIntf) bool {
+		if !fi.IsDeleted() && !fi.IsInvalid() {
+			names = append(names, fi.FileName())
+		}
+		return true
+	})
+	snap.Release()
+
+	removed := 0
+	for _, name := range names {
+		select {
+		case <-f.ctx.Done():
+			return removed, f.ctx.Err()
+		default:
+		}
+		repaired, err := fs.RepairMtime(f.mtimefs, name)
+		if err != nil {
+			return removed, err
+		}
+		if repaired {
+			l.Debugln(f, "removed stale mtime mapping for", name)
+			removed++
+		}
+	}
+	if removed > 0 {
+		l.Infof("Removed %d stale modification time mappings in folder %v", removed, f.Description())
+	}
+	return removed, nil
+}
+
 func (f *folder) updateLocalsFromScanning(fs []protocol.FileInfo) {
 	f.updateLocals(fs)
 
 	f.emitDiskChangeEvents(fs, events.LocalChangeDetected)
+
+	changes := 0
+	for _, file := range fs {
+		if !file.IsInvalid() {
+			changes++
+		}
+	}
+	// Bring the next scan forward when a burst of activity starts, unless
+	// it was explicitly delayed.
+	if f.scanActivity.record(changes, time.Now()) {
+		if reason, _ := f.ScanDelay(); reason == "" {
+			l.Debugln(f, "high activity, scanning more often")
+			f.Reschedule()
+		}
+	}
 }
 
 func (f *folder) updateLocalsFromPulling(fs []protocol.FileInfo) {
-	f.updateLocals(fs)
+	if !f.AuditLog {
+		f.updateLocals(fs)
+	} else {
+		// The previous state is needed to tell what changed.
+		now := time.Now()
+		entries, err := f.auditEntries(fs, now)
+		f.updateLocals(fs)
+		if err == nil {
+			err = f.audit.record(entries, f.AuditLogMaxEntries, f.AuditLogMaxAge(), now)
+		}
+		if err != nil {
+			l.Warnf("Folder %v: Failed to write audit log: %v", f.Description(), err)
+		}
+	}
+
+	// Send only folders merely merge versions of identical items.
+	if f.Type != config.FolderTypeSendOnly {
+		f.recordTransfers(fs)
+	}
 
+	if f.PullEventsPerS > 0 {
+		f.emitThrottledPullEvents(fs, time.Now())
+		return
+	}
 	f.emitDiskChangeEvents(fs, events.RemoteChangeDetected)
 }
 
+// recordTransfers adds the pulled items to the transfer totals. Invalid,
+// i.e. ignored or unsupported, items aren't counted.
+func (f *folder) recordTransfers(fs []protocol.FileInfo) {
+	var bytes int64
+	var files, deleted int
+	for _, file := range fs {
+		switch {
+		case file.IsInvalid():
+		case file.IsDeleted():
+			deleted++
+		case file.Type == protocol.FileInfoTypeFile:
+			files++
+			bytes += file.Size
+		}
+	}
+	if err := f.Pulled(bytes, files, deleted); err != nil {
+		l.Warnf("Folder %v: Failed to record transfer statistics: %v", f.Description(), err)
+	}
+}
+
 func (f *folder) updateLocals(fs []protocol.FileInfo) {
 	f.fset.Update(protocol.LocalDeviceID, fs)
 
@@ -1167,31 +2896,65 @@ func (f *folder) emitDiskChangeEvents(fs []protocol.FileInfo, typeOfEvent events
 		if file.IsInvalid() {
 			continue
 		}
+		f.emitDiskChangeEvent(file, typeOfEvent)
+	}
+}
 
-		objType := "file"
-		action := "modified"
+func (f *folder) emitDiskChangeEvent(file protocol.FileInfo, typeOfEvent events.EventType) {
+	objType := "file"
+	action := "modified"
 
-		if file.IsDeleted() {
-			action = "deleted"
-		}
+	if file.IsDeleted() {
+		action = "deleted"
+	}
 
-		if file.IsSymlink() {
-			objType = "symlink"
-		} else if file.IsDirectory() {
-			objType = "dir"
-		}
+	if file.IsSymlink() {
+		objType = "symlink"
+	} else if file.IsDirectory() {
+		objType = "dir"
+	}
 
-		// Two different events can be fired here based on what EventType is passed into function
-		f.evLogger.Log(typeOfEvent, map[string]string{
-			"folder":     f.ID,
-			"folderID":   f.ID, // incorrect, deprecated, kept for historical compliance
-			"label":      f.Label,
-			"action":     action,
-			"type":       objType,
-			"path":       filepath.FromSlash(file.Name),
-			"modifiedBy": file.ModifiedBy.String(),
-		})
+	// Two different events can be fired here based on what EventType is passed into function
+	f.evLogger.Log(typeOfEvent, map[string]string{
+		"folder":     f.ID,
+		"folderID":   f.ID, // incorrect, deprecated, kept for historical compliance
+		"label":      f.Label,
+		"action":     action,
+		"type":       objType,
+		"path":       filepath.FromSlash(file.Name),
+		"modifiedBy": file.ModifiedBy.String(),
+	})
+}
+
+// emitRenameEvent reports that old, now deleted, was found to be renamed
+// to file.
+func (f *folder) emitRenameEvent(old, file protocol.FileInfo) {
+	f.evLogger.Log(events.LocalRenameDetected, map[string]string{
+		"folder":     f.ID,
+		"label":      f.Label,
+		"oldPath":    filepath.FromSlash(old.Name),
+		"path":       filepath.FromSlash(file.Name),
+		"blocksHash": fmt.Sprintf("%x", file.BlocksHash),
+	})
+}
+
+// emitScanCompleted reports the statistics of a finished scan of the given
+// subdirectories, along with its error if any.
+func (f *folder) emitScanCompleted(subDirs []string, duration time.Duration, err error) {
+	data := map[string]interface{}{
+		"folder":      f.ID,
+		"label":       f.Label,
+		"subdirs":     subDirs,
+		"duration":    duration.Seconds(),
+		"files":       f.scanStats.files,
+		"hashedBytes": f.scanStats.hashedBytes,
+		"deleted":     f.scanStats.deleted,
+		"renamed":     f.scanStats.renamed,
 	}
+	if err != nil {
+		data["error"] = err.Error()
+	}
+	f.evLogger.Log(events.FolderScanCompleted, data)
 }
 
 func (f *folder) handleForcedRescans() error {
@@ -1234,7 +2997,59 @@ func (f *folder) handleForcedRescans() error {
 		return err
 	}
 
-	return f.scanSubdirs(paths)
+	return f.scanSubdirsWithOptions(coalesceForcedRescans(paths), scanOptions{force: true})
+}
+
+// Forced rescans of more siblings than this are done by scanning their
+// parent directory instead.
+const maxForcedRescanSiblings = 100
+
+// coalesceForcedRescans replaces paths that share their parent directory
+// with many others by that directory, and drops paths within others. It
+// returns nil if the entire folder should be scanned. The given slice is
+// modified.
+func coalesceForcedRescans(paths []string) []string {
+	siblings := make(map[string]int)
+	for _, path := range paths {
+		siblings[filepath.Dir(path)]++
+	}
+	coalesced := paths[:0]
+	for _, path := range paths {
+		parent := filepath.Dir(path)
+		switch n := siblings[parent]; {
+		case n <= maxForcedRescanSiblings:
+			coalesced = append(coalesced, path)
+		case n > 0:
+			if parent == "." {
+				return nil
+			}
+			coalesced = append(coalesced, parent)
+			siblings[parent] = 0 // only add the parent once
+		}
+	}
+	return unifySubs(coalesced, func(string) bool { return true })
+}
+
+// ChangesSince returns the local index entries with a sequence higher than
+// seq, ordered by sequence, and the current local sequence to pass next
+// time. The database only retains the latest entry per item, which is all
+// that's needed to catch up. If seq is ahead of the current sequence, the
+// local index was reset and ErrResyncRequired is returned, along with the
+// current sequence to resync from scratch with.
+func (f *folder) ChangesSince(seq int64) ([]protocol.FileInfo, int64, error) {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer snap.Release()
+	cur := snap.Sequence(protocol.LocalDeviceID)
+	if seq > cur {
+		return nil, cur, ErrResyncRequired
+	}
+	if seq < 0 {
+		seq = 0
+	}
+	return haveSince(snap, seq, 0), cur, nil
 }
 
 // dbSnapshots gets a snapshot from the fileset, and wraps any error
@@ -1289,3 +3104,39 @@ type cFiler struct {
 func (cf cFiler) CurrentFile(file string) (protocol.FileInfo, bool) {
 	return cf.Get(protocol.LocalDeviceID, file)
 }
+
+// dirHashStore gets directory listing hashes from the database, but keeps
+// new ones in memory until the scan's changes are in the database, such
+// that a failed scan doesn't skip the directories it didn't get to store.
+type dirHashStore struct {
+	kv      *db.NamespacedKV
+	scanned map[string][]byte
+}
+
+// Implements scanner.DirHashStore
+func (s dirHashStore) DirHash(name string) ([]byte, bool) {
+	hash, ok, err := s.kv.Bytes(name)
+	if err != nil {
+		l.Debugln("Getting directory hash:", err)
+		return nil, false
+	}
+	return hash, ok
+}
+
+func (s dirHashStore) SetDirHash(name string, hash []byte) {
+	s.scanned[name] = hash
+}
+
+// storeDirHashes stores the directory listing hashes of the completed scan.
+func (f *folder) storeDirHashes() {
+	if len(f.scannedDirHashes) == 0 {
+		return
+	}
+	kv := f.fset.DirHashes()
+	for name, hash := range f.scannedDirHashes {
+		if err := kv.PutBytes(name, hash); err != nil {
+			l.Debugln("Storing directory hash:", err)
+		}
+	}
+	f.scannedDirHashes = nil
+}
@@ -50,7 +50,8 @@ implemted in this file):
   but not propagated outwards (because receive only, right).
 
 Implementation wise a receiveOnlyFolder is just a sendReceiveFolder that
-sets an extra bit on local changes and has a Revert method.
+sets an extra bit on local changes and implements the revert used by the
+folder's Revert method.
 */
 type receiveOnlyFolder struct {
 	*sendReceiveFolder
@@ -59,11 +60,9 @@ type receiveOnlyFolder struct {
 func newReceiveOnlyFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg config.FolderConfiguration, ver versioner.Versioner, evLogger events.Logger, ioLimiter *byteSemaphore) service {
 	sr := newSendReceiveFolder(model, fset, ignores, cfg, ver, evLogger, ioLimiter).(*sendReceiveFolder)
 	sr.localFlags = protocol.FlagLocalReceiveOnly // gets propagated to the scanner, and set on locally changed files
-	return &receiveOnlyFolder{sr}
-}
-
-func (f *receiveOnlyFolder) Revert() {
-	f.doInSync(f.revert)
+	f := &receiveOnlyFolder{sr}
+	f.folder.reverter = f
+	return f
 }
 
 func (f *receiveOnlyFolder) revert() error {
@@ -84,6 +83,7 @@ func (f *receiveOnlyFolder) revert() error {
 
 	batch := make([]protocol.FileInfo, 0, maxBatchSizeFiles)
 	batchSizeBytes := 0
+	reverted := 0
 	snap, err := f.dbSnapshot()
 	if err != nil {
 		return err
@@ -126,6 +126,7 @@ func (f *receiveOnlyFolder) revert() error {
 
 		batch = append(batch, fi)
 		batchSizeBytes += fi.ProtoSize()
+		reverted++
 
 		if len(batch) >= maxBatchSizeFiles || batchSizeBytes >= maxBatchSizeBytes {
 			f.updateLocalsFromScanning(batch)
@@ -159,6 +160,13 @@ func (f *receiveOnlyFolder) revert() error {
 	if len(batch) > 0 {
 		f.updateLocalsFromScanning(batch)
 	}
+	reverted += len(deleted)
+
+	f.evLogger.Log(events.LocalChangesReverted, map[string]interface{}{
+		"folder": f.ID,
+		"label":  f.Label,
+		"items":  reverted,
+	})
 
 	// We will likely have changed our local index, but that won't trigger a
 	// pull by itself. Make sure we schedule one so that we start
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/scanner"
@@ -176,8 +177,19 @@ func TestRecvOnlyRevertNeeds(t *testing.T) {
 
 	// We hit the Revert button. The file that was new should become old.
 
+	sub := m.evLogger.Subscribe(events.LocalChangesReverted)
+	defer sub.Unsubscribe()
+
 	m.Revert("ro")
 
+	ev, err := sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal("Expected a revert event:", err)
+	}
+	if data := ev.Data.(map[string]interface{}); data["folder"] != "ro" || data["items"] != 1 {
+		t.Errorf("Unexpected event data %v", data)
+	}
+
 	size = globalSize(t, m, "ro")
 	if size.Files != 1 || size.Bytes != sizeOfDir+int64(len(oldData)) {
 		t.Fatalf("Global: expected the global size to revert: %+v", size)
@@ -486,3 +498,31 @@ func writeFile(fs fs.Filesystem, filename string, data []byte, perm fs.FileMode)
 	}
 	return fs.Chmod(filename, perm)
 }
+
+func TestRecvOnlyRevertInterval(t *testing.T) {
+	m, f, wcfgCancel := setupROFolder(t)
+	defer wcfgCancel()
+	defer cleanupModel(m)
+
+	fcfg := f.FolderConfiguration
+	fcfg.ReceiveOnlyRevertIntervalS = 1
+	setFolder(t, m.cfg, fcfg)
+	m.fmut.RLock()
+	f = m.folderRunners["ro"].(*receiveOnlyFolder)
+	m.fmut.RUnlock()
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "unknownFile", []byte("hello\n"), 0644))
+	must(t, m.ScanFolder("ro"))
+	if size := receiveOnlyChangedSize(t, m, "ro"); size.Files != 1 {
+		t.Fatalf("ROChanged: expected one file: %+v", size)
+	}
+
+	// The local change gets reverted without requesting it.
+	for start := time.Now(); time.Since(start) < 10*time.Second; time.Sleep(100 * time.Millisecond) {
+		if _, err := ffs.Stat("unknownFile"); fs.IsNotExist(err) {
+			return
+		}
+	}
+	t.Error("Expected the local change to be reverted")
+}
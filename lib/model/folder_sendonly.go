@@ -45,6 +45,7 @@ func (f *sendOnlyFolder) pull() (bool, error) {
 		return false, err
 	}
 	defer snap.Release()
+	ignoresHidden := f.hiddenIgnorer()
 	snap.WithNeed(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
 		if len(batch) == maxBatchSizeFiles || batchSizeBytes > maxBatchSizeBytes {
 			f.updateLocalsFromPulling(batch)
@@ -52,7 +53,7 @@ func (f *sendOnlyFolder) pull() (bool, error) {
 			batchSizeBytes = 0
 		}
 
-		if f.ignores.ShouldIgnore(intf.FileName()) {
+		if f.ignores.ShouldIgnore(intf.FileName()) || ignoresHidden(intf.FileName()) {
 			file := intf.(protocol.FileInfo)
 			file.SetIgnored()
 			batch = append(batch, file)
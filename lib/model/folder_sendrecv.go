@@ -8,12 +8,15 @@ package model
 
 import (
 	"bytes"
+	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"path/filepath"
 	"runtime"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -67,9 +70,13 @@ var (
 	errDirHasIgnored          = errors.New(errDirPrefix + "contains ignored files (see ignore documentation for (?d) prefix)")
 	errDirNotEmpty            = errors.New(errDirPrefix + "is not empty; the contents are probably ignored on that remote device, but not locally")
 	errNotAvailable           = errors.New("no connected device has the required version of this file")
+	errNoAllowedSource        = errors.New("no connected allowed source device has the required version of this file")
 	errModified               = errors.New("file modified but not rescanned; will try again later")
 	errUnexpectedDirOnFileDel = errors.New("encountered directory when trying to remove file/symlink")
 	errIncompatibleSymlink    = errors.New("incompatible symlink entry; rescan with newer Syncthing on source")
+	errContentRejected        = errors.New("content does not match any allowed signature")
+	errChronicConflictIgnored = errors.New("conflicts repeatedly and is now ignored")
+	errResolvedSymlink        = errors.New("is a symlink resolved by the folder's symlink policy and won't be replaced")
 	contextRemovingOldItem    = "removing item to be replaced"
 )
 
@@ -111,6 +118,10 @@ const (
 	defaultPullerPendingKiB = 2 * protocol.MaxBlockSize / 1024
 
 	maxPullerIterations = 3
+
+	// How often free space is checked against the data still to be
+	// written while pulling.
+	pendingSpaceInterval = 10 * time.Second
 )
 
 type dbUpdateJob struct {
@@ -125,7 +136,21 @@ type sendReceiveFolder struct {
 	blockPullReorderer blockPullReorderer
 	writeLimiter       *byteSemaphore
 
-	tempPullErrors map[string]string // pull errors that might be just transient
+	tempPullErrors        map[string]string // pull errors that might be just transient
+	tempPullErrorsDropped int
+
+	modTimeMismatchWarned int32 // accessed atomically
+
+	contentSignatures [][]byte
+	rejectedContent   map[string]rejectedContent // file name -> rejection
+	rejectedMut       sync.Mutex
+
+	reserved    map[*sharedPullerState]struct{} // files being pulled, which still need space
+	reservedMut sync.Mutex
+
+	pullConcurrency *pullConcurrency // nil unless AdaptivePullConcurrency
+
+	conflictNames config.ConflictNameTemplate
 }
 
 func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg config.FolderConfiguration, ver versioner.Versioner, evLogger events.Logger, ioLimiter *byteSemaphore) service {
@@ -134,6 +159,12 @@ func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matche
 		queue:              newJobQueue(),
 		blockPullReorderer: newBlockPullReorderer(cfg.BlockPullOrder, model.id, cfg.DeviceIDs()),
 		writeLimiter:       newByteSemaphore(cfg.MaxConcurrentWrites),
+		contentSignatures:  parseContentSignatures(cfg),
+		rejectedContent:    make(map[string]rejectedContent),
+		rejectedMut:        sync.NewMutex(),
+		reserved:           make(map[*sharedPullerState]struct{}),
+		reservedMut:        sync.NewMutex(),
+		conflictNames:      cfg.ConflictNames(),
 	}
 	f.folder.puller = f
 
@@ -151,9 +182,24 @@ func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matche
 		f.PullerMaxPendingKiB = blockSizeKiB
 	}
 
+	if f.AdaptivePullConcurrency {
+		f.pullConcurrency = newPullConcurrency(f.PullerMaxPendingKiB*1024, f.AdaptivePullMaxKiB*1024)
+	}
+
 	return f
 }
 
+// PullConcurrency returns the current amount of pending block requests.
+func (f *sendReceiveFolder) PullConcurrency() PullConcurrency {
+	if f.pullConcurrency == nil {
+		return PullConcurrency{
+			PendingKiB:    f.PullerMaxPendingKiB,
+			MaxPendingKiB: f.PullerMaxPendingKiB,
+		}
+	}
+	return f.pullConcurrency.status()
+}
+
 // pull returns true if it manages to get all needed items from peers, i.e. get
 // the device in sync with the global state.
 func (f *sendReceiveFolder) pull() (bool, error) {
@@ -171,6 +217,8 @@ func (f *sendReceiveFolder) pull() (bool, error) {
 
 	f.errorsMut.Lock()
 	f.pullErrors = nil
+	f.pullErrorsDropped = 0
+	f.persistFileErrorsLocked()
 	f.errorsMut.Unlock()
 
 	var err error
@@ -213,6 +261,9 @@ func (f *sendReceiveFolder) pull() (bool, error) {
 		}
 		f.tempPullErrors = nil
 	}
+	f.pullErrorsDropped = f.tempPullErrorsDropped
+	pullErrNum += f.tempPullErrorsDropped
+	f.persistFileErrorsLocked()
 	f.errorsMut.Unlock()
 
 	if pullErrNum > 0 {
@@ -233,6 +284,7 @@ func (f *sendReceiveFolder) pull() (bool, error) {
 func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error) {
 	f.errorsMut.Lock()
 	f.tempPullErrors = make(map[string]string)
+	f.tempPullErrorsDropped = 0
 	f.errorsMut.Unlock()
 
 	snap, err := f.dbSnapshot()
@@ -283,6 +335,10 @@ func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error)
 		doneWg.Done()
 	}()
 
+	spaceCtx, spaceCancel := context.WithCancel(f.ctx)
+	spaceErr := make(chan error, 1)
+	go f.pendingSpaceRoutine(spaceCtx, spaceErr)
+
 	changed, fileDeletions, dirDeletions, err := f.processNeeded(snap, dbUpdateChan, copyChan, scanChan)
 
 	// Signal copy and puller routines that we are done with the in data for
@@ -297,8 +353,17 @@ func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error)
 	close(finisherChan)
 	doneWg.Wait()
 
+	spaceCancel()
+	if err == nil {
+		select {
+		case err = <-spaceErr:
+		default:
+		}
+	}
+
 	if err == nil {
 		f.processDeletions(fileDeletions, dirDeletions, snap, dbUpdateChan, scanChan)
+		f.pruneRejected(snap)
 	}
 
 	// Wait for db updates and scan scheduling to complete
@@ -315,6 +380,7 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 	var dirDeletions []protocol.FileInfo
 	fileDeletions := map[string]protocol.FileInfo{}
 	buckets := map[string][]protocol.FileInfo{}
+	ignoresHidden := f.hiddenIgnorer()
 
 	// Iterate the list of items that we need and sort them into piles.
 	// Regular files to pull goes into the file queue, everything else
@@ -331,13 +397,17 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 			l.Debugln(f, "ignore file deletion (config)", intf.FileName())
 			return true
 		}
+		if f.PullDeletionsOnly && !intf.IsDeleted() {
+			l.Debugln(f, "ignore file change (config)", intf.FileName())
+			return true
+		}
 
 		changed++
 
 		file := intf.(protocol.FileInfo)
 
 		switch {
-		case f.ignores.ShouldIgnore(file.Name):
+		case f.ignores.ShouldIgnore(file.Name), ignoresHidden(file.Name):
 			file.SetIgnored()
 			l.Debugln(f, "Handling ignored file", file)
 			dbUpdateChan <- dbUpdateJob{file, dbUpdateInvalidate}
@@ -387,7 +457,12 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 
 		case file.Type == protocol.FileInfoTypeFile:
 			curFile, hasCurFile := snap.Get(protocol.LocalDeviceID, file.Name)
-			if hasCurFile && file.BlocksEqual(curFile) {
+			if err := f.wasRejected(file); err != nil {
+				// Don't download content again that we already refused.
+				f.newPullError(file.Name, err)
+				// No reason to retry for this
+				changed--
+			} else if hasCurFile && file.BlocksEqual(curFile) {
 				// We are supposed to copy the entire file, and then fetch nothing. We
 				// are only updating metadata, so we don't actually *need* to make the
 				// copy.
@@ -397,7 +472,7 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 				f.queue.Push(file.Name, file.Size, file.ModTime())
 			}
 
-		case runtime.GOOS == "windows" && file.IsSymlink():
+		case (runtime.GOOS == "windows" || f.SymlinkPolicy == config.SymlinkPolicyIgnore) && file.IsSymlink():
 			if err := f.handleSymlinkCheckExisting(file, snap, scanChan); err != nil {
 				f.newPullError(file.Name, fmt.Errorf("handling unsupported symlink: %w", err))
 				break
@@ -505,6 +580,11 @@ nextFile:
 		}
 
 		devices := snap.Availability(fileName)
+		if f.holdWithoutSource(devices) {
+			f.newPullError(fileName, errNoAllowedSource)
+			f.queue.Done(fileName)
+			continue
+		}
 		for _, dev := range devices {
 			if _, ok := f.model.Connection(dev); ok {
 				// Handle the file normally, by coping and pulling, etc.
@@ -519,6 +599,29 @@ nextFile:
 	return changed, fileDeletions, dirDeletions, nil
 }
 
+// holdWithoutSource returns true if the folder holds files until an allowed
+// source has them and no connected one out of the given devices is.
+func (f *sendReceiveFolder) holdWithoutSource(devices []protocol.DeviceID) bool {
+	if f.SourceFallback != config.SourceFallbackHold {
+		return false
+	}
+	sources := f.AllowedSources()
+	if len(sources) == 0 {
+		return false
+	}
+	for _, dev := range devices {
+		for _, source := range sources {
+			if dev != source {
+				continue
+			}
+			if _, ok := f.model.Connection(dev); ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func popCandidate(buckets map[string][]protocol.FileInfo, key string) (protocol.FileInfo, bool) {
 	cands := buckets[key]
 	if len(cands) == 0 {
@@ -1224,6 +1327,7 @@ func (f *sendReceiveFolder) shortcutFile(file, curFile protocol.FileInfo, dbUpda
 	}
 
 	f.mtimefs.Chtimes(file.Name, file.ModTime(), file.ModTime()) // never fails
+	f.verifyModTime(file)
 
 	dbUpdateChan <- dbUpdateJob{file, dbUpdateShortcutFile}
 }
@@ -1247,7 +1351,7 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 	}
 
 	for state := range in {
-		if err := f.CheckAvailableSpace(uint64(state.file.Size)); err != nil {
+		if err := f.reserveSpace(state.sharedPullerState); err != nil {
 			state.fail(err)
 			// Nothing more to do for this failed file, since it would use to much disk space
 			out <- state.sharedPullerState
@@ -1440,7 +1544,12 @@ func (f *sendReceiveFolder) verifyBuffer(buf []byte, block protocol.BlockInfo) e
 }
 
 func (f *sendReceiveFolder) pullerRoutine(snap *db.Snapshot, in <-chan pullBlockState, out chan<- *sharedPullerState) {
-	requestLimiter := newByteSemaphore(f.PullerMaxPendingKiB * 1024)
+	var requestLimiter *byteSemaphore
+	if f.pullConcurrency != nil {
+		requestLimiter = f.pullConcurrency.newLimiter()
+	} else {
+		requestLimiter = newByteSemaphore(f.PullerMaxPendingKiB * 1024)
+	}
 	wg := sync.NewWaitGroup()
 
 	for state := range in {
@@ -1525,8 +1634,13 @@ loop:
 		activity.using(selected)
 		var buf []byte
 		blockNo := int(state.block.Offset / int64(state.file.BlockSize()))
+		requested := time.Now()
 		buf, lastError = f.model.requestGlobal(f.ctx, selected.ID, f.folderID, state.file.Name, blockNo, state.block.Offset, int(state.block.Size), state.block.Hash, state.block.WeakHash, selected.FromTemporary)
 		activity.done(selected)
+		if f.pullConcurrency != nil {
+			now := time.Now()
+			f.pullConcurrency.requestDone(int(state.block.Size), now.Sub(requested), lastError, now)
+		}
 		if lastError != nil {
 			l.Debugln("request:", f.folderID, state.file.Name, state.block.Offset, state.block.Size, "returned error:", lastError)
 			continue
@@ -1551,6 +1665,8 @@ loop:
 		if err != nil {
 			state.fail(errors.Wrap(err, "save"))
 		} else {
+			atomic.AddInt64(&f.pulledBytes, int64(len(buf)))
+			state.pulledFrom(selected.ID)
 			state.pullDone(state.block)
 		}
 		break
@@ -1559,6 +1675,10 @@ loop:
 }
 
 func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCurFile bool, tempName string, snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) error {
+	if err := f.checkContentSignature(file, tempName); err != nil {
+		return err
+	}
+
 	// Set the correct permission bits on the new file
 	if !f.IgnorePerms && !file.NoPermissions {
 		if err := f.mtimefs.Chmod(tempName, fs.FileMode(file.Permissions&0777)); err != nil {
@@ -1606,50 +1726,323 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 
 	// Set the correct timestamp on the new file
 	f.mtimefs.Chtimes(file.Name, file.ModTime(), file.ModTime()) // never fails
+	f.verifyModTime(file)
 
 	// Record the updated file in the index
 	dbUpdateChan <- dbUpdateJob{file, dbUpdateHandleFile}
 	return nil
 }
 
-func (f *sendReceiveFolder) finisherRoutine(snap *db.Snapshot, in <-chan *sharedPullerState, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) {
-	for state := range in {
-		if closed, err := state.finalClose(); closed {
-			l.Debugln(f, "closing", state.file.Name)
+// parseContentSignatures decodes the configured hex encoded signatures,
+// skipping invalid ones.
+func parseContentSignatures(cfg config.FolderConfiguration) [][]byte {
+	var sigs [][]byte
+	for _, s := range cfg.AllowedContentSignatures {
+		sig, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+		if err != nil || len(sig) == 0 {
+			l.Warnf("Folder %v: Ignoring invalid content signature %q", cfg.Description(), s)
+			continue
+		}
+		sigs = append(sigs, sig)
+	}
+	return sigs
+}
 
-			f.queue.Done(state.file.Name)
+// checkContentSignature refuses the pulled file if signatures are
+// configured and the leading bytes of the temporary file match none of
+// them. Blocks are written to the temporary file in arbitrary order while
+// pulling, so the check can only happen once the file is complete, before
+// it replaces anything. A refused temporary file is removed and its content
+// remembered, such that it isn't downloaded again.
+func (f *sendReceiveFolder) checkContentSignature(file protocol.FileInfo, tempName string) error {
+	if len(f.contentSignatures) == 0 {
+		return nil
+	}
 
-			if err == nil {
-				err = f.performFinish(state.file, state.curFile, state.hasCurFile, state.tempName, snap, dbUpdateChan, scanChan)
-			}
+	maxLen := 0
+	for _, sig := range f.contentSignatures {
+		if len(sig) > maxLen {
+			maxLen = len(sig)
+		}
+	}
+	fd, err := f.mtimefs.Open(tempName)
+	if err != nil {
+		return err
+	}
+	head := make([]byte, maxLen)
+	n, err := io.ReadFull(fd, head)
+	fd.Close()
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	head = head[:n]
 
-			if err != nil {
-				f.newPullError(state.file.Name, err)
-			} else {
-				minBlocksPerBlock := state.file.BlockSize() / protocol.MinBlockSize
-				blockStatsMut.Lock()
-				blockStats["total"] += (state.reused + state.copyTotal + state.pullTotal) * minBlocksPerBlock
-				blockStats["reused"] += state.reused * minBlocksPerBlock
-				blockStats["pulled"] += state.pullTotal * minBlocksPerBlock
-				// copyOriginShifted is counted towards copyOrigin due to progress bar reasons
-				// for reporting reasons we want to separate these.
-				blockStats["copyOrigin"] += (state.copyOrigin - state.copyOriginShifted) * minBlocksPerBlock
-				blockStats["copyOriginShifted"] += state.copyOriginShifted * minBlocksPerBlock
-				blockStats["copyElsewhere"] += (state.copyTotal - state.copyOrigin) * minBlocksPerBlock
-				blockStatsMut.Unlock()
-			}
+	for _, sig := range f.contentSignatures {
+		if bytes.HasPrefix(head, sig) {
+			return nil
+		}
+	}
 
-			if f.Type != config.FolderTypeReceiveEncrypted {
-				f.model.progressEmitter.Deregister(state)
+	l.Infof("Folder %v: Refusing %v: %v", f.Description(), file.Name, errContentRejected)
+	f.mtimefs.Remove(tempName)
+	f.rejectContent(file, errContentRejected)
+	f.evLogger.Log(events.ItemRejected, map[string]string{
+		"folder": f.folderID,
+		"item":   file.Name,
+		"reason": errContentRejected.Error(),
+	})
+	return errContentRejected
+}
+
+type rejectedContent struct {
+	blocksHash []byte
+	reason     error
+}
+
+// rejectContent remembers that the content of the given file was refused,
+// such that it isn't downloaded again.
+func (f *sendReceiveFolder) rejectContent(file protocol.FileInfo, reason error) {
+	f.rejectedMut.Lock()
+	f.rejectedContent[file.Name] = rejectedContent{file.BlocksHash, reason}
+	f.rejectedMut.Unlock()
+}
+
+// wasRejected returns the reason if the content of the given file was
+// refused before, nil otherwise.
+func (f *sendReceiveFolder) wasRejected(file protocol.FileInfo) error {
+	f.rejectedMut.Lock()
+	defer f.rejectedMut.Unlock()
+	rejected, ok := f.rejectedContent[file.Name]
+	if !ok {
+		return nil
+	}
+	if len(rejected.blocksHash) == 0 || !bytes.Equal(rejected.blocksHash, file.BlocksHash) {
+		// Content changed (or can't tell), give it another chance.
+		delete(f.rejectedContent, file.Name)
+		return nil
+	}
+	return rejected.reason
+}
+
+// pruneRejected forgets about rejected content that isn't needed anymore,
+// as the file was deleted, changed or became ignored.
+func (f *sendReceiveFolder) pruneRejected(snap *db.Snapshot) {
+	f.rejectedMut.Lock()
+	defer f.rejectedMut.Unlock()
+	for name, rejected := range f.rejectedContent {
+		global, ok := snap.GetGlobal(name)
+		if !ok || global.IsDeleted() || !bytes.Equal(global.BlocksHash, rejected.blocksHash) || f.ignores.ShouldIgnore(name) {
+			delete(f.rejectedContent, name)
+		}
+	}
+}
+
+// verifyModTime checks, if enabled, that the modification time of the given
+// item as seen through the mtime filesystem is the one we just set. On a
+// mismatch it is set again to refresh the stored mapping between real and
+// virtual modification time. If that doesn't help either, the filesystem
+// is lossy in a way we can't compensate for and we warn about it once.
+func (f *sendReceiveFolder) verifyModTime(file protocol.FileInfo) {
+	if !f.VerifyModTimes {
+		return
+	}
+	for repaired := false; ; repaired = true {
+		info, err := f.mtimefs.Lstat(file.Name)
+		if err != nil {
+			l.Debugf("%v verifying modification time of %v: %v", f, file.Name, err)
+			return
+		}
+		if protocol.ModTimeEqual(info.ModTime(), file.ModTime(), f.modTimeWindow) {
+			return
+		}
+		if repaired {
+			break
+		}
+		l.Debugf("%v modification time of %v is %v instead of %v, setting it again", f, file.Name, info.ModTime(), file.ModTime())
+		f.mtimefs.Chtimes(file.Name, file.ModTime(), file.ModTime()) // never fails
+	}
+	if atomic.CompareAndSwapInt32(&f.modTimeMismatchWarned, 0, 1) {
+		l.Warnf("Folder %v: Modification times do not persist on this filesystem (first seen on %v), which may cause repeated rescans. Consider setting modTimeWindowS.", f.Description(), file.Name)
+	} else {
+		l.Debugf("%v modification time of %v still differs after setting it again", f, file.Name)
+	}
+}
+
+func (f *sendReceiveFolder) finisherRoutine(snap *db.Snapshot, in <-chan *sharedPullerState, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) {
+	// Pulled files are validated concurrently, if configured, and then
+	// finished here. While a file waits for a free validation routine, no
+	// further files are taken in.
+	var toValidate chan *sharedPullerState
+	validated := make(chan validatedState)
+	if f.PostPullValidationCommand != "" {
+		toValidate = make(chan *sharedPullerState)
+		defer close(toValidate)
+		for i := 0; i < maxConcurrentValidations; i++ {
+			go f.validationRoutine(toValidate, validated)
+		}
+	}
+
+	var waiting *sharedPullerState
+	validating := 0
+	for in != nil || waiting != nil || validating > 0 {
+		inChan, validateChan := in, toValidate
+		if waiting != nil {
+			inChan = nil
+		} else {
+			validateChan = nil
+		}
+
+		select {
+		case state, ok := <-inChan:
+			if !ok {
+				in = nil
+				break
 			}
+			closed, err := state.finalClose()
+			if !closed {
+				break
+			}
+			if err == nil && toValidate != nil {
+				waiting = state
+				break
+			}
+			f.finishState(state, err, snap, dbUpdateChan, scanChan)
 
-			f.evLogger.Log(events.ItemFinished, map[string]interface{}{
-				"folder": f.folderID,
-				"item":   state.file.Name,
-				"error":  events.Error(err),
-				"type":   "file",
-				"action": "update",
-			})
+		case validateChan <- waiting:
+			waiting = nil
+			validating++
+
+		case v := <-validated:
+			validating--
+			f.finishState(v.state, v.err, snap, dbUpdateChan, scanChan)
+		}
+	}
+}
+
+// removeUnusableTemp removes the temporary file of a failed pull, if it
+// doesn't contain any blocks to reuse on the next attempt.
+func (f *sendReceiveFolder) removeUnusableTemp(state *sharedPullerState) {
+	if len(state.Available()) > 0 {
+		return
+	}
+	if err := f.inWritableDir(f.mtimefs.Remove, state.tempName); err != nil && !fs.IsNotExist(err) {
+		l.Debugf("%v failed to remove unusable temporary file %v: %v", f, state.tempName, err)
+	}
+}
+
+func (f *sendReceiveFolder) finishState(state *sharedPullerState, err error, snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) {
+	l.Debugln(f, "closing", state.file.Name)
+
+	f.releaseSpace(state)
+
+	f.queue.Done(state.file.Name)
+
+	if err == nil {
+		err = f.performFinish(state.file, state.curFile, state.hasCurFile, state.tempName, snap, dbUpdateChan, scanChan)
+	}
+
+	if err != nil {
+		f.newPullError(state.file.Name, err)
+		f.removeUnusableTemp(state)
+	} else {
+		minBlocksPerBlock := state.file.BlockSize() / protocol.MinBlockSize
+		blockStatsMut.Lock()
+		blockStats["total"] += (state.reused + state.copyTotal + state.pullTotal) * minBlocksPerBlock
+		blockStats["reused"] += state.reused * minBlocksPerBlock
+		blockStats["pulled"] += state.pullTotal * minBlocksPerBlock
+		// copyOriginShifted is counted towards copyOrigin due to progress bar reasons
+		// for reporting reasons we want to separate these.
+		blockStats["copyOrigin"] += (state.copyOrigin - state.copyOriginShifted) * minBlocksPerBlock
+		blockStats["copyOriginShifted"] += state.copyOriginShifted * minBlocksPerBlock
+		blockStats["copyElsewhere"] += (state.copyTotal - state.copyOrigin) * minBlocksPerBlock
+		blockStatsMut.Unlock()
+	}
+
+	if f.Type != config.FolderTypeReceiveEncrypted {
+		f.model.progressEmitter.Deregister(state)
+	}
+
+	f.evLogger.Log(events.ItemFinished, map[string]interface{}{
+		"folder": f.folderID,
+		"item":   state.file.Name,
+		"error":  events.Error(err),
+		"type":   "file",
+		"action": "update",
+	})
+}
+
+// reserveSpace checks that there is enough free space for the given file in
+// addition to all the files currently being pulled, and if so reserves it
+// until the file is finished. Thus running out of space is noticed before
+// actually writing, even with several files being pulled concurrently. Only
+// the data not yet written counts, as the rest already takes up space.
+func (f *sendReceiveFolder) reserveSpace(state *sharedPullerState) error {
+	f.reservedMut.Lock()
+	defer f.reservedMut.Unlock()
+	pending := f.pendingSpaceLocked()
+	if err := f.CheckAvailableSpace(pending + state.pendingBytes()); err != nil {
+		if pending > 0 {
+			return fmt.Errorf("pulling would exceed free space, with %d bytes of other files in progress: %w", pending, err)
+		}
+		return err
+	}
+	f.reserved[state] = struct{}{}
+	return nil
+}
+
+func (f *sendReceiveFolder) releaseSpace(state *sharedPullerState) {
+	f.reservedMut.Lock()
+	delete(f.reserved, state)
+	f.reservedMut.Unlock()
+}
+
+// pendingSpaceLocked returns the amount of data still to be written by the
+// files being pulled.
+func (f *sendReceiveFolder) pendingSpaceLocked() uint64 {
+	var pending uint64
+	for state := range f.reserved {
+		pending += state.pendingBytes()
+	}
+	return pending
+}
+
+// checkPendingSpace fails all files being pulled if the data they still
+// have to write doesn't fit into the free space anymore, e.g. as something
+// else is filling the disk. Their temporary files are kept for the next
+// attempt.
+func (f *sendReceiveFolder) checkPendingSpace() error {
+	f.reservedMut.Lock()
+	defer f.reservedMut.Unlock()
+	if len(f.reserved) == 0 {
+		return nil
+	}
+	pending := f.pendingSpaceLocked()
+	if err := f.CheckAvailableSpace(pending); err != nil {
+		err = fmt.Errorf("pulling paused as the %d bytes still to be written would exceed free space: %w", pending, err)
+		for state := range f.reserved {
+			state.fail(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// pendingSpaceRoutine periodically checks the free space while pulling,
+// until ctx is cancelled. The first error is sent to errChan, after which
+// the routine stops.
+func (f *sendReceiveFolder) pendingSpaceRoutine(ctx context.Context, errChan chan<- error) {
+	ticker := time.NewTicker(pendingSpaceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := f.checkPendingSpace(); err != nil {
+				l.Infof("Folder %v: %v", f.Description(), err)
+				errChan <- err
+				return
+			}
+		case <-ctx.Done():
+			return
 		}
 	}
 }
@@ -1794,7 +2187,7 @@ func removeAvailability(availabilities []Availability, availability Availability
 }
 
 func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan chan<- string) error {
-	if isConflict(name) {
+	if f.conflictNames.IsConflict(name) {
 		l.Infoln("Conflict for", name, "which is already a conflict copy; not copying again.")
 		if err := f.mtimefs.Remove(name); err != nil && !fs.IsNotExist(err) {
 			return errors.Wrap(err, contextRemovingOldItem)
@@ -1802,6 +2195,10 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 		return nil
 	}
 
+	if handled, err := f.handleChronicConflict(name); handled {
+		return err
+	}
+
 	if f.MaxConflicts == 0 {
 		if err := f.mtimefs.Remove(name); err != nil && !fs.IsNotExist(err) {
 			return errors.Wrap(err, contextRemovingOldItem)
@@ -1809,7 +2206,7 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 		return nil
 	}
 
-	newName := conflictName(name, lastModBy)
+	newName := f.conflictNames.Name(name, lastModBy, time.Now())
 	err := f.mtimefs.Rename(name, newName)
 	if fs.IsNotExist(err) {
 		// We were supposed to move a file away but it does not exist. Either
@@ -1819,7 +2216,7 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 		err = nil
 	}
 	if f.MaxConflicts > -1 {
-		matches := existingConflicts(name, f.mtimefs)
+		matches := f.existingConflicts(name)
 		if len(matches) > f.MaxConflicts {
 			sort.Sort(sort.Reverse(sort.StringSlice(matches)))
 			for _, match := range matches[f.MaxConflicts:] {
@@ -1835,6 +2232,74 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 	return err
 }
 
+// handleChronicConflict records a conflict for the given file and, if the
+// file conflicted more often than the configured threshold, applies the
+// configured chronic conflict action. It returns true if the conflict was
+// taken care of and no conflict copy should be made.
+func (f *sendReceiveFolder) handleChronicConflict(name string) (bool, error) {
+	if f.ChronicConflictThreshold <= 0 {
+		return false, nil
+	}
+
+	conflicts, err := f.conflicts.record(name, time.Now(), f.ChronicConflictWindow())
+	if err != nil {
+		l.Debugln(f, "recording conflict", name, err)
+		return false, nil
+	}
+	if conflicts <= int(f.ChronicConflictThreshold) {
+		return false, nil
+	}
+
+	l.Warnf("Folder %v: %v conflicted %d times within %v (%v)", f.Description(), name, conflicts, f.ChronicConflictWindow(), f.ChronicConflictAction)
+	f.evLogger.Log(events.ChronicConflictDetected, map[string]interface{}{
+		"folder":    f.folderID,
+		"item":      name,
+		"conflicts": conflicts,
+		"action":    f.ChronicConflictAction.String(),
+	})
+
+	switch f.ChronicConflictAction {
+	case config.ChronicConflictActionLastWriterWins:
+		// The incoming file is the winner, get rid of ours without
+		// keeping a conflict copy.
+		var err error
+		if f.versioner != nil {
+			err = f.versioner.Archive(name)
+		} else {
+			err = f.mtimefs.Remove(name)
+		}
+		if err != nil && !fs.IsNotExist(err) {
+			return true, errors.Wrap(err, contextRemovingOldItem)
+		}
+		return true, nil
+
+	case config.ChronicConflictActionIgnore:
+		if err := f.ignoreChronicConflict(name); err != nil {
+			return true, errors.Wrap(err, "ignoring chronic conflict")
+		}
+		return true, errChronicConflictIgnored
+	}
+
+	return false, nil
+}
+
+// ignoreChronicConflict adds an ignore pattern for the given file to the
+// folder's .stignore and reloads the ignores.
+func (f *sendReceiveFolder) ignoreChronicConflict(name string) error {
+	pattern := ignorePatternFor(name)
+	lines := f.ignores.Lines()
+	for _, line := range lines {
+		if line == pattern {
+			return nil
+		}
+	}
+	lines = append(append([]string{}, lines...), pattern)
+	if err := ignore.WriteIgnores(f.Filesystem(), ".stignore", lines); err != nil {
+		return err
+	}
+	return f.ignores.Load(".stignore")
+}
+
 func (f *sendReceiveFolder) newPullError(path string, err error) {
 	if errors.Cause(err) == f.ctx.Err() {
 		// Error because the folder stopped - no point logging/tracking
@@ -1851,6 +2316,12 @@ func (f *sendReceiveFolder) newPullError(path string, err error) {
 		return
 	}
 
+	if f.MaxFileErrors > 0 && len(f.tempPullErrors) >= f.MaxFileErrors {
+		f.tempPullErrorsDropped++
+		l.Debugf("%v dropping error for %v: %v", f, path, err)
+		return
+	}
+
 	// Establish context to differentiate from errors while scanning.
 	// Use "syncing" as opposed to "pulling" as the latter might be used
 	// for errors occurring specificly in the puller routine.
@@ -2019,6 +2490,13 @@ func (f *sendReceiveFolder) scanIfItemChanged(name string, stat fs.FileInfo, ite
 		return errModified
 	}
 
+	if stat.IsSymlink() && !item.IsSymlink() && f.SymlinkPolicy == config.SymlinkPolicyResolve {
+		// The item was scanned as the file the symlink points to.
+		// Replacing or removing it would destroy the link, so leave it
+		// alone and let the user decide.
+		return errResolvedSymlink
+	}
+
 	// Check that the item on disk is what we expect it to be according
 	// to the database. If there's a mismatch here, there might be local
 	// changes that we don't know about yet and we should scan before
@@ -2104,6 +2582,9 @@ func (f *sendReceiveFolder) withLimiter(fn func() error) error {
 type FileError struct {
 	Path string `json:"path"`
 	Err  string `json:"error"`
+	// Stale is set on errors from before a restart, until the next scan
+	// respectively pull replaces them.
+	Stale bool `json:"stale,omitempty"`
 }
 
 type fileErrorList []FileError
@@ -2120,18 +2601,8 @@ func (l fileErrorList) Swap(a, b int) {
 	l[a], l[b] = l[b], l[a]
 }
 
-func conflictName(name, lastModBy string) string {
-	ext := filepath.Ext(name)
-	return name[:len(name)-len(ext)] + time.Now().Format(".sync-conflict-20060102-150405-") + lastModBy + ext
-}
-
-func isConflict(name string) bool {
-	return strings.Contains(filepath.Base(name), ".sync-conflict-")
-}
-
-func existingConflicts(name string, fs fs.Filesystem) []string {
-	ext := filepath.Ext(name)
-	matches, err := fs.Glob(name[:len(name)-len(ext)] + ".sync-conflict-????????-??????*" + ext)
+func (f *sendReceiveFolder) existingConflicts(name string) []string {
+	matches, err := f.mtimefs.Glob(f.conflictNames.Glob(name))
 	if err != nil {
 		l.Debugln("globbing for conflicts", err)
 	}
@@ -18,9 +18,12 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
@@ -928,7 +931,7 @@ func TestSRConflictReplaceFileByDir(t *testing.T) {
 
 	f.handleDir(file, fsetSnapshot(t, f.fset), dbUpdateChan, scanChan)
 
-	if confls := existingConflicts(name, ffs); len(confls) != 1 {
+	if confls := f.existingConflicts(name); len(confls) != 1 {
 		t.Fatal("Expected one conflict, got", len(confls))
 	} else if scan := <-scanChan; confls[0] != scan {
 		t.Fatal("Expected request to scan", confls[0], "got", scan)
@@ -961,7 +964,7 @@ func TestSRConflictReplaceFileByLink(t *testing.T) {
 
 	f.handleSymlink(file, fsetSnapshot(t, f.fset), dbUpdateChan, scanChan)
 
-	if confls := existingConflicts(name, ffs); len(confls) != 1 {
+	if confls := f.existingConflicts(name); len(confls) != 1 {
 		t.Fatal("Expected one conflict, got", len(confls))
 	} else if scan := <-scanChan; confls[0] != scan {
 		t.Fatal("Expected request to scan", confls[0], "got", scan)
@@ -1378,6 +1381,102 @@ func TestPullDeleteIgnoreChildDir(t *testing.T) {
 	}
 }
 
+// lossyModTimeFS reports a fixed modification time for every item,
+// regardless of what was set.
+type lossyModTimeFS struct {
+	fs.Filesystem
+}
+
+type lossyModTimeFileInfo struct {
+	fs.FileInfo
+}
+
+func (fi lossyModTimeFileInfo) ModTime() time.Time {
+	return time.Unix(1234567890, 0)
+}
+
+func (lfs lossyModTimeFS) Lstat(name string) (fs.FileInfo, error) {
+	info, err := lfs.Filesystem.Lstat(name)
+	if err != nil {
+		return nil, err
+	}
+	return lossyModTimeFileInfo{info}, nil
+}
+
+func TestVerifyModTime(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.VerifyModTimes = true
+
+	name := "file"
+	must(t, writeFile(f.mtimefs, name, []byte("contents"), 0644))
+	file := protocol.FileInfo{Name: name, ModifiedS: time.Now().Add(-time.Hour).Unix()}
+
+	// The mtime filesystem keeps track of the modification time even if
+	// it didn't stick on disk.
+	must(t, f.mtimefs.Chtimes(name, file.ModTime(), file.ModTime()))
+	f.verifyModTime(file)
+	if atomic.LoadInt32(&f.modTimeMismatchWarned) != 0 {
+		t.Error("Unexpected warning about modification time mismatch")
+	}
+
+	f.mtimefs = lossyModTimeFS{f.mtimefs}
+	f.verifyModTime(file)
+	if atomic.LoadInt32(&f.modTimeMismatchWarned) != 1 {
+		t.Error("Expected a warning about modification time mismatch")
+	}
+}
+
+func TestContentSignatureRejection(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.contentSignatures = parseContentSignatures(config.FolderConfiguration{
+		AllowedContentSignatures: []string{"89 50 4e 47", "not hex"},
+	})
+	if len(f.contentSignatures) != 1 {
+		t.Fatal("Expected exactly one valid signature, got", len(f.contentSignatures))
+	}
+
+	file := protocol.FileInfo{Name: "image", BlocksHash: []byte("hash")}
+	temp := fs.TempName(file.Name)
+
+	must(t, writeFile(f.mtimefs, temp, []byte("\x89PNG\r\n"), 0644))
+	if err := f.checkContentSignature(file, temp); err != nil {
+		t.Error("Unexpected error for matching content:", err)
+	}
+
+	must(t, writeFile(f.mtimefs, temp, []byte("GIF89a"), 0644))
+	if err := f.checkContentSignature(file, temp); err != errContentRejected {
+		t.Error("Expected content to be rejected, got", err)
+	}
+	if _, err := f.mtimefs.Lstat(temp); !fs.IsNotExist(err) {
+		t.Error("Expected temporary file to be removed, got", err)
+	}
+	if f.wasRejected(file) != errContentRejected {
+		t.Error("Expected rejected content to be remembered")
+	}
+
+	file.BlocksHash = []byte("other")
+	if f.wasRejected(file) != nil {
+		t.Error("Changed content shouldn't be considered rejected")
+	}
+}
+
+func TestPruneRejected(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	file := protocol.FileInfo{Name: "gone", BlocksHash: []byte("hash")}
+	f.rejectContent(file, errContentRejected)
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	f.pruneRejected(snap)
+	if len(f.rejectedContent) != 0 {
+		t.Error("Expected rejected content of a file that isn't needed anymore to be forgotten")
+	}
+}
+
 func cleanupSharedPullerState(s *sharedPullerState) {
 	s.mut.Lock()
 	defer s.mut.Unlock()
@@ -1399,3 +1498,1494 @@ func startCopier(f *sendReceiveFolder, pullChan chan<- pullBlockState, finisherC
 	}()
 	return copyChan, wg
 }
+
+func TestScanIgnoreHidden(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, ffs.MkdirAll(".dir", 0755))
+	must(t, writeFile(ffs, filepath.Join(".dir", "file"), []byte("data"), 0644))
+	must(t, writeFile(ffs, ".hidden", []byte("data"), 0644))
+	must(t, writeFile(ffs, "visible", []byte("data"), 0644))
+	must(t, f.scanSubdirs(nil))
+	snap := dbSnapshot(t, m, f.ID)
+	visible, _ := snap.Get(protocol.LocalDeviceID, "visible")
+	snap.Release()
+
+	// Turning it on ignores the hidden items instead of deleting them and
+	// leaves the rest alone.
+	f.IgnoreHidden = true
+	must(t, f.scanSubdirs(nil))
+	snap = dbSnapshot(t, m, f.ID)
+	for _, name := range []string{".dir", filepath.Join(".dir", "file"), ".hidden"} {
+		if fi, ok := snap.Get(protocol.LocalDeviceID, name); !ok || fi.IsDeleted() || !fi.IsIgnored() {
+			t.Errorf("Expected %v to be ignored, got %v", name, fi)
+		}
+	}
+	if fi, ok := snap.Get(protocol.LocalDeviceID, "visible"); !ok || fi.Sequence != visible.Sequence {
+		t.Errorf("Expected visible to be unchanged, got %v", fi)
+	}
+	snap.Release()
+
+	// New hidden items aren't picked up.
+	must(t, writeFile(ffs, ".new", []byte("data"), 0644))
+	must(t, f.scanSubdirs(nil))
+	snap = dbSnapshot(t, m, f.ID)
+	if _, ok := snap.Get(protocol.LocalDeviceID, ".new"); ok {
+		t.Error("Expected the new hidden file not to be scanned")
+	}
+	snap.Release()
+
+	// Neither are those below a hidden directory when scanned directly.
+	newBelow := filepath.Join(".dir", "new")
+	must(t, writeFile(ffs, newBelow, []byte("data"), 0644))
+	must(t, f.scanSubdirs([]string{newBelow}))
+	snap = dbSnapshot(t, m, f.ID)
+	if _, ok := snap.Get(protocol.LocalDeviceID, newBelow); ok {
+		t.Error("Expected the new file in the hidden directory not to be scanned")
+	}
+	snap.Release()
+
+	f.IgnoreHidden = false
+	must(t, f.scanSubdirs(nil))
+	snap = dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	for _, name := range []string{".hidden", ".new"} {
+		if fi, ok := snap.Get(protocol.LocalDeviceID, name); !ok || fi.IsInvalid() {
+			t.Errorf("Expected %v to be scanned again, got %v", name, fi)
+		}
+	}
+}
+
+func TestConflictNameTemplate(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	f.MaxConflicts = 1
+	tpl, err := config.ParseConflictNameTemplate("{ext}~conflict~{device}~{date}{time}")
+	must(t, err)
+	f.conflictNames = tpl
+
+	name := "file.txt"
+	scanChan := make(chan string, 2)
+	createFile(t, name, ffs)
+	must(t, f.moveForConflict(name, device1.Short().String(), scanChan))
+	confls := f.existingConflicts(name)
+	if len(confls) != 1 || !strings.HasPrefix(confls[0], "file.txt~conflict~"+device1.Short().String()+"~") {
+		t.Fatal("Expected one conflict named by the template, got", confls)
+	}
+	if scan := <-scanChan; scan != confls[0] {
+		t.Errorf("Expected %v to be scanned, got %v", confls[0], scan)
+	}
+
+	// A conflict copy isn't copied again.
+	must(t, f.moveForConflict(confls[0], device2.Short().String(), scanChan))
+	if confls := f.existingConflicts(name); len(confls) != 0 {
+		t.Error("Expected the conflicting conflict copy to be removed, got", confls)
+	}
+}
+
+func TestChronicConflictActions(t *testing.T) {
+	for _, action := range []config.ChronicConflictAction{config.ChronicConflictActionNotify, config.ChronicConflictActionIgnore, config.ChronicConflictActionLastWriterWins} {
+		t.Run(action.String(), func(t *testing.T) {
+			m, f, wcfgCancel := setupSendReceiveFolder(t)
+			defer cleanupSRFolder(f, m, wcfgCancel)
+			ffs := f.Filesystem()
+			f.ChronicConflictThreshold = 1
+			f.ChronicConflictAction = action
+
+			name := "foo"
+			scanChan := make(chan string, 2)
+
+			createFile(t, name, ffs)
+			if err := f.moveForConflict(name, device1.String(), scanChan); err != nil {
+				t.Fatal(err)
+			}
+			if confls := f.existingConflicts(name); len(confls) != 1 {
+				t.Fatal("Expected one conflict, got", len(confls))
+			}
+			if chronic, err := f.ChronicConflicts(); err != nil {
+				t.Fatal(err)
+			} else if len(chronic) != 0 {
+				t.Fatal("Expected no chronic conflicts, got", chronic)
+			}
+
+			createFile(t, name, ffs)
+			err := f.moveForConflict(name, device2.String(), scanChan)
+
+			chronic, cerr := f.ChronicConflicts()
+			if cerr != nil {
+				t.Fatal(cerr)
+			}
+			if len(chronic) != 1 || chronic[0].Path != name || chronic[0].Conflicts != 2 {
+				t.Fatal("Unexpected chronic conflicts", chronic)
+			}
+
+			confls := f.existingConflicts(name)
+			switch action {
+			case config.ChronicConflictActionNotify:
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(confls) != 2 {
+					t.Error("Expected two conflicts, got", len(confls))
+				}
+			case config.ChronicConflictActionIgnore:
+				if err != errChronicConflictIgnored {
+					t.Fatal("Expected chronic conflict to be ignored, got", err)
+				}
+				if len(confls) != 1 {
+					t.Error("Expected one conflict, got", len(confls))
+				}
+				if !f.ignores.ShouldIgnore(name) {
+					t.Error("Expected", name, "to be ignored")
+				}
+			case config.ChronicConflictActionLastWriterWins:
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(confls) != 1 {
+					t.Error("Expected one conflict, got", len(confls))
+				}
+				if _, err := ffs.Lstat(name); !fs.IsNotExist(err) {
+					t.Error("Expected file to be removed, got", err)
+				}
+			}
+		})
+	}
+}
+
+func TestPullPlan(t *testing.T) {
+	existing := setupFile("existing", []int{1, 2})
+	existing.Size = 2 * 0x20000
+	existing.Version = protocol.Vector{}.Update(myID.Short())
+	m, f, wcfgCancel := setupSendReceiveFolder(t, existing)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	remVersion := protocol.Vector{}.Update(device1.Short())
+	copied := setupFile("copied", []int{1, 2, 3})
+	copied.Size = 3 * 0x20000
+	copied.Version = remVersion
+	fresh := setupFile("fresh", []int{3, 4})
+	fresh.Size = 2 * 0x20000
+	fresh.Version = remVersion
+	deleted := existing
+	deleted.Deleted = true
+	deleted.Blocks = nil
+	deleted.Version = existing.Version.Update(device1.Short())
+	f.fset.Update(device1, []protocol.FileInfo{copied, fresh, deleted})
+
+	plan, err := f.PullPlan()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Blocks 1 and 2 exist locally and block 3 is needed twice.
+	if plan.TotalBytes != copied.Size+fresh.Size {
+		t.Errorf("Expected %v total bytes, got %v", copied.Size+fresh.Size, plan.TotalBytes)
+	}
+	if exp := int64(2 * 0x20000); plan.DownloadBytes != exp {
+		t.Errorf("Expected %v bytes to download, got %v", exp, plan.DownloadBytes)
+	}
+	actions := make(map[string]string)
+	for _, item := range plan.Items {
+		actions[item.Name] = item.Action
+	}
+	exp := map[string]string{"copied": "update", "fresh": "update", "existing": "delete"}
+	if len(actions) != len(exp) {
+		t.Errorf("Expected actions %v, got %v", exp, actions)
+	}
+	for name, action := range exp {
+		if actions[name] != action {
+			t.Errorf("Expected action %v for %v, got %v", action, name, actions[name])
+		}
+	}
+}
+
+func TestSpaceChanged(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	f.MinDiskFree = config.Size{Value: 100, Unit: "%"}
+	if err := f.spaceChanged(); err == nil {
+		t.Error("Expected an error when requiring all space to be free")
+	}
+	select {
+	case <-f.pullScheduled:
+		t.Error("Expected no pull to be scheduled")
+	default:
+	}
+
+	f.MinDiskFree = config.Size{}
+	if err := f.spaceChanged(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-f.pullScheduled:
+	default:
+		t.Error("Expected a pull to be scheduled")
+	}
+}
+
+func TestTempFiles(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	old := fs.TempName("old")
+	recent := filepath.Join("dir", fs.UnixTempPrefix+"recent.tmp")
+	must(t, ffs.MkdirAll("dir", 0755))
+	for _, name := range []string{old, recent, "regular"} {
+		must(t, writeFile(ffs, name, []byte("content"), 0644))
+	}
+	past := time.Now().Add(-48 * time.Hour)
+	must(t, ffs.Chtimes(old, past, past))
+
+	files, err := f.TempFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("Expected two temp files, got %v", files)
+	}
+	for _, file := range files {
+		switch file.Name {
+		case old:
+			if !file.Expired || file.AgeS < 47*60*60 {
+				t.Errorf("Expected %v to be expired, got %+v", old, file)
+			}
+		case recent:
+			if file.Expired || file.Size != int64(len("content")) {
+				t.Errorf("Expected %v to be a recent temp file, got %+v", recent, file)
+			}
+		default:
+			t.Errorf("Unexpected temp file %v", file.Name)
+		}
+	}
+}
+
+func TestScanSlotLimit(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	// Another folder is scanning and only one may do so at a time.
+	m.folderScanLimiter.setCapacity(1)
+	m.folderScanLimiter.take(1)
+
+	done := make(chan error)
+	go func() {
+		done <- f.scanSubdirs(nil)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatal("Scan finished while another folder was scanning:", err)
+	default:
+	}
+	if state, _, _ := f.getState(); state != FolderScanWaiting {
+		t.Errorf("Expected state %v, got %v", FolderScanWaiting, state)
+	}
+
+	count := scanSlotWaitTimer.Count()
+	m.folderScanLimiter.give(1)
+	select {
+	case err := <-done:
+		must(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for the scan")
+	}
+	if scanSlotWaitTimer.Count() != count+1 {
+		t.Error("Expected the wait for the scan slot to be recorded")
+	}
+
+	// Waiting for a slot stops when the folder does.
+	m.folderScanLimiter.take(1)
+	defer m.folderScanLimiter.give(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	f.ctx = ctx
+	go func() {
+		done <- f.scanSubdirs(nil)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Errorf("Expected %v, got %v", context.Canceled, err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for the scan to give up on the slot")
+	}
+}
+
+func TestPullDeletionsOnly(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.PullDeletionsOnly = true
+
+	for _, name := range []string{"deleted", "modified"} {
+		must(t, writeFile(f.mtimefs, name, []byte("data"), 0644))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	deleted, ok := m.testCurrentFolderFile(f.ID, "deleted")
+	if !ok {
+		t.Fatal("file missing")
+	}
+	deleted.SetDeleted(device1.Short())
+	modified, ok := m.testCurrentFolderFile(f.ID, "modified")
+	if !ok {
+		t.Fatal("file missing")
+	}
+	modified.Version = modified.Version.Update(device1.Short())
+	modified.Size++
+	created := protocol.FileInfo{Name: "created", Type: protocol.FileInfoTypeDirectory, Version: protocol.Vector{}.Update(device1.Short())}
+	m.Index(device1, f.ID, []protocol.FileInfo{deleted, modified, created})
+
+	changed, err := f.pullerIteration(make(chan string))
+	must(t, err)
+	if changed != 1 {
+		t.Error("Expected one change in pull, got", changed)
+	}
+	if _, err := f.mtimefs.Lstat("deleted"); !fs.IsNotExist(err) {
+		t.Error("Expected deleted file to be removed, got", err)
+	}
+	if _, err := f.mtimefs.Lstat("created"); !fs.IsNotExist(err) {
+		t.Error("Expected directory to not be created, got", err)
+	}
+	if cur, ok := m.testCurrentFolderFile(f.ID, "modified"); !ok || cur.Version.Equal(modified.Version) {
+		t.Error("Expected modified file to not be pulled")
+	}
+}
+
+func TestReserveSpace(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	usage, err := f.Filesystem().Usage(".")
+	if err != nil {
+		t.Skip("Usage not supported:", err)
+	}
+	f.MinDiskFree = config.Size{Value: 1, Unit: "B"}
+
+	// Each file fits on its own, but not both together.
+	size := int64(usage.Free / 10 * 6)
+	newState := func(name string) *sharedPullerState {
+		file := protocol.FileInfo{Name: name, Size: size, RawBlockSize: protocol.BlockSize(size)}
+		blocks := make([]protocol.BlockInfo, size/int64(file.BlockSize())+1)
+		return newSharedPullerState(file, f.mtimefs, f.ID, "", blocks, nil, false, false, protocol.FileInfo{}, false, false)
+	}
+	first, second := newState("first"), newState("second")
+
+	must(t, f.reserveSpace(first))
+	if err := f.reserveSpace(second); err == nil {
+		t.Fatal("Expected an error when reserving space for the second file")
+	}
+	f.releaseSpace(second)
+	f.releaseSpace(first)
+	if len(f.reserved) != 0 {
+		t.Errorf("Expected no space to be reserved, got %v", len(f.reserved))
+	}
+	must(t, f.reserveSpace(second))
+
+	// Data already written doesn't need to be reserved anymore.
+	for i := 0; i < second.copyTotal/2; i++ {
+		second.copyDone(protocol.BlockInfo{})
+	}
+	must(t, f.reserveSpace(first))
+	must(t, f.checkPendingSpace())
+}
+
+func TestCheckPendingSpace(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	usage, err := f.Filesystem().Usage(".")
+	if err != nil {
+		t.Skip("Usage not supported:", err)
+	}
+
+	size := int64(usage.Free / 10 * 6)
+	file := protocol.FileInfo{Name: "file", Size: size, RawBlockSize: protocol.BlockSize(size)}
+	blocks := make([]protocol.BlockInfo, size/int64(file.BlockSize())+1)
+	state := newSharedPullerState(file, f.mtimefs, f.ID, "", blocks, nil, false, false, protocol.FileInfo{}, false, false)
+	must(t, f.reserveSpace(state))
+	must(t, f.checkPendingSpace())
+
+	// Free space shrinks below what the file still needs.
+	f.MinDiskFree = config.Size{Value: float64(usage.Free - uint64(size)/2), Unit: "B"}
+	if err := f.checkPendingSpace(); err == nil {
+		t.Fatal("Expected an error when the pending data exceeds free space")
+	}
+	if state.failed() == nil {
+		t.Error("Expected the file being pulled to be failed")
+	}
+}
+
+func TestPostPullValidation(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a shell")
+	}
+
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	file := protocol.FileInfo{Name: filepath.Join("dir", "file"), BlocksHash: []byte("hash")}
+	temp := fs.TempName(file.Name)
+	must(t, writeFile(f.mtimefs, temp, []byte("content"), 0644))
+
+	f.PostPullValidationCommand = `sh -c 'test -f "%FOLDER_PATH%/%FILE_PATH%"'`
+	must(t, f.validatePulledFile(file, temp))
+
+	f.PostPullValidationCommand = `sh -c "exit 1"`
+	if err := f.validatePulledFile(file, temp); !errors.Is(err, errValidationFailed) {
+		t.Fatal("Expected validation to fail, got", err)
+	}
+	if _, err := f.mtimefs.Lstat(temp); !fs.IsNotExist(err) {
+		t.Error("Expected temporary file to be moved, got", err)
+	}
+	if _, err := f.mtimefs.Lstat(filepath.Join(f.quarantineDir(), file.Name)); err != nil {
+		t.Error("Expected file to be quarantined, got", err)
+	}
+	if f.wasRejected(file) != errValidationFailed {
+		t.Error("Expected rejected content to be remembered")
+	}
+}
+
+func TestPostPullValidationMany(t *testing.T) {
+	// More files than validation routines are validated and finished.
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a shell")
+	}
+
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.PostPullValidationCommand = `sh -c "exit 1"`
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+
+	n := 4 * maxConcurrentValidations
+	in := make(chan *sharedPullerState, n)
+	for i := 0; i < n; i++ {
+		file := protocol.FileInfo{Name: fmt.Sprintf("file%d", i)}
+		temp := fs.TempName(file.Name)
+		must(t, writeFile(f.mtimefs, temp, []byte("content"), 0644))
+		in <- newSharedPullerState(file, f.mtimefs, f.ID, temp, nil, nil, false, false, protocol.FileInfo{}, false, false)
+	}
+	close(in)
+	f.finisherRoutine(snap, in, make(chan dbUpdateJob, n), make(chan string, n))
+
+	for i := 0; i < n; i++ {
+		name := filepath.Join(f.quarantineDir(), fmt.Sprintf("file%d", i))
+		if _, err := f.mtimefs.Lstat(name); err != nil {
+			t.Error("Expected file to be quarantined, got", err)
+		}
+	}
+}
+
+func TestVerifyOnStartup(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	for _, name := range []string{"changed", "corrupted", "removed", "unchanged"} {
+		must(t, writeFile(ffs, name, []byte("content"), 0644))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	// Changes while we weren't running.
+	must(t, writeFile(ffs, "changed", []byte("changed content"), 0644))
+	must(t, ffs.Remove("removed"))
+
+	// A change that keeps size and modification time, which only hashing
+	// notices.
+	info, err := ffs.Lstat("corrupted")
+	must(t, err)
+	must(t, writeFile(ffs, "corrupted", []byte("CONTENT"), 0644))
+	must(t, ffs.Chtimes("corrupted", info.ModTime(), info.ModTime()))
+
+	f.VerifyOnStartupFull = true
+	discrepancies, err := f.verifyOnStartup()
+	must(t, err)
+	if discrepancies != 3 {
+		t.Errorf("Expected three discrepancies, got %v", discrepancies)
+	}
+	f.forcedRescanPathsMut.Lock()
+	for _, name := range []string{"changed", "corrupted", "removed"} {
+		if _, ok := f.forcedRescanPaths[name]; !ok {
+			t.Errorf("Expected %v to be scheduled for a forced rescan", name)
+		}
+	}
+	if _, ok := f.forcedRescanPaths["unchanged"]; ok {
+		t.Error("Expected unchanged not to be scheduled for a forced rescan")
+	}
+	f.forcedRescanPaths = make(map[string]struct{})
+	f.forcedRescanPathsMut.Unlock()
+
+	// A sample of one file finds at most one discrepancy.
+	f.VerifyOnStartupFull = false
+	f.VerifyOnStartupSample = 1
+	discrepancies, err = f.verifyOnStartup()
+	must(t, err)
+	if discrepancies > 1 {
+		t.Errorf("Expected at most one discrepancy in a sample of one, got %v", discrepancies)
+	}
+}
+
+func TestSymlinkPolicyIgnore(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks aren't synced on Windows")
+	}
+
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "target", []byte("content"), 0644))
+	must(t, ffs.CreateSymlink("target", "link"))
+	must(t, ffs.CreateSymlink("target", "synced"))
+
+	// Scanned while synced as symlink.
+	f.SymlinkPolicy = config.SymlinkPolicySync
+	must(t, f.scanSubdirs([]string{"synced"}))
+
+	f.SymlinkPolicy = config.SymlinkPolicyIgnore
+	must(t, f.scanSubdirs(nil))
+
+	snap := dbSnapshot(t, m, f.ID)
+	_, ok := snap.Get(protocol.LocalDeviceID, "link")
+	synced, _ := snap.Get(protocol.LocalDeviceID, "synced")
+	snap.Release()
+	if ok {
+		t.Error("Expected the symlink not to be scanned")
+	}
+	if !synced.IsUnsupported() {
+		t.Error("Expected the previously synced symlink to be marked unsupported")
+	}
+	if skipped := f.SkippedSymlinks(); len(skipped) != 2 || skipped[0] != "link" || skipped[1] != "synced" {
+		t.Errorf("Expected both links to be reported as skipped, got %v", skipped)
+	}
+
+	// They're no longer reported once they're gone.
+	must(t, ffs.Remove("link"))
+	must(t, ffs.Remove("synced"))
+	must(t, f.scanSubdirs(nil))
+	if skipped := f.SkippedSymlinks(); len(skipped) != 0 {
+		t.Errorf("Expected no skipped symlinks, got %v", skipped)
+	}
+}
+
+func TestSymlinkPolicyResolveKeepsLink(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks aren't synced on Windows")
+	}
+
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "target", []byte("content"), 0644))
+	must(t, ffs.CreateSymlink("target", "link"))
+
+	f.SymlinkPolicy = config.SymlinkPolicyResolve
+	must(t, f.scanSubdirs(nil))
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	cur, ok := snap.Get(protocol.LocalDeviceID, "link")
+	if !ok || cur.IsSymlink() {
+		t.Fatal("Expected the symlink to be scanned as a file")
+	}
+
+	remote := cur
+	remote.Version = cur.Version.Update(device1.Short())
+	temp := fs.TempName(remote.Name)
+	must(t, writeFile(ffs, temp, []byte("changed"), 0644))
+	scanChan := make(chan string, 1)
+	dbUpdateChan := make(chan dbUpdateJob, 1)
+
+	if err := f.performFinish(remote, cur, true, temp, snap, dbUpdateChan, scanChan); err != errResolvedSymlink {
+		t.Errorf("Expected %v, got %v", errResolvedSymlink, err)
+	}
+	if info, err := ffs.Lstat("link"); err != nil || !info.IsSymlink() {
+		t.Error("Expected the symlink to be left in place")
+	}
+	if info, err := ffs.Stat("target"); err != nil || info.Size() != int64(len("content")) {
+		t.Error("Expected the target to be unchanged")
+	}
+}
+
+func TestEmptyPathGuard(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	var names []string
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("file%d", i)
+		names = append(names, name)
+		must(t, writeFile(ffs, name, []byte("content"), 0644))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	// As if the mount failed, leaving only a few files.
+	for _, name := range names[5:] {
+		must(t, ffs.Remove(name))
+	}
+	f.EmptyPathGuardRatio = 2
+	if err := f.getHealthErrorWithoutIgnores(); err != ErrEmptyPathSuspected {
+		t.Fatalf("Expected ErrEmptyPathSuspected, got %v", err)
+	}
+
+	// Half of the files are enough with a ratio of two.
+	for _, name := range names[5:11] {
+		must(t, writeFile(ffs, name, []byte("content"), 0644))
+	}
+	must(t, f.getHealthErrorWithoutIgnores())
+
+	// The check passed, thus isn't done again.
+	for _, name := range names[:11] {
+		must(t, ffs.Remove(name))
+	}
+	must(t, f.getHealthErrorWithoutIgnores())
+}
+
+func TestSkipRenameIgnoreCheck(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	f.SkipRenameIgnoreCheck = true
+
+	must(t, writeFile(ffs, "a", []byte("content"), 0644))
+	must(t, writeFile(ffs, "c", []byte("other content"), 0644))
+	must(t, f.scanSubdirs(nil))
+
+	get := func(name string) protocol.FileInfo {
+		t.Helper()
+		snap := dbSnapshot(t, m, f.ID)
+		defer snap.Release()
+		fi, ok := snap.Get(protocol.LocalDeviceID, name)
+		if !ok {
+			t.Fatalf("%v not in the database", name)
+		}
+		return fi
+	}
+
+	// Renames are detected as usual.
+	must(t, ffs.Rename("a", "b"))
+	must(t, f.scanSubdirs(nil))
+	if a := get("a"); !a.IsDeleted() {
+		t.Errorf("Expected a to be deleted, got %v", a)
+	}
+
+	// A rename from a file that was just ignored must not be picked up, as
+	// the file isn't deleted but ignored.
+	must(t, writeFile(ffs, ".stignore", []byte("c\n"), 0644))
+	must(t, ffs.Rename("c", "d"))
+	must(t, f.scanSubdirs(nil))
+	if c := get("c"); c.IsDeleted() || !c.IsIgnored() {
+		t.Errorf("Expected c to be ignored, not deleted, got %v", c)
+	}
+}
+
+func TestRehash(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	for _, name := range []string{"a", "b", "c"} {
+		must(t, writeFile(ffs, name, []byte("content "+name), 0644))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	versions := func() map[string]protocol.Vector {
+		snap := dbSnapshot(t, m, f.ID)
+		defer snap.Release()
+		res := make(map[string]protocol.Vector)
+		for _, name := range []string{"a", "b", "c"} {
+			fi, _ := snap.Get(protocol.LocalDeviceID, name)
+			if fi.IsInvalid() {
+				t.Errorf("Expected %v to be valid after rehashing", name)
+			}
+			res[name] = fi.Version
+		}
+		return res
+	}
+	before := versions()
+
+	// Bitrot doesn't change size or modification time, thus goes unnoticed
+	// by a normal scan.
+	info, err := ffs.Lstat("b")
+	must(t, err)
+	must(t, writeFile(ffs, "b", []byte("rotten b"), 0644))
+	must(t, ffs.Chtimes("b", info.ModTime(), info.ModTime()))
+
+	must(t, f.Rehash())
+	if err := f.Rehash(); err != errRehashRunning {
+		t.Errorf("Expected errRehashRunning, got %v", err)
+	}
+	for f.RehashStatus().State == RehashRunning {
+		must(t, f.rehashBatch())
+	}
+
+	status := f.RehashStatus()
+	if status.State != RehashDone || status.TotalFiles != 3 || status.HashedFiles != 3 || status.Changed != 1 {
+		t.Errorf("Unexpected rehash status %+v", status)
+	}
+	after := versions()
+	if !after["a"].Equal(before["a"]) || !after["c"].Equal(before["c"]) {
+		t.Error("Expected unchanged files to keep their version")
+	}
+	if after["b"].Equal(before["b"]) {
+		t.Error("Expected changed file to get a new version")
+	}
+
+	// Cancelling stops before the next batch.
+	must(t, f.Rehash())
+	must(t, f.CancelRehash())
+	must(t, f.rehashBatch())
+	if status := f.RehashStatus(); status.State != RehashCancelled || status.HashedFiles != 0 {
+		t.Errorf("Unexpected status after cancelling %+v", status)
+	}
+	if err := f.CancelRehash(); err != errRehashNotRunning {
+		t.Errorf("Expected errRehashNotRunning, got %v", err)
+	}
+}
+
+func TestFailedPullRemovesUnusableTemp(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+
+	blocks := []protocol.BlockInfo{{Offset: 0, Size: protocol.MinBlockSize}, {Offset: protocol.MinBlockSize, Size: protocol.MinBlockSize}}
+	pullFailing := func(name string, pulled int) string {
+		t.Helper()
+		file := protocol.FileInfo{Name: name, Size: 2 * protocol.MinBlockSize, Blocks: blocks}
+		temp := fs.TempName(name)
+		state := newSharedPullerState(file, f.mtimefs, f.ID, temp, blocks, nil, false, false, protocol.FileInfo{}, false, false)
+		if _, err := state.tempFile(); err != nil {
+			t.Fatal(err)
+		}
+		for _, block := range blocks[:pulled] {
+			state.pullDone(block)
+		}
+		state.fail(errors.New("no connected device has the required version of this file"))
+
+		in := make(chan *sharedPullerState, 1)
+		in <- state
+		close(in)
+		f.finisherRoutine(snap, in, make(chan dbUpdateJob, 1), make(chan string, 1))
+		return temp
+	}
+
+	// Nothing was pulled, thus there's nothing to reuse.
+	temp := pullFailing("nothing", 0)
+	if _, err := f.mtimefs.Lstat(temp); !fs.IsNotExist(err) {
+		t.Error("Expected unusable temporary file to be removed, got", err)
+	}
+
+	// Pulled blocks are reused on the next attempt.
+	temp = pullFailing("partial", 1)
+	if _, err := f.mtimefs.Lstat(temp); err != nil {
+		t.Error("Expected partial temporary file to be kept, got", err)
+	}
+}
+
+func TestPullRetriesHalt(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.MaxPullRetries = 1
+
+	// Nobody is connected to pull the file from.
+	file := setupFile("needed", []int{0, 1})
+	file.Version = protocol.Vector{}.Update(device1.Short())
+	must(t, m.IndexUpdate(device1, f.ID, []protocol.FileInfo{file}))
+
+	if ok, _ := f.folder.pull(); ok {
+		t.Fatal("Expected pull to fail")
+	}
+	if r := f.PullRetries(); r.Failures != 1 || r.NextRetry.IsZero() || r.Halted {
+		t.Fatalf("Unexpected state after first failure: %+v", r)
+	}
+	if ok, _ := f.folder.pull(); ok {
+		t.Fatal("Expected pull to fail")
+	}
+	if r := f.PullRetries(); r.Failures != 2 || !r.NextRetry.IsZero() || !r.Halted {
+		t.Fatalf("Expected pulling to be halted after the failed retry, got %+v", r)
+	}
+	if _, err := f.folder.pull(); err != errPullHalted {
+		t.Fatal("Expected no further pull attempts, got", err)
+	}
+
+	// Run the resumption, as the serve loop isn't running.
+	f.done = make(chan struct{})
+	go func() {
+		req := <-f.doInSyncChan
+		req.err <- req.fn()
+	}()
+	must(t, f.ResumePulling())
+	if r := f.PullRetries(); r.Failures != 0 || r.Halted {
+		t.Errorf("Expected retries to be reset, got %+v", r)
+	}
+	select {
+	case <-f.pullScheduled:
+	default:
+		t.Error("Expected a pull to be scheduled")
+	}
+}
+
+func TestLocalRenameDetectedEvent(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "old", []byte("content"), 0644))
+	must(t, writeFile(ffs, "gone", []byte("other content"), 0644))
+	must(t, f.scanSubdirs(nil))
+
+	sub := m.evLogger.Subscribe(events.LocalRenameDetected)
+	defer sub.Unsubscribe()
+
+	must(t, ffs.Rename("old", "new"))
+	must(t, ffs.Remove("gone"))
+	must(t, f.scanSubdirs(nil))
+
+	ev, err := sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal("Expected a rename event:", err)
+	}
+	data := ev.Data.(map[string]string)
+	if data["folder"] != f.ID || data["oldPath"] != "old" || data["path"] != "new" || data["blocksHash"] == "" {
+		t.Errorf("Unexpected event data %v", data)
+	}
+	// The plain deletion isn't reported as rename.
+	if ev, err := sub.Poll(10 * time.Millisecond); err != events.ErrTimeout {
+		t.Errorf("Expected no further events, got %v", ev.Data)
+	}
+}
+
+func TestScanCaseConflict(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "Foo", []byte("content"), 0644))
+	if _, err := os.Lstat(filepath.Join(ffs.URI(), "FOO")); err == nil {
+		t.Skip("the filesystem is case insensitive")
+	}
+	must(t, writeFile(ffs, "Bar", []byte("content"), 0644))
+	must(t, f.scanSubdirs(nil))
+
+	sub := m.evLogger.Subscribe(events.FolderCaseConflict)
+	defer sub.Unsubscribe()
+
+	must(t, writeFile(ffs, "foo", []byte("other content"), 0644))
+	must(t, f.scanSubdirs(nil))
+
+	ev, err := sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal("Expected a case conflict event:", err)
+	}
+	data := ev.Data.(map[string]string)
+	if data["folder"] != f.ID || data["path"] != "foo" || data["existing"] != "Foo" {
+		t.Errorf("Unexpected event data %v", data)
+	}
+	if errs := f.Errors(); len(errs) != 1 || errs[0].Path != "foo" {
+		t.Errorf("Expected a scan error for foo, got %v", errs)
+	}
+	snap := dbSnapshot(t, m, f.ID)
+	_, ok := snap.Get(protocol.LocalDeviceID, "foo")
+	snap.Release()
+	if ok {
+		t.Error("Expected foo not to be scanned")
+	}
+
+	// Renaming to a different case only isn't a conflict.
+	must(t, ffs.Remove("foo"))
+	must(t, ffs.Rename("Bar", "bar"))
+	must(t, f.scanSubdirs(nil))
+	if ev, err := sub.Poll(10 * time.Millisecond); err != events.ErrTimeout {
+		t.Errorf("Expected no case conflict on rename, got %v", ev.Data)
+	}
+	snap = dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if fi, ok := snap.Get(protocol.LocalDeviceID, "bar"); !ok || fi.IsDeleted() {
+		t.Error("Expected bar to be scanned")
+	}
+	if fi, ok := snap.Get(protocol.LocalDeviceID, "Bar"); !ok || !fi.IsDeleted() {
+		t.Error("Expected Bar to be deleted")
+	}
+}
+
+func TestScanCaseConflictDir(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, ffs.Mkdir("Dir", 0755))
+	if _, err := os.Lstat(filepath.Join(ffs.URI(), "DIR")); err == nil {
+		t.Skip("the filesystem is case insensitive")
+	}
+	must(t, f.scanSubdirs(nil))
+
+	must(t, ffs.MkdirAll(filepath.Join("dir", "sub"), 0755))
+	must(t, writeFile(ffs, filepath.Join("dir", "file"), []byte("content"), 0644))
+	must(t, writeFile(ffs, filepath.Join("dir", "sub", "file"), []byte("content"), 0644))
+	must(t, f.scanSubdirs(nil))
+
+	if errs := f.Errors(); len(errs) != 1 || errs[0].Path != "dir" {
+		t.Errorf("Expected a single scan error for dir, got %v", errs)
+	}
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	for _, name := range []string{"dir", filepath.Join("dir", "file"), filepath.Join("dir", "sub"), filepath.Join("dir", "sub", "file")} {
+		if _, ok := snap.Get(protocol.LocalDeviceID, name); ok {
+			t.Errorf("Expected %v not to be scanned", name)
+		}
+	}
+}
+
+func TestFuzzyRenameDetection(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	f.FuzzyRenameThresholdPct = 80
+
+	sub := m.evLogger.Subscribe(events.LocalRenameDetected)
+	defer sub.Unsubscribe()
+
+	// Renames a file of five blocks while appending a sixth one.
+	renameAppended := func(from, to string) {
+		t.Helper()
+		content := make([]byte, 6*protocol.MinBlockSize)
+		_, err := io.ReadFull(rand.Reader, content)
+		must(t, err)
+		must(t, writeFile(ffs, from, content[:5*protocol.MinBlockSize], 0644))
+		must(t, f.scanSubdirs(nil))
+		must(t, ffs.Remove(from))
+		must(t, writeFile(ffs, to, content, 0644))
+		must(t, f.scanSubdirs(nil))
+	}
+
+	renameAppended("exact1", "exact2")
+	if ev, err := sub.Poll(10 * time.Millisecond); err != events.ErrTimeout {
+		t.Fatalf("Expected no rename with exact detection, got %v", ev.Data)
+	}
+
+	f.RenameDetectionMode = config.RenameDetectionModeFuzzy
+	renameAppended("fuzzy1", "fuzzy2")
+	ev, err := sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal("Expected a rename event:", err)
+	}
+	if data := ev.Data.(map[string]string); data["oldPath"] != "fuzzy1" || data["path"] != "fuzzy2" {
+		t.Errorf("Unexpected event data %v", data)
+	}
+
+	f.FuzzyRenameThresholdPct = 90
+	renameAppended("below1", "below2")
+	if ev, err := sub.Poll(10 * time.Millisecond); err != events.ErrTimeout {
+		t.Fatalf("Expected no rename below the threshold, got %v", ev.Data)
+	}
+}
+
+func TestRetryAndClearPullError(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	f.errorsMut.Lock()
+	f.pullErrors = []FileError{{Path: "a", Err: "failed"}, {Path: "b", Err: "failed"}}
+	f.errorsMut.Unlock()
+
+	if err := f.RetryPullError("c"); err != errNoPullError {
+		t.Errorf("Expected %v for a file without error, got %v", errNoPullError, err)
+	}
+	must(t, f.RetryPullError("a"))
+	f.forcedRescanPathsMut.Lock()
+	_, ok := f.forcedRescanPaths["a"]
+	f.forcedRescanPathsMut.Unlock()
+	if !ok {
+		t.Error("Expected a to be rescanned")
+	}
+
+	f.ClearPullError("b")
+	if errs := f.Errors(); len(errs) != 0 {
+		t.Errorf("Expected no errors left, got %v", errs)
+	}
+}
+
+func TestMaxFileErrors(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.MaxFileErrors = 2
+
+	for _, name := range []string{"a", "b", "c"} {
+		f.newScanError(name, errors.New("failed"))
+	}
+	errs := f.Errors()
+	if len(errs) != 3 || errs[0].Path != "a" || errs[1].Path != "b" {
+		t.Fatalf("Expected the first two errors and a summary, got %v", errs)
+	}
+	if last := errs[2]; last.Path != "" || last.Err != "and 1 more errors not shown" {
+		t.Errorf("Unexpected summary %v", last)
+	}
+	f.clearScanErrors(nil)
+	if errs := f.Errors(); len(errs) != 0 {
+		t.Errorf("Expected no errors after clearing, got %v", errs)
+	}
+
+	f.errorsMut.Lock()
+	f.tempPullErrors = make(map[string]string)
+	f.errorsMut.Unlock()
+	for _, name := range []string{"a", "b", "c", "d"} {
+		f.newPullError(name, errors.New("failed"))
+	}
+	f.errorsMut.Lock()
+	defer f.errorsMut.Unlock()
+	if len(f.tempPullErrors) != 2 || f.tempPullErrorsDropped != 2 {
+		t.Errorf("Expected 2 kept and 2 dropped pull errors, got %v and %d", f.tempPullErrors, f.tempPullErrorsDropped)
+	}
+}
+
+func TestPullBackoffPersisted(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.PullerPauseS = 10
+
+	f.setPullBackoff(2)
+	f.restorePullBackoff()
+	if f.PullBackoff() != 2 || f.pullPause != 40*time.Second {
+		t.Errorf("Expected backoff 2 and a pause of 40s, got %v and %v", f.PullBackoff(), f.pullPause)
+	}
+
+	// The backoff is relative to the base pause, and capped.
+	f.PullerPauseS = 1
+	f.setPullBackoff(10)
+	f.restorePullBackoff()
+	if f.PullBackoff() != 6 || f.pullPause != 64*time.Second {
+		t.Errorf("Expected backoff 6 and a pause of 64s, got %v and %v", f.PullBackoff(), f.pullPause)
+	}
+
+	f.setPullBackoff(0)
+	f.restorePullBackoff()
+	if f.PullBackoff() != 0 || f.pullPause != time.Second {
+		t.Errorf("Expected no backoff after reset, got %v and %v", f.PullBackoff(), f.pullPause)
+	}
+}
+
+func TestFileErrorsPersisted(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.MaxFileErrors = 2
+
+	f.newScanError("scan1", errors.New("scan failure"))
+	f.newScanError("scan2", errors.New("scan failure"))
+	f.newScanError("scan3", errors.New("scan failure"))
+	f.errorsMut.Lock()
+	f.pullErrors = []FileError{{Path: "pull", Err: "pull failure"}}
+	f.errorsMut.Unlock()
+	f.persistFileErrors()
+
+	// Simulate a restart.
+	restarted := newFolder(f.model, f.fset, f.ignores, f.FolderConfiguration, events.NoopLogger, nil, nil)
+	errs := restarted.Errors()
+	if len(errs) != 4 {
+		t.Fatalf("Expected 3 errors and a dropped notice, got %v", errs)
+	}
+	for _, fe := range errs[:3] {
+		if !fe.Stale {
+			t.Errorf("Expected %v to be stale", fe)
+		}
+	}
+	if errs[3].Err != "and 1 more errors not shown" {
+		t.Errorf("Expected one dropped error, got %v", errs[3])
+	}
+
+	// Clearing also clears the persisted copy.
+	restarted.clearScanErrors(nil)
+	restarted.errorsMut.Lock()
+	restarted.pullErrors = nil
+	restarted.persistFileErrorsLocked()
+	restarted.errorsMut.Unlock()
+	restarted = newFolder(f.model, f.fset, f.ignores, f.FolderConfiguration, events.NoopLogger, nil, nil)
+	if errs := restarted.Errors(); len(errs) != 0 {
+		t.Errorf("Expected no errors after clearing, got %v", errs)
+	}
+}
+
+func TestFileErrorsPersistedIfChanged(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	kv := db.NewFolderStatisticsNamespace(m.db, f.ID)
+
+	// Overwrites the stored errors, to tell whether they are stored again.
+	marked := func() bool {
+		t.Helper()
+		bs, _, err := kv.Bytes(fileErrorsKey)
+		must(t, err)
+		return string(bs) == "marker"
+	}
+	mark := func() {
+		t.Helper()
+		must(t, kv.PutBytes(fileErrorsKey, []byte("marker")))
+	}
+
+	f.newScanError("scan1", errors.New("scan failure"))
+	f.persistFileErrors()
+	mark()
+	f.persistFileErrors()
+	if !marked() {
+		t.Error("Expected unchanged errors not to be stored again")
+	}
+
+	f.newScanError("scan2", errors.New("scan failure"))
+	f.persistFileErrors()
+	if marked() {
+		t.Error("Expected changed errors to be stored")
+	}
+
+	f.clearScanErrors(nil)
+	f.persistFileErrors()
+	if _, ok, _ := kv.Bytes(fileErrorsKey); ok {
+		t.Error("Expected the stored errors to be removed")
+	}
+	mark()
+	f.persistFileErrors()
+	if !marked() {
+		t.Error("Expected the stored errors not to be removed again")
+	}
+}
+
+func TestChangesSince(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "a", []byte("a"), 0644))
+	must(t, writeFile(ffs, "b", []byte("b"), 0644))
+	must(t, f.scanSubdirs(nil))
+
+	files, seq, err := f.ChangesSince(0)
+	must(t, err)
+	if len(files) != 2 || seq != files[1].Sequence {
+		t.Fatalf("Expected two files up to sequence %v, got %v and %v", seq, files, seq)
+	}
+
+	must(t, writeFile(ffs, "b", []byte("changed"), 0644))
+	must(t, f.scanSubdirs(nil))
+	files, next, err := f.ChangesSince(seq)
+	must(t, err)
+	if len(files) != 1 || files[0].Name != "b" || next != files[0].Sequence {
+		t.Errorf("Expected only b up to sequence %v, got %v", next, files)
+	}
+
+	if _, cur, err := f.ChangesSince(next + 10); err != ErrResyncRequired || cur != next {
+		t.Errorf("Expected a resync from sequence %v, got %v and %v", next, cur, err)
+	}
+}
+
+func TestModTimeWindowSkew(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	fcfg := f.FolderConfiguration
+	fcfg.RawModTimeWindowS = 3
+	restarted := newFolder(f.model, f.fset, f.ignores, fcfg, events.NoopLogger, nil, nil)
+	f.modTimeWindow = restarted.ModTimeWindow()
+	if f.modTimeWindow != 3*time.Second {
+		t.Fatalf("Expected the configured window of 3s, got %v", f.modTimeWindow)
+	}
+
+	t0 := time.Now().Add(-time.Hour).Truncate(time.Second)
+	must(t, writeFile(ffs, "file", []byte("data"), 0644))
+	must(t, ffs.Chtimes("file", t0, t0))
+	must(t, f.scanSubdirs(nil))
+	sequence := func() int64 {
+		snap := dbSnapshot(t, m, f.ID)
+		defer snap.Release()
+		fi, _ := snap.Get(protocol.LocalDeviceID, "file")
+		return fi.Sequence
+	}
+	seq := sequence()
+
+	// A skew of 2s is within the window.
+	skewed := t0.Add(2 * time.Second)
+	must(t, ffs.Chtimes("file", skewed, skewed))
+	must(t, f.scanSubdirs(nil))
+	if sequence() != seq {
+		t.Error("Expected a 2s skew to be tolerated")
+	}
+
+	skewed = t0.Add(4 * time.Second)
+	must(t, ffs.Chtimes("file", skewed, skewed))
+	must(t, f.scanSubdirs(nil))
+	if sequence() == seq {
+		t.Error("Expected a 4s skew to be detected")
+	}
+}
+
+func TestVerifyIntegrity(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	for _, name := range []string{"intact", "corrupted", "outOfSync"} {
+		must(t, writeFile(ffs, name, []byte("content"), 0644))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	// A newer global version, that isn't verified as pulling takes care
+	// of it.
+	snap := dbSnapshot(t, m, f.ID)
+	fi, _ := snap.Get(protocol.LocalDeviceID, "outOfSync")
+	snap.Release()
+	fi.Version = fi.Version.Update(device1.Short())
+	f.fset.Update(device1, []protocol.FileInfo{fi})
+
+	for _, name := range []string{"corrupted", "outOfSync"} {
+		info, err := ffs.Lstat(name)
+		must(t, err)
+		must(t, writeFile(ffs, name, []byte("CONTENT"), 0644))
+		must(t, ffs.Chtimes(name, info.ModTime(), info.ModTime()))
+	}
+
+	errs, err := f.VerifyIntegrity(context.Background())
+	must(t, err)
+	if len(errs) != 1 || errs[0].Path != "corrupted" {
+		t.Errorf("Expected only corrupted to fail verification, got %v", errs)
+	}
+	f.forcedRescanPathsMut.Lock()
+	if _, ok := f.forcedRescanPaths["corrupted"]; !ok || len(f.forcedRescanPaths) != 1 {
+		t.Errorf("Expected only corrupted to be scheduled for a forced rescan, got %v", f.forcedRescanPaths)
+	}
+	f.forcedRescanPathsMut.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := f.VerifyIntegrity(ctx); err != context.Canceled {
+		t.Errorf("Expected verification to be cancelled, got %v", err)
+	}
+}
+
+func TestAdaptPullPause(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.PullerPauseS = 1
+	f.pullPause = 4 * time.Second
+
+	// Without a rate limit the pause isn't touched.
+	f.adaptPullPause(0, time.Second, 0)
+	if f.pullPause != 4*time.Second {
+		t.Errorf("Expected unchanged pause without limit, got %v", f.pullPause)
+	}
+
+	// Far below the limit extends the pause.
+	f.adaptPullPause(10*1024, time.Second, 100)
+	if f.pullPause != 8*time.Second {
+		t.Errorf("Expected pause of 8s below the limit, got %v", f.pullPause)
+	}
+
+	// In between leaves it alone.
+	f.adaptPullPause(50*1024, time.Second, 100)
+	if f.pullPause != 8*time.Second {
+		t.Errorf("Expected unchanged pause of 8s, got %v", f.pullPause)
+	}
+
+	// Close to the limit shortens it, but not below the base pause.
+	for i := 0; i < 5; i++ {
+		f.adaptPullPause(95*1024, time.Second, 100)
+	}
+	if f.pullPause != time.Second {
+		t.Errorf("Expected base pause close to the limit, got %v", f.pullPause)
+	}
+
+	// And it doesn't grow beyond the ceiling.
+	for i := 0; i < 10; i++ {
+		f.adaptPullPause(0, time.Second, 100)
+	}
+	if f.pullPause != time.Minute {
+		t.Errorf("Expected pause capped at 1m, got %v", f.pullPause)
+	}
+}
+
+func TestScanDryRun(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "unchanged", []byte("unchanged"), 0644))
+	must(t, writeFile(ffs, "old", []byte("renamed"), 0644))
+	must(t, writeFile(ffs, "deleted", []byte("deleted"), 0644))
+	must(t, f.scanSubdirs(nil))
+	snap := dbSnapshot(t, m, f.ID)
+	seq := snap.Sequence(protocol.LocalDeviceID)
+	snap.Release()
+	stats := f.scanStats
+	f.skippedMountPoints = []string{"mount"}
+
+	must(t, ffs.Rename("old", "new"))
+	must(t, ffs.Remove("deleted"))
+	must(t, writeFile(ffs, "added", []byte("added"), 0644))
+	// Stale temporary files are removed by regular scans only.
+	must(t, ffs.MkdirAll(config.DefaultMarkerName, 0755))
+	stale := fs.TempName("stale")
+	must(t, writeFile(ffs, stale, []byte("data"), 0644))
+	dayAgo := time.Now().Add(-24 * time.Hour)
+	must(t, ffs.Chtimes(stale, dayAgo, dayAgo))
+	// Drop the pull scheduled by the initial scan.
+	select {
+	case <-f.pullScheduled:
+	default:
+	}
+
+	// Run the dry run, as the serve loop isn't running.
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+	f.done = make(chan struct{})
+	go func() {
+		req := <-f.doInSyncChan
+		req.err <- req.fn()
+	}()
+	files, err := f.ScanDryRun(nil)
+	must(t, err)
+
+	changes := make(map[string]bool)
+	for _, fi := range files {
+		if _, ok := changes[fi.Name]; ok {
+			t.Errorf("Expected %v to be listed once", fi.Name)
+		}
+		changes[fi.Name] = fi.IsDeleted()
+	}
+	expected := map[string]bool{"new": false, "added": false, "old": true, "deleted": true}
+	if len(changes) != len(expected) {
+		t.Errorf("Expected changes %v, got %v", expected, changes)
+	}
+	for name, deleted := range expected {
+		if got, ok := changes[name]; !ok || got != deleted {
+			t.Errorf("Expected %v with deleted %v, got %v", name, deleted, got)
+		}
+	}
+
+	snap = dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if snap.Sequence(protocol.LocalDeviceID) != seq {
+		t.Error("Expected the database to be unchanged")
+	}
+	if _, err := ffs.Lstat(stale); err != nil {
+		t.Error("Expected the stale temporary file to be kept:", err)
+	}
+	if f.scanStats != stats {
+		t.Errorf("Expected the scan statistics %v to be kept, got %v", stats, f.scanStats)
+	}
+	if len(f.skippedMountPoints) != 1 || f.skippedMountPoints[0] != "mount" {
+		t.Error("Expected the skipped mount points to be kept, got", f.skippedMountPoints)
+	}
+	select {
+	case <-f.pullScheduled:
+		t.Error("Expected no pull to be scheduled")
+	default:
+	}
+}
+
+func TestScanMatching(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, ffs.Mkdir("dir", 0755))
+	for _, name := range []string{"a.jpg", "a.txt", "b.txt", filepath.Join("dir", "b.jpg")} {
+		must(t, writeFile(ffs, name, []byte(name), 0644))
+	}
+	must(t, f.scanSubdirs(nil))
+	snap := dbSnapshot(t, m, f.ID)
+	seqs := make(map[string]int64)
+	snap.WithHave(protocol.LocalDeviceID, func(fi protocol.FileIntf) bool {
+		seqs[fi.FileName()] = fi.SequenceNo()
+		return true
+	})
+	snap.Release()
+
+	must(t, writeFile(ffs, "a.jpg", []byte("changed"), 0644))
+	must(t, writeFile(ffs, "a.txt", []byte("changed"), 0644))
+	must(t, ffs.Remove(filepath.Join("dir", "b.jpg")))
+	must(t, ffs.Remove("b.txt"))
+	must(t, writeFile(ffs, "c.jpg", []byte("new"), 0644))
+	must(t, writeFile(ffs, "c.txt", []byte("new"), 0644))
+	must(t, ffs.MkdirAll(filepath.Join("new", "sub"), 0755))
+	must(t, writeFile(ffs, filepath.Join("new", "sub", "d.jpg"), []byte("new"), 0644))
+	must(t, ffs.Mkdir("other", 0755))
+	must(t, writeFile(ffs, filepath.Join("other", "d.txt"), []byte("new"), 0644))
+
+	if err := f.ScanMatching("["); err == nil {
+		t.Error("Expected an error for an invalid pattern")
+	}
+
+	// Run the scan, as the serve loop isn't running.
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+	f.done = make(chan struct{})
+	go func() {
+		req := <-f.doInSyncChan
+		req.err <- req.fn()
+	}()
+	must(t, f.ScanMatching("*.jpg"))
+
+	snap = dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	for name, changed := range map[string]bool{
+		"a.jpg":                       true,
+		"a.txt":                       false,
+		"b.txt":                       false,
+		filepath.Join("dir", "b.jpg"): true,
+	} {
+		fi, ok := snap.Get(protocol.LocalDeviceID, name)
+		if !ok {
+			t.Errorf("Expected %v in the database", name)
+			continue
+		}
+		if got := fi.Sequence != seqs[name]; got != changed {
+			t.Errorf("Expected %v changed %v, got %v", name, changed, got)
+		}
+	}
+	if _, ok := snap.Get(protocol.LocalDeviceID, "c.jpg"); !ok {
+		t.Error("Expected c.jpg to be scanned")
+	}
+	if _, ok := snap.Get(protocol.LocalDeviceID, "c.txt"); ok {
+		t.Error("Expected c.txt not to be scanned")
+	}
+	// New directories are scanned along with the matching items within.
+	for _, name := range []string{"new", filepath.Join("new", "sub"), filepath.Join("new", "sub", "d.jpg")} {
+		if _, ok := snap.Get(protocol.LocalDeviceID, name); !ok {
+			t.Errorf("Expected %v to be scanned", name)
+		}
+	}
+	if _, ok := snap.Get(protocol.LocalDeviceID, "other"); ok {
+		t.Error("Expected the directory without matching items not to be scanned")
+	}
+}
+
+func TestPullerBringToFront(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	for _, name := range []string{"a", "b", "c"} {
+		f.queue.Push(name, 1, time.Now())
+	}
+	m.BringToFront(f.ID, "c")
+	m.BringToFront(f.ID, "unknown") // not queued, does nothing
+	if _, queued, _ := f.Jobs(1, 10); strings.Join(queued, ",") != "c,a,b" {
+		t.Errorf("Expected c to be pulled first, got %v", queued)
+	}
+}
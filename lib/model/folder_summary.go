@@ -103,6 +103,9 @@ func (c *folderSummaryService) Summary(folder string) (map[string]interface{}, e
 	res["errors"] = len(errors)
 	res["pullErrors"] = len(errors) // deprecated
 
+	skippedSymlinks, _ := c.model.SkippedSymlinks(folder)
+	res["skippedSymlinks"] = len(skippedSymlinks)
+
 	res["invalid"] = "" // Deprecated, retains external API for now
 
 	res["globalFiles"], res["globalDirectories"], res["globalSymlinks"], res["globalDeleted"], res["globalBytes"], res["globalTotalItems"] = global.Files, global.Directories, global.Symlinks, global.Deleted, global.Bytes, global.TotalItems()
@@ -114,6 +117,9 @@ func (c *folderSummaryService) Summary(folder string) (map[string]interface{}, e
 	if haveFcfg && fcfg.IgnoreDelete {
 		need.Deleted = 0
 	}
+	if haveFcfg && fcfg.PullDeletionsOnly {
+		need.Files, need.Directories, need.Symlinks, need.Bytes = 0, 0, 0, 0
+	}
 
 	need.Bytes -= c.model.FolderProgressBytesCompleted(folder)
 	// This may happen if we are in progress of pulling files that were
@@ -153,9 +159,37 @@ func (c *folderSummaryService) Summary(folder string) (map[string]interface{}, e
 		}
 	}
 
-	err = c.model.WatchError(folder)
+	res["pullPaused"] = c.model.PullPaused(folder)
+
+	if transfers, err := c.model.TransferTotals(folder); err == nil {
+		res["pulledBytes"], res["pulledFiles"], res["pulledDeletes"] = transfers.BytesPulled, transfers.FilesPulled, transfers.FilesDeleted
+	}
+
+	current, total, rate := c.model.ScanProgress(folder)
+	res["scanProgress"] = map[string]interface{}{
+		"current": current,
+		"total":   total,
+		"rate":    rate,
+	}
+
+	err, nextRetry, failures := c.model.WatchStatus(folder)
 	if err != nil {
 		res["watchError"] = err.Error()
+		res["watchNextRetry"] = nextRetry
+		res["watchFailures"] = failures
+	}
+	if haveFcfg && fcfg.FSWatcherEnabled {
+		res["watchErrorPolicy"] = fcfg.WatchErrorPolicy.String()
+		if fcfg.WatchErrorPolicy == config.WatchErrorPolicyEscalate {
+			res["watchErrorRescanAfter"] = fcfg.WatchErrorRescanAfter
+		}
+	}
+
+	res["modTimeWindowS"] = int(c.model.ModTimeWindow(folder) / time.Second)
+
+	if reason, until := c.model.ScanDelay(folder); reason != "" {
+		res["scanDelayReason"] = reason
+		res["scanDelayedUntil"] = until
 	}
 
 	return res, nil
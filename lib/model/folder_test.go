@@ -7,13 +7,29 @@
 package model
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/d4l3k/messagediff"
 
 	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/ignore"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/stats"
+	"github.com/syncthing/syncthing/lib/sync"
 )
 
 type unifySubsCase struct {
@@ -149,3 +165,1405 @@ func BenchmarkUnifySubs(b *testing.B) {
 		}
 	}
 }
+
+func TestDatabaseSpaceCheckedByFolder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syncthing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	usage, err := fs.NewFilesystem(fs.FilesystemTypeBasic, dir).Usage(".")
+	if err != nil {
+		t.Skip("Usage not supported:", err)
+	}
+
+	f := &folder{
+		FolderConfiguration: config.FolderConfiguration{
+			FilesystemType: fs.FilesystemTypeBasic,
+			Path:           dir,
+			MinDiskFree:    config.Size{Value: 1, Unit: "%"},
+		},
+	}
+	if f.databaseSpaceCheckedByFolder(usage) {
+		t.Error("Expected the database to be checked unless configured otherwise")
+	}
+
+	f.SkipSharedVolumeDatabaseSpaceCheck = true
+	if !f.databaseSpaceCheckedByFolder(usage) {
+		t.Error("Expected the database check to be skipped on the same volume")
+	}
+	other := usage
+	other.Total++
+	if f.databaseSpaceCheckedByFolder(other) {
+		t.Error("Expected the database to be checked on another volume")
+	}
+
+	f.MinDiskFree = config.Size{}
+	if f.databaseSpaceCheckedByFolder(usage) {
+		t.Error("Expected the database to be checked if the folder isn't")
+	}
+}
+
+func TestScanCompletedEvent(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	sub := m.evLogger.Subscribe(events.FolderScanCompleted)
+	defer sub.Unsubscribe()
+
+	must(t, ffs.MkdirAll("dir", 0755))
+	must(t, writeFile(ffs, "dir/a", []byte("aaa"), 0644))
+	must(t, writeFile(ffs, "dir/b", []byte("bb"), 0644))
+	must(t, f.scanSubdirs(nil))
+	ev, err := sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal("Expected a scan completed event:", err)
+	}
+	data := ev.Data.(map[string]interface{})
+	if data["files"] != 3 || data["hashedBytes"] != int64(5) || data["deleted"] != 0 {
+		t.Errorf("Unexpected stats for the full scan: %v", data)
+	}
+
+	// Counters are per scan and subdir scans are reported too.
+	must(t, ffs.Remove("dir/b"))
+	must(t, f.scanSubdirs([]string{"dir"}))
+	ev, err = sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal("Expected a scan completed event:", err)
+	}
+	data = ev.Data.(map[string]interface{})
+	if data["files"] != 0 || data["hashedBytes"] != int64(0) || data["deleted"] != 1 {
+		t.Errorf("Unexpected stats for the subdir scan: %v", data)
+	}
+	if subDirs := data["subdirs"].([]string); len(subDirs) != 1 || subDirs[0] != "dir" {
+		t.Errorf("Expected the scanned subdir to be reported, got %v", subDirs)
+	}
+}
+
+func TestDeferScanWhilePulling(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	f.DeferScanWhilePulling = true
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	must(t, writeFile(ffs, "new", []byte("data"), 0644))
+	atomic.StoreInt32(&m.pullsHoldingIO, 1)
+	must(t, f.scanTimerFired())
+	if reason, _ := f.ScanDelay(); reason == "" {
+		t.Error("Expected the scan to be delayed")
+	}
+	snap := dbSnapshot(t, m, f.ID)
+	if _, ok := snap.Get(protocol.LocalDeviceID, "new"); ok {
+		t.Error("Expected no scan while another folder is pulling")
+	}
+	snap.Release()
+
+	atomic.StoreInt32(&m.pullsHoldingIO, 0)
+	must(t, f.scanTimerFired())
+	snap = dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if _, ok := snap.Get(protocol.LocalDeviceID, "new"); !ok {
+		t.Error("Expected the file to be scanned once pulling is done")
+	}
+}
+
+func TestScanOutsideWindowDeferred(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	// A window spanning midnight that starts in two hours.
+	now := time.Now()
+	f.ScanWindows = []string{now.Add(2*time.Hour).Format("15:04") + "-" + now.Add(-time.Hour).Format("15:04")}
+
+	must(t, writeFile(ffs, "new", []byte("data"), 0644))
+	must(t, f.scanTimerFired())
+	reason, until := f.ScanDelay()
+	if reason == "" {
+		t.Fatal("Expected the scan to be delayed")
+	}
+	if d := time.Until(until); d < time.Hour || d > 2*time.Hour {
+		t.Errorf("Expected the scan to be delayed to the window start, got %v", d)
+	}
+	snap := dbSnapshot(t, m, f.ID)
+	if _, ok := snap.Get(protocol.LocalDeviceID, "new"); ok {
+		t.Error("Expected no scan outside the window")
+	}
+	snap.Release()
+
+	f.ScanWindows = []string{now.Add(-time.Hour).Format("15:04") + "-" + now.Add(time.Hour).Format("15:04")}
+	must(t, f.scanTimerFired())
+	snap = dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if _, ok := snap.Get(protocol.LocalDeviceID, "new"); !ok {
+		t.Error("Expected the file to be scanned within the window")
+	}
+}
+
+func TestScanRequestedOutsideWindow(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	// A window spanning midnight that starts in two hours.
+	now := time.Now()
+	f.ScanWindows = []string{now.Add(2*time.Hour).Format("15:04") + "-" + now.Add(-time.Hour).Format("15:04")}
+
+	// As if requested through DelayScan(0).
+	f.scanTimerRequested = true
+	must(t, writeFile(ffs, "new", []byte("data"), 0644))
+	must(t, f.scanTimerFired())
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if _, ok := snap.Get(protocol.LocalDeviceID, "new"); !ok {
+		t.Error("Expected the requested scan to happen outside the window")
+	}
+	if f.scanTimerRequested {
+		t.Error("Expected the next timer scan to respect the window again")
+	}
+}
+
+func TestDeterministicRescan(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	f.scanInterval = time.Hour
+	now := time.Now()
+	jittered := false
+	for i := 0; i < 100; i++ {
+		interval := f.rescanInterval(now)
+		if interval < 45*time.Minute || interval > 75*time.Minute {
+			t.Fatalf("Expected the interval to be within 25%% of an hour, got %v", interval)
+		}
+		if interval != time.Hour {
+			jittered = true
+		}
+	}
+	if !jittered {
+		t.Error("Expected random jitter by default")
+	}
+
+	f.DeterministicRescan = true
+	for i := 0; i < 100; i++ {
+		if interval := f.rescanInterval(now); interval != time.Hour {
+			t.Fatalf("Expected an interval of exactly an hour, got %v", interval)
+		}
+	}
+}
+
+func TestScanErrorsCoalesced(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	n := 2 * scanErrorLogThreshold
+	for i := 0; i < n; i++ {
+		path := fmt.Sprintf("file%d", i)
+		f.newScanError(path, &os.PathError{Op: "open", Path: path, Err: os.ErrPermission})
+	}
+	f.newScanError("other", errors.New("other error"))
+
+	f.errorsMut.Lock()
+	counts := f.scanErrorCounts
+	f.errorsMut.Unlock()
+	if len(counts) != 2 || counts["open: "+os.ErrPermission.Error()] != n || counts["other error"] != 1 {
+		t.Errorf("Expected the permission errors to be coalesced, got %v", counts)
+	}
+	// Every item is still reported individually.
+	if errs := f.Errors(); len(errs) != n+1 {
+		t.Errorf("Expected %d errors, got %d", n+1, len(errs))
+	}
+
+	f.logScanErrorSummary()
+	if f.scanErrorCounts != nil {
+		t.Error("Expected the counts to be reset after the summary")
+	}
+}
+
+func TestIsPathIgnored(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	must(t, f.ignores.Parse(strings.NewReader("// comment\n!keep.tmp\n*.tmp\n"), ".stignore"))
+
+	cases := []struct {
+		path    string
+		ignored bool
+		pattern string
+	}{
+		{"file", false, ""},
+		{"dir/file.tmp", true, ".stignore:3: *.tmp"},
+		{"keep.tmp", false, ".stignore:2: !keep.tmp"},
+		{".stfolder", true, ""},
+	}
+	for _, tc := range cases {
+		ignored, pattern, err := f.IsPathIgnored(tc.path)
+		must(t, err)
+		if ignored != tc.ignored || pattern != tc.pattern {
+			t.Errorf("%v: expected %v %q, got %v %q", tc.path, tc.ignored, tc.pattern, ignored, pattern)
+		}
+	}
+
+	if _, _, err := f.IsPathIgnored("../outside"); err == nil {
+		t.Error("Expected an error for a path outside the folder")
+	}
+}
+
+func TestFolderErrorChangedEvent(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	sub := m.evLogger.Subscribe(events.FolderErrorChanged)
+	defer sub.Unsubscribe()
+
+	expect := func(previous, cur string) {
+		t.Helper()
+		ev, err := sub.Poll(time.Second)
+		if err != nil {
+			t.Fatal("Expected an event:", err)
+		}
+		data := ev.Data.(map[string]string)
+		if data["folder"] != f.ID || data["previous"] != previous || data["error"] != cur {
+			t.Errorf("Unexpected event data %v", data)
+		}
+	}
+
+	f.setError(errors.New("first"))
+	expect("", "first")
+	// Repeating the same error is not a transition.
+	f.setError(errors.New("first"))
+	f.setError(errors.New("second"))
+	expect("first", "second")
+	f.setError(nil)
+	expect("second", "")
+	f.setError(nil)
+	if _, err := sub.Poll(100 * time.Millisecond); err == nil {
+		t.Error("Expected no event without a transition")
+	}
+}
+
+func TestFolderScanPhaseEvents(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	must(t, writeFile(f.Filesystem(), "file", []byte("content"), 0644))
+
+	sub := m.evLogger.Subscribe(events.FolderScanPhase)
+	defer sub.Unsubscribe()
+
+	must(t, f.scanSubdirs(nil))
+
+	var phases []string
+	for {
+		ev, err := sub.Poll(100 * time.Millisecond)
+		if err != nil {
+			break
+		}
+		data := ev.Data.(map[string]string)
+		if data["folder"] != f.ID {
+			t.Errorf("Unexpected folder in %v", data)
+		}
+		phases = append(phases, data["phase"])
+	}
+	expected := []string{scanPhaseWalking, scanPhaseHashing, scanPhaseDetectingDeletes, scanPhaseFlushing}
+	if !reflect.DeepEqual(phases, expected) {
+		t.Errorf("Expected phases %v, got %v", expected, phases)
+	}
+
+	// Dry runs don't emit events.
+	must(t, f.scanSubdirsWithOptions(nil, scanOptions{dryRun: func([]protocol.FileInfo) {}}))
+	if ev, err := sub.Poll(100 * time.Millisecond); err == nil {
+		t.Errorf("Expected no event during a dry run, got %v", ev)
+	}
+
+	// A truncated scan still flushes what it scanned.
+	if err := f.scanSubdirsWithOptions(nil, scanOptions{maxDuration: time.Nanosecond}); err != errScanTruncated {
+		t.Fatalf("Expected the scan to be truncated, got %v", err)
+	}
+	var last string
+	for {
+		ev, err := sub.Poll(100 * time.Millisecond)
+		if err != nil {
+			break
+		}
+		last = ev.Data.(map[string]string)["phase"]
+	}
+	if last != scanPhaseFlushing {
+		t.Errorf("Expected a truncated scan to end flushing, got %v", last)
+	}
+}
+
+func TestLastPullCompletedOnlyIfPulled(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	// Nothing to pull.
+	if ok, err := f.folder.pull(); !ok || err != nil {
+		t.Fatalf("Expected the pull to succeed, got %v, %v", ok, err)
+	}
+	if last, err := f.LastPullCompleted(); err != nil || !last.IsZero() {
+		t.Errorf("Expected no pull to be recorded without anything to pull, got %v (%v)", last, err)
+	}
+
+	f.fset.Update(device1, []protocol.FileInfo{{
+		Name:    "dir",
+		Type:    protocol.FileInfoTypeDirectory,
+		Version: protocol.Vector{}.Update(device1.Short()),
+	}})
+	if ok, err := f.folder.pull(); !ok || err != nil {
+		t.Fatalf("Expected the pull to succeed, got %v, %v", ok, err)
+	}
+	if last, err := f.LastPullCompleted(); err != nil || time.Since(last) > 5*time.Second {
+		t.Errorf("Expected the pull to be recorded, got %v (%v)", last, err)
+	}
+}
+
+func TestTransferTotals(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	must(t, f.ResetTransferTotals())
+
+	file := protocol.FileInfo{Name: "file", Type: protocol.FileInfoTypeFile, Size: 100, Version: protocol.Vector{}.Update(device1.Short())}
+	f.updateLocalsFromScanning([]protocol.FileInfo{file})
+	if totals, err := m.TransferTotals(f.ID); err != nil || totals != (stats.TransferTotals{}) {
+		t.Errorf("Expected scanned changes not to be counted, got %+v (%v)", totals, err)
+	}
+
+	dir := protocol.FileInfo{Name: "dir", Type: protocol.FileInfoTypeDirectory, Version: protocol.Vector{}.Update(device1.Short())}
+	deleted := protocol.FileInfo{Name: "gone", Type: protocol.FileInfoTypeFile, Deleted: true, Version: protocol.Vector{}.Update(device1.Short())}
+	ignored := protocol.FileInfo{Name: "ignored", Type: protocol.FileInfoTypeFile, Size: 50, LocalFlags: protocol.FlagLocalIgnored}
+	f.updateLocalsFromPulling([]protocol.FileInfo{file, dir, deleted, ignored})
+	expected := stats.TransferTotals{BytesPulled: 100, FilesPulled: 1, FilesDeleted: 1}
+	if totals, err := m.TransferTotals(f.ID); err != nil || totals != expected {
+		t.Errorf("Expected totals %+v, got %+v (%v)", expected, totals, err)
+	}
+}
+
+func TestScanTruncatedKeepsUnwalked(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "a", []byte("aaa"), 0644))
+	must(t, f.scanSubdirs(nil))
+	must(t, ffs.Remove("a"))
+
+	err := f.scanSubdirsWithOptions(nil, scanOptions{maxDuration: time.Nanosecond})
+	if err != errScanTruncated {
+		t.Fatalf("Expected the scan to be truncated, got %v", err)
+	}
+	snap := dbSnapshot(t, m, f.ID)
+	file, ok := snap.Get(protocol.LocalDeviceID, "a")
+	snap.Release()
+	if !ok || file.IsDeleted() {
+		t.Error("Expected a truncated scan not to detect deletions")
+	}
+
+	must(t, f.scanSubdirs(nil))
+	snap = dbSnapshot(t, m, f.ID)
+	file, _ = snap.Get(protocol.LocalDeviceID, "a")
+	snap.Release()
+	if !file.IsDeleted() {
+		t.Error("Expected the next complete scan to detect the deletion")
+	}
+}
+
+func TestScanTruncatedResumes(t *testing.T) {
+	w, wCancel := createTmpWrapper(defaultCfgWrapper.RawCopy())
+	defer wCancel()
+	fcfg := testFolderConfigFake()
+	fcfg.Path = t.Name() + "?files=20&sizeavg=100&latency=1ms"
+	setFolder(t, w, fcfg)
+	m := setupModel(t, w)
+	m.cancel()
+	<-m.stopped
+	defer cleanupModel(m)
+	f := m.folderRunners[fcfg.ID].(*sendReceiveFolder)
+	f.ctx = context.Background()
+	ffs := f.Filesystem()
+
+	must(t, f.scanSubdirs(nil))
+	var files []string
+	must(t, ffs.Walk(".", func(path string, info fs.FileInfo, err error) error {
+		if err == nil && info.IsRegular() {
+			files = append(files, path)
+		}
+		return err
+	}))
+	mtime := time.Now().Truncate(time.Second)
+	for _, name := range files[1:] {
+		must(t, ffs.Chtimes(name, mtime, mtime))
+	}
+	must(t, ffs.Remove(files[0]))
+
+	// Each timer scan runs out of time, continuing where the last one
+	// stopped until the folder was walked entirely.
+	walkOrder := strings.NewReplacer(string(fs.PathSeparator), "\x00")
+	truncated := 0
+	last := ""
+	for {
+		must(t, f.scanTimerSubdirsWithOptions(scanOptions{maxDuration: 30 * time.Millisecond}))
+		if f.scanResume == nil {
+			break
+		}
+		if walkOrder.Replace(f.scanResume.at) <= walkOrder.Replace(last) {
+			t.Fatalf("Expected the scan to get further than %v, stopped at %v", last, f.scanResume.at)
+		}
+		last = f.scanResume.at
+		truncated++
+		if truncated > len(files) {
+			t.Fatal("Expected the scans to get through eventually")
+		}
+	}
+	if truncated < 2 {
+		t.Fatalf("Expected back to back truncated scans, got %v", truncated)
+	}
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if file, _ := snap.Get(protocol.LocalDeviceID, files[0]); !file.IsDeleted() {
+		t.Error("Expected the resumed scan to detect the deletion")
+	}
+	for _, name := range files[1:] {
+		if file, _ := snap.Get(protocol.LocalDeviceID, name); !file.ModTime().Equal(mtime) {
+			t.Errorf("Expected %v to be rescanned with the new modification time, got %v", name, file.ModTime())
+		}
+	}
+}
+
+func TestScanWithHashers(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	for _, hashers := range []int{0, -1, runtime.NumCPU() + 1} {
+		if err := f.ScanWithHashers(nil, hashers); !errors.Is(err, ErrHashersOutOfRange) {
+			t.Errorf("Expected %d hashers to be rejected, got %v", hashers, err)
+		}
+	}
+
+	// Run the scan, as the serve loop isn't running.
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+	f.done = make(chan struct{})
+	go func() {
+		req := <-f.doInSyncChan
+		req.err <- req.fn()
+	}()
+	must(t, writeFile(f.Filesystem(), "file", []byte("content"), 0644))
+	must(t, f.ScanWithHashers(nil, runtime.NumCPU()))
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if _, ok := snap.Get(protocol.LocalDeviceID, "file"); !ok {
+		t.Error("Expected the file to be scanned")
+	}
+}
+
+func TestNestedFolderError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping unsupported symlink test")
+	}
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	setFolder := func(id, path string, paused bool) {
+		t.Helper()
+		fcfg := testFolderConfig(path)
+		fcfg.ID = id
+		fcfg.Paused = paused
+		waiter, err := m.cfg.Modify(func(cfg *config.Configuration) {
+			cfg.SetFolder(fcfg)
+		})
+		must(t, err)
+		waiter.Wait()
+	}
+
+	// A common prefix isn't nesting.
+	sibling := f.Path + "-sibling"
+	defer os.RemoveAll(sibling)
+	setFolder("sibling", sibling, false)
+	must(t, f.checkNestedFolder())
+
+	// The outer folder is configured through a symlink to the parent.
+	linkDir := createTmpDir()
+	defer os.RemoveAll(linkDir)
+	link := filepath.Join(linkDir, "link")
+	must(t, os.Symlink(filepath.Dir(f.Path), link))
+	setFolder("outer", link, true)
+	must(t, f.checkNestedFolder())
+
+	setFolder("outer", link, false)
+	if err := f.getHealthErrorWithoutIgnores(); !errors.Is(err, ErrFolderNested) {
+		t.Errorf("Expected the folder to be nested, got %v", err)
+	}
+}
+
+func TestVerifyBeforePull(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "known", []byte("aaa"), 0644))
+	must(t, f.scanSubdirs(nil))
+	must(t, writeFile(ffs, "known", []byte("changed"), 0644))
+	must(t, writeFile(ffs, "unknown", []byte("bbb"), 0644))
+
+	f.ScheduleVerifyBeforePull([]string{"known", "unknown"})
+	must(t, f.verifyBeforePull())
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if fi, ok := snap.Get(protocol.LocalDeviceID, "known"); !ok || fi.Size != 7 {
+		t.Errorf("Expected the local change to be scanned, got %v", fi)
+	}
+	// Scanning an unknown item would scan its parent, i.e. the whole folder.
+	if _, ok := snap.Get(protocol.LocalDeviceID, "unknown"); ok {
+		t.Error("Expected only known items to be scanned")
+	}
+	if len(f.verifyPaths) != 0 {
+		t.Error("Expected the scheduled items to be consumed")
+	}
+}
+
+func TestVerifyBeforePullMany(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, f.scanSubdirs(nil))
+	must(t, writeFile(ffs, "unknown", []byte("bbb"), 0644))
+
+	names := make([]string, maxVerifyPaths+1)
+	for i := range names {
+		names[i] = fmt.Sprintf("file%d", i)
+	}
+	f.ScheduleVerifyBeforePull(names)
+	if len(f.verifyPaths) != 0 || !f.verifyAll {
+		t.Fatalf("Expected a scan of the entire folder to be scheduled, got %d items", len(f.verifyPaths))
+	}
+	must(t, f.verifyBeforePull())
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	if _, ok := snap.Get(protocol.LocalDeviceID, "unknown"); !ok {
+		t.Error("Expected the entire folder to be scanned")
+	}
+	if f.verifyAll {
+		t.Error("Expected the scheduled scan to be consumed")
+	}
+}
+
+func TestVerifyBeforePullFailed(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, writeFile(ffs, "known", []byte("aaa"), 0644))
+	must(t, f.scanSubdirs(nil))
+	must(t, os.RemoveAll(ffs.URI()))
+
+	f.ScheduleVerifyBeforePull([]string{"known"})
+	if err := f.verifyBeforePull(); err == nil {
+		t.Fatal("Expected verifying to fail without the folder")
+	}
+	if _, ok := f.verifyPaths["known"]; !ok {
+		t.Error("Expected the item to be verified again on the next pull")
+	}
+
+	f.verifyPaths = make(map[string]struct{})
+	f.verifyAll = true
+	if err := f.verifyBeforePull(); err == nil {
+		t.Fatal("Expected verifying to fail without the folder")
+	}
+	if !f.verifyAll {
+		t.Error("Expected the entire folder to be verified again on the next pull")
+	}
+}
+
+func TestCoalesceForcedRescans(t *testing.T) {
+	paths := []string{"other", filepath.Join("dir", "sub", "file")}
+	for i := 0; i < 10000; i++ {
+		paths = append(paths, filepath.Join("dir", fmt.Sprintf("file%d", i)))
+	}
+	expected := []string{"dir", "other"}
+	if out := coalesceForcedRescans(paths); !reflect.DeepEqual(out, expected) {
+		t.Errorf("Got %v, expected %v", out, expected)
+	}
+
+	paths = paths[:0]
+	for i := 0; i < 10000; i++ {
+		paths = append(paths, fmt.Sprintf("file%d", i))
+	}
+	if out := coalesceForcedRescans(paths); out != nil {
+		t.Errorf("Expected a scan of the entire folder, got %v", out)
+	}
+}
+
+func TestPendingScanCoalescing(t *testing.T) {
+	f := &folder{
+		scanPendingMut:     sync.NewMutex(),
+		scanPendingChanged: make(chan struct{}, 1),
+	}
+
+	f.addPendingScan([]string{"foo/bar", "baz"})
+	f.addPendingScan([]string{"foo", "baz/quux"})
+	select {
+	case <-f.scanPendingChanged:
+	default:
+		t.Fatal("Expected a scan to be queued")
+	}
+	select {
+	case <-f.scanPendingChanged:
+		t.Fatal("Expected only one queued scan")
+	default:
+	}
+	subDirs, ok := f.takePendingScan()
+	expected := []string{"baz", "foo"}
+	if diff, equal := messagediff.PrettyDiff(expected, subDirs); !ok || !equal {
+		t.Errorf("Got %v, expected %v, diff:\n%s", subDirs, expected, diff)
+	}
+	if _, ok := f.takePendingScan(); ok {
+		t.Error("Expected nothing pending after taking")
+	}
+
+	// A full scan request subsumes everything.
+	f.addPendingScan([]string{"foo"})
+	f.addPendingScan([]string{""})
+	f.addPendingScan([]string{"bar"})
+	if subDirs, ok := f.takePendingScan(); !ok || subDirs != nil {
+		t.Errorf("Expected full scan, got %v (%v)", subDirs, ok)
+	}
+
+	f.addPendingScan([]string{"foo"})
+	f.clearPendingScan()
+	if _, ok := f.takePendingScan(); ok {
+		t.Error("Expected nothing pending after clearing")
+	}
+}
+
+func TestScanActivityInterval(t *testing.T) {
+	configured := time.Hour
+	cfg := config.FolderConfiguration{
+		AdaptiveScanThreshold: 10,
+		AdaptiveScanIntervalS: 60,
+		AdaptiveScanQuietS:    600,
+	}
+	a := newScanActivity(cfg)
+	start := time.Now()
+
+	if a.record(9, start) {
+		t.Error("Expected no burst below the threshold")
+	}
+	if d := a.interval(configured, start); d != configured {
+		t.Errorf("Expected configured interval without a burst, got %v", d)
+	}
+	// Changes outside the window don't add up to a burst.
+	if a.record(9, start.Add(2*time.Minute)) {
+		t.Error("Expected no burst after the window passed")
+	}
+	burst := start.Add(2*time.Minute + time.Second)
+	if !a.record(1, burst) {
+		t.Error("Expected a burst at the threshold")
+	}
+	if a.record(1, burst) {
+		t.Error("Expected an ongoing burst not to start again")
+	}
+
+	for _, tc := range []struct {
+		since    time.Duration
+		expected time.Duration
+	}{
+		{0, time.Minute},
+		{10 * time.Minute, 2 * time.Minute},
+		{25 * time.Minute, 4 * time.Minute},
+		{60 * time.Minute, 60 * time.Minute},
+	} {
+		if d := a.interval(configured, burst.Add(tc.since)); d != tc.expected {
+			t.Errorf("Expected %v after %v, got %v", tc.expected, tc.since, d)
+		}
+	}
+	// Back at the configured interval, the burst is over.
+	if d := a.interval(configured, burst); d != configured {
+		t.Errorf("Expected configured interval after decaying, got %v", d)
+	}
+
+	// Disabled
+	a = newScanActivity(config.FolderConfiguration{AdaptiveScanIntervalS: 60, AdaptiveScanQuietS: 600})
+	if a.record(1000, start) || a.interval(configured, start) != configured {
+		t.Error("Expected adaptive scanning to be disabled")
+	}
+}
+
+func TestPullConcurrencyAdapts(t *testing.T) {
+	const mib = 1 << 20
+	c := newPullConcurrency(4*mib, 6*mib)
+	limiter := c.newLimiter()
+	now := time.Now()
+
+	window := func(bytes int, rtt time.Duration) {
+		t.Helper()
+		c.requestDone(bytes, rtt, nil, now)
+		now = now.Add(adaptivePullWindow)
+		c.requestDone(bytes, rtt, nil, now)
+	}
+	expect := func(expected int) {
+		t.Helper()
+		if c.current != expected {
+			t.Errorf("Expected %v bytes pending, got %v", expected, c.current)
+		}
+		if limiter.max != expected {
+			t.Errorf("Expected limiter capacity %v, got %v", expected, limiter.max)
+		}
+	}
+
+	// Increasing throughput ramps up to the cap.
+	window(mib, 10*time.Millisecond)
+	expect(5 * mib)
+	window(2*mib, 10*time.Millisecond)
+	expect(6 * mib)
+	window(4*mib, 10*time.Millisecond)
+	expect(6 * mib)
+
+	// Steady throughput keeps it, inflated round trip times back off.
+	window(4*mib, 10*time.Millisecond)
+	expect(6 * mib)
+	window(4*mib, 30*time.Millisecond)
+	expect(6 * mib * 3 / 4)
+
+	// Timeouts halve it, other errors don't.
+	c.requestDone(mib, 0, errors.New("no such file"), now)
+	expect(6 * mib * 3 / 4)
+	c.requestDone(mib, 0, protocol.ErrTimeout, now)
+	expect(6 * mib * 3 / 8)
+
+	// Never below a single block.
+	for i := 0; i < 10; i++ {
+		c.requestDone(mib, 0, protocol.ErrClosed, now)
+	}
+	expect(protocol.MinBlockSize)
+}
+
+func TestScanOnWatchErrPolicy(t *testing.T) {
+	cases := []struct {
+		policy   config.WatchErrorPolicy
+		failures int
+		scan     bool
+	}{
+		{config.WatchErrorPolicyFullRescan, 1, true},
+		{config.WatchErrorPolicyTargeted, 1, false},
+		{config.WatchErrorPolicyTargeted, 10, false},
+		{config.WatchErrorPolicyEscalate, 2, false},
+		{config.WatchErrorPolicyEscalate, 3, true},
+	}
+
+	for _, tc := range cases {
+		f := &folder{
+			FolderConfiguration: config.FolderConfiguration{
+				WatchErrorPolicy:      tc.policy,
+				WatchErrorRescanAfter: 3,
+			},
+			watchMut:  sync.NewMutex(),
+			scanDelay: make(chan scanDelayRequest, 1),
+		}
+		if f.scanOnWatchErr(tc.failures) {
+			t.Errorf("%v: Expected no scan without a watch error", tc.policy)
+		}
+
+		f.watchErr = errors.New("watch failed")
+		if scan := f.scanOnWatchErr(tc.failures); scan != tc.scan {
+			t.Errorf("%v after %d failures: Got scan %v, expected %v", tc.policy, tc.failures, scan, tc.scan)
+		}
+		select {
+		case <-f.scanDelay:
+			if !tc.scan {
+				t.Errorf("%v after %d failures: Unexpected scan scheduled", tc.policy, tc.failures)
+			}
+		default:
+			if tc.scan {
+				t.Errorf("%v after %d failures: Expected a scan to be scheduled", tc.policy, tc.failures)
+			}
+		}
+	}
+}
+
+func TestWatchStatus(t *testing.T) {
+	f := &folder{
+		watchMut:     sync.NewMutex(),
+		stateTracker: newStateTracker("", events.NoopLogger),
+	}
+
+	before := time.Now()
+	f.setWatchError(errors.New("watch failed"), 34*time.Minute, 3)
+	err, next, failures := f.WatchStatus()
+	if err == nil || failures != 3 {
+		t.Errorf("Expected an error after 3 failures, got %v after %d", err, failures)
+	}
+	if next.Before(before.Add(34*time.Minute)) || next.After(time.Now().Add(34*time.Minute)) {
+		t.Errorf("Expected the next retry in 34m, got %v", next)
+	}
+
+	f.setWatchError(nil, 0, 3)
+	if err, next, failures := f.WatchStatus(); err != nil || !next.IsZero() || failures != 0 {
+		t.Errorf("Expected a zero status once healthy, got %v, %v, %d", err, next, failures)
+	}
+}
+
+func TestRestartWatchNow(t *testing.T) {
+	w, fcfg, wCancel := tmpDefaultWrapper()
+	defer wCancel()
+	defer os.RemoveAll(fcfg.Path)
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+
+	if err := m.RestartWatch(context.Background(), fcfg.ID); err != errWatchNotEnabled {
+		t.Errorf("Expected %v, got %v", errWatchNotEnabled, err)
+	}
+
+	fcfg.FSWatcherEnabled = true
+	setFolder(t, w, fcfg)
+	if err := m.RestartWatch(context.Background(), fcfg.ID); err != nil {
+		t.Error("Expected watching to start, got", err)
+	}
+
+	// The fake filesystem doesn't support watching.
+	fcfg.FilesystemType = fs.FilesystemTypeFake
+	fcfg.Path = "TestRestartWatchNow"
+	setFolder(t, w, fcfg)
+	if err := m.RestartWatch(context.Background(), fcfg.ID); !errors.Is(err, fs.ErrWatchNotSupported) {
+		t.Errorf("Expected %v, got %v", fs.ErrWatchNotSupported, err)
+	}
+}
+
+func TestPruningWatchMatcher(t *testing.T) {
+	ffs := fs.NewFilesystem(fs.FilesystemTypeFake, "")
+	matcher := ignore.New(ffs)
+	must(t, matcher.Parse(strings.NewReader("!/build/keep\n/build\n/out\n"), ""))
+
+	m := pruningWatchMatcher{matcher}
+	cases := map[string]bool{
+		"out":       true,  // nothing below can be unignored
+		"build":     false, // /build/keep is unignored
+		"src":       false, // not ignored
+		"build/obj": true,
+	}
+	for name, prune := range cases {
+		if got := m.CanPruneWatch(name); got != prune {
+			t.Errorf("%v: Got prune %v, expected %v", name, got, prune)
+		}
+	}
+}
+
+func TestThrottledPullEvents(t *testing.T) {
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+	sub := evLogger.Subscribe(events.RemoteChangeDetected | events.RemoteChangeSummary)
+	defer sub.Unsubscribe()
+
+	f := &folder{
+		FolderConfiguration: config.FolderConfiguration{ID: "throttled", PullEventsPerS: 2},
+		stateTracker:        stateTracker{evLogger: evLogger},
+	}
+	expectEvents := func(expected ...events.EventType) []events.Event {
+		t.Helper()
+		var evs []events.Event
+		for _, typ := range expected {
+			ev, err := sub.Poll(time.Second)
+			if err != nil {
+				t.Fatalf("Expected %v event, got %v", typ, err)
+			}
+			if ev.Type != typ {
+				t.Fatalf("Expected %v event, got %v", typ, ev.Type)
+			}
+			evs = append(evs, ev)
+		}
+		if ev, err := sub.Poll(10 * time.Millisecond); err != events.ErrTimeout {
+			t.Fatalf("Expected no further events, got %v", ev.Type)
+		}
+		return evs
+	}
+
+	now := time.Now()
+	f.emitThrottledPullEvents([]protocol.FileInfo{
+		{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d", Deleted: true}, {Name: "e", LocalFlags: protocol.FlagLocalIgnored},
+	}, now)
+	expectEvents(events.RemoteChangeDetected, events.RemoteChangeDetected)
+
+	// The next second starts with a summary of what was left out before.
+	f.emitThrottledPullEvents([]protocol.FileInfo{{Name: "f"}}, now.Add(time.Second))
+	evs := expectEvents(events.RemoteChangeSummary, events.RemoteChangeDetected)
+	data := evs[0].Data.(map[string]interface{})
+	if data["modified"] != 1 || data["deleted"] != 1 {
+		t.Errorf("Unexpected summary %v", data)
+	}
+
+	f.emitThrottledPullEvents([]protocol.FileInfo{{Name: "g"}, {Name: "h"}}, now.Add(time.Second))
+	expectEvents(events.RemoteChangeDetected)
+	f.flushPullEventSummary()
+	evs = expectEvents(events.RemoteChangeSummary)
+	if data := evs[0].Data.(map[string]interface{}); data["modified"] != 1 || data["deleted"] != 0 {
+		t.Errorf("Unexpected summary %v", data)
+	}
+	f.flushPullEventSummary()
+	expectEvents()
+}
+
+func TestScanProgress(t *testing.T) {
+	p := newScanProgress()
+	now := time.Now()
+	file := protocol.FileInfo{Name: "a", Type: protocol.FileInfoTypeFile, Size: 100}
+
+	if current, total, rate := p.get(now); current != 0 || total != 0 || rate != 0 {
+		t.Fatal("Expected no progress when idle, got", current, total, rate)
+	}
+
+	p.start(false, now)
+	p.scanned(file)
+	p.scanned(protocol.FileInfo{Name: "dir", Type: protocol.FileInfoTypeDirectory})
+	p.setTotal(2, 300)
+	if current, total, rate := p.get(now.Add(2 * time.Second)); current != 100 || total != 300 || rate != 50 {
+		t.Error("Unexpected progress", current, total, rate)
+	}
+	p.stop()
+	if current, total, _ := p.get(now); current != 0 || total != 0 {
+		t.Error("Expected no progress after the scan, got", current, total)
+	}
+
+	// Without hashing files are counted.
+	p.start(true, now)
+	if current, total, _ := p.get(now); current != 0 || total != 0 {
+		t.Error("Expected progress to be reset, got", current, total)
+	}
+	p.scanned(file)
+	p.setTotal(2, 300)
+	if current, total, _ := p.get(now); current != 1 || total != 2 {
+		t.Error("Unexpected progress in files", current, total)
+	}
+}
+
+func TestScanChangedDirsOnly(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	f.ScanChangedDirsOnly = true
+	f.FullRescanEvery = 3
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	for _, dir := range []string{"a", "b"} {
+		must(t, ffs.MkdirAll(filepath.Join(dir, "sub"), 0755))
+		must(t, writeFile(ffs, filepath.Join(dir, "sub", "file"), []byte("content"), 0644))
+	}
+	has := func(name string) bool {
+		t.Helper()
+		snap := dbSnapshot(t, m, f.ID)
+		defer snap.Release()
+		fi, ok := snap.Get(protocol.LocalDeviceID, name)
+		return ok && !fi.IsDeleted()
+	}
+
+	must(t, f.scanTimerSubdirs())
+	if !has("a/sub/file") || !has("b/sub/file") {
+		t.Fatal("Expected the first timer scan to be a full one")
+	}
+	// Directories unknown before the full scan have no stored mtime yet,
+	// thus are scanned once more.
+	must(t, f.scanTimerSubdirs())
+
+	// A deletion within a is found by the changed mtime of a/sub, while
+	// an addition to b isn't if its mtime is reset, as on a filesystem
+	// not updating directory mtimes.
+	must(t, ffs.Remove(filepath.Join("a", "sub", "file")))
+	info, err := ffs.Lstat(filepath.Join("b", "sub"))
+	must(t, err)
+	must(t, writeFile(ffs, filepath.Join("b", "sub", "new"), []byte("content"), 0644))
+	must(t, ffs.Chtimes(filepath.Join("b", "sub"), info.ModTime(), info.ModTime()))
+
+	must(t, f.scanTimerSubdirs())
+	if has("a/sub/file") {
+		t.Error("Expected the deletion to be detected")
+	}
+	if has("b/sub/new") {
+		t.Error("Expected the unchanged directory not to be scanned")
+	}
+
+	must(t, f.scanTimerSubdirs())
+	if !has("b/sub/new") {
+		t.Error("Expected the periodic full scan to find the new file")
+	}
+}
+
+func TestScanReadLimit(t *testing.T) {
+	ffs := fs.NewFilesystem(fs.FilesystemTypeFake, "TestScanReadLimit")
+	must(t, writeFile(ffs, "file", make([]byte, 4*scanReadBurst), 0644))
+
+	f := &folder{mtimefs: ffs}
+	if f.scanFilesystem(context.Background()) != ffs {
+		t.Fatal("Expected reads to be unlimited without limit")
+	}
+
+	// The limiter is shared by all reads: Once the burst is read, reading
+	// more at 1 KiB/s can't finish before the deadline.
+	f.scanReadLimiter = newScanReadLimiter(1)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	lfs := f.scanFilesystem(ctx)
+	first, err := lfs.Open("file")
+	must(t, err)
+	defer first.Close()
+	_, err = io.ReadFull(first, make([]byte, scanReadBurst))
+	must(t, err)
+	second, err := lfs.Open("file")
+	must(t, err)
+	defer second.Close()
+	if _, err := io.ReadFull(second, make([]byte, scanReadBurst)); err == nil {
+		t.Error("Expected reading beyond the limit to fail before the deadline")
+	}
+}
+
+func TestRenameCache(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	// Files with the same contents share the candidates looked up for the
+	// first rename.
+	must(t, writeFile(ffs, "a", []byte("same"), 0644))
+	must(t, writeFile(ffs, "b", []byte("same"), 0644))
+	must(t, f.scanSubdirs(nil))
+	must(t, ffs.Rename("a", "c"))
+	must(t, ffs.Rename("b", "d"))
+	must(t, f.scanSubdirs(nil))
+	if f.scanStats.renamed != 2 {
+		t.Errorf("Expected 2 renames, got %v", f.scanStats.renamed)
+	}
+	if f.renameCache != nil {
+		t.Error("Expected the cache to be dropped after the scan")
+	}
+
+	// Stale entries are skipped.
+	must(t, writeFile(ffs, "e", []byte("diff"), 0644))
+	must(t, f.scanSubdirs(nil))
+	must(t, ffs.Remove("e"))
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	file, ok := snap.Get(protocol.LocalDeviceID, "c")
+	if !ok {
+		t.Fatal("Expected c in the db")
+	}
+	file.Name = "f"
+	f.renameCache = newRenameCache(1)
+	f.renameCache.add(file.BlocksHash, []string{"e"})
+	if nf, ok := f.findRename(snap, file, make(map[string]struct{}), false); ok {
+		t.Errorf("Expected no rename from a stale cache entry, got %v", nf.Name)
+	}
+}
+
+func TestMinFileAge(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	f.MinFileAgeS = 1
+
+	hourAgo := time.Now().Add(-time.Hour)
+	must(t, writeFile(ffs, "existing", []byte("aaa"), 0644))
+	must(t, ffs.Chtimes("existing", hourAgo, hourAgo))
+	must(t, f.scanSubdirs(nil))
+
+	must(t, writeFile(ffs, "existing", []byte("changed"), 0644))
+	must(t, writeFile(ffs, "new", []byte("bbb"), 0644))
+	must(t, f.scanSubdirs(nil))
+	snap := dbSnapshot(t, m, f.ID)
+	if fi, ok := snap.Get(protocol.LocalDeviceID, "existing"); !ok || fi.IsDeleted() || fi.Size != 3 {
+		t.Errorf("Expected the recently changed file to be unchanged in the db, got %v", fi)
+	}
+	if _, ok := snap.Get(protocol.LocalDeviceID, "new"); ok {
+		t.Error("Expected the recently created file to be skipped")
+	}
+	snap.Release()
+
+	// The skipped files are scanned once old enough.
+	select {
+	case <-f.scanPendingChanged:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a scan of the skipped files to be scheduled")
+	}
+	subDirs, _ := f.takePendingScan()
+	if strings.Join(subDirs, ",") != "existing,new" {
+		t.Errorf("Expected the skipped files to be scanned, got %v", subDirs)
+	}
+}
+
+func TestMinFileAgeStopped(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f.ctx = ctx
+	f.scanRecentFilesLater([]string{"recent"}, time.Now().Add(100*time.Millisecond))
+	cancel()
+
+	select {
+	case <-f.scanPendingChanged:
+		t.Error("Expected no scan to be scheduled after the folder stopped")
+	case <-time.After(time.Second):
+	}
+}
+
+func TestScanSkipsMarker(t *testing.T) {
+	// The marker is internal, thus never walked when scanning, while the
+	// health check stats it directly.
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	must(t, ffs.MkdirAll(filepath.Join(config.DefaultMarkerName, "sub"), 0755))
+	must(t, writeFile(ffs, filepath.Join(config.DefaultMarkerName, "file"), []byte("data"), 0644))
+	must(t, writeFile(ffs, "file", []byte("data"), 0644))
+
+	for _, subDirs := range [][]string{nil, {config.DefaultMarkerName}, {filepath.Join(config.DefaultMarkerName, "file")}} {
+		var files []protocol.FileInfo
+		must(t, f.scanSubdirsWithOptions(subDirs, scanOptions{
+			dryRun: func(fs []protocol.FileInfo) {
+				files = append(files, fs...)
+			},
+		}))
+		for _, fi := range files {
+			if fs.IsInternal(fi.Name) {
+				t.Errorf("Scanning %v: Expected no internal files, got %v", subDirs, fi.Name)
+			}
+		}
+	}
+	must(t, f.CheckPath())
+}
+
+func TestOnInitialScanComplete(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.initialScanFinished = make(chan struct{})
+
+	var calls []string
+	f.OnInitialScanComplete(func() { calls = append(calls, "before") })
+	if len(calls) != 0 {
+		t.Fatal("Expected no call before the initial scan")
+	}
+	must(t, f.scanTimerFired())
+	if strings.Join(calls, ",") != "before" {
+		t.Fatalf("Expected a call after the initial scan, got %v", calls)
+	}
+
+	// Later scans don't call again, later registrations are called right
+	// away.
+	must(t, f.scanTimerFired())
+	f.OnInitialScanComplete(func() { calls = append(calls, "after") })
+	if strings.Join(calls, ",") != "before,after" {
+		t.Errorf("Expected one call per registration, got %v", calls)
+	}
+}
+
+func TestFolderKeepTemporaries(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	if lifetime := f.tempLifetime(); lifetime != time.Hour {
+		t.Errorf("Expected the global lifetime of 1h, got %v", lifetime)
+	}
+	f.KeepTemporariesH = 48
+
+	must(t, ffs.MkdirAll(config.DefaultMarkerName, 0755))
+	kept, removed := fs.TempName("kept"), fs.TempName("removed")
+	for name, age := range map[string]time.Duration{kept: 24 * time.Hour, removed: 72 * time.Hour} {
+		must(t, writeFile(ffs, name, []byte("data"), 0644))
+		modTime := time.Now().Add(-age)
+		must(t, ffs.Chtimes(name, modTime, modTime))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	if _, err := ffs.Lstat(kept); err != nil {
+		t.Error("Expected the temporary file younger than the folder's lifetime to be kept:", err)
+	}
+	if _, err := ffs.Lstat(removed); !fs.IsNotExist(err) {
+		t.Error("Expected the temporary file older than the folder's lifetime to be removed:", err)
+	}
+}
+
+func TestScanSchedulesPullOnlyIfNeeded(t *testing.T) {
+	for _, typ := range []config.FolderType{
+		config.FolderTypeSendReceive,
+		config.FolderTypeSendOnly,
+		config.FolderTypeReceiveOnly,
+		config.FolderTypeReceiveEncrypted,
+	} {
+		t.Run(typ.String(), func(t *testing.T) {
+			w, fcfg, wCancel := tmpDefaultWrapper()
+			defer wCancel()
+			fcfg.Type = typ
+			setFolder(t, w, fcfg)
+			m := setupModel(t, w)
+			m.cancel()
+			<-m.stopped
+			defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+			var f *folder
+			switch r := m.folderRunners[fcfg.ID].(type) {
+			case *sendReceiveFolder:
+				f = &r.folder
+			case *sendOnlyFolder:
+				f = &r.folder
+			case *receiveOnlyFolder:
+				f = &r.folder
+			case *receiveEncryptedFolder:
+				f = &r.folder
+			}
+			f.ctx = context.Background()
+			drain := func() bool {
+				select {
+				case <-f.pullScheduled:
+					return true
+				default:
+					return false
+				}
+			}
+			drain()
+
+			must(t, writeFile(f.Filesystem(), "local", []byte("local"), 0644))
+			must(t, f.scanSubdirs(nil))
+			if drain() {
+				t.Error("Expected no pull to be scheduled without needed items")
+			}
+
+			f.fset.Update(device1, []protocol.FileInfo{{
+				Name:    "remote",
+				Type:    protocol.FileInfoTypeFile,
+				Size:    6,
+				Version: protocol.Vector{}.Update(device1.Short()),
+			}})
+			must(t, writeFile(f.Filesystem(), "local2", []byte("local"), 0644))
+			must(t, f.scanSubdirs(nil))
+			if !drain() {
+				t.Error("Expected a pull to be scheduled with a needed item")
+			}
+		})
+	}
+}
+
+func TestScanCancelledKeepsDeletions(t *testing.T) {
+	w, wCancel := createTmpWrapper(defaultCfgWrapper.RawCopy())
+	defer wCancel()
+	fcfg := testFolderConfigFake()
+	fcfg.Path = t.Name() + "?files=20&sizeavg=10&latency=1ms"
+	setFolder(t, w, fcfg)
+	m := setupModel(t, w)
+	m.cancel()
+	<-m.stopped
+	defer cleanupModel(m)
+	f := m.folderRunners[fcfg.ID].(*sendReceiveFolder)
+	f.ctx = context.Background()
+	ffs := f.Filesystem()
+
+	must(t, f.scanSubdirs(nil))
+	names, err := ffs.DirNames(".")
+	must(t, err)
+	for _, name := range names {
+		if name != config.DefaultMarkerName {
+			must(t, ffs.RemoveAll(name))
+		}
+	}
+
+	// Stop the scan while it's checking for deletions, which takes a while
+	// due to the latency.
+	sub := m.evLogger.Subscribe(events.FolderScanPhase)
+	defer sub.Unsubscribe()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f.ctx = ctx
+	go func() {
+		for {
+			ev, err := sub.Poll(time.Second)
+			if err != nil {
+				return
+			}
+			if ev.Data.(map[string]string)["phase"] == scanPhaseDetectingDeletes {
+				cancel()
+				return
+			}
+		}
+	}()
+	if err := f.scanSubdirs(nil); err != context.Canceled {
+		t.Fatalf("Expected the scan to be cancelled, got %v", err)
+	}
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	deleted := 0
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(fi protocol.FileIntf) bool {
+		if fi.IsDeleted() {
+			deleted++
+		}
+		return true
+	})
+	if deleted == 0 {
+		t.Error("Expected the deletions detected before stopping to be kept")
+	}
+}
+
+func TestFolderDrain(t *testing.T) {
+	w, fcfg, wCancel := tmpDefaultWrapper()
+	defer wCancel()
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	m.fmut.RLock()
+	runner := m.folderRunners[fcfg.ID]
+	m.fmut.RUnlock()
+
+	m.Drain(10 * time.Second)
+
+	select {
+	case <-runner.Drain():
+	default:
+		t.Fatal("Expected the folder to be drained")
+	}
+	if err := runner.Scan(nil); err == nil {
+		t.Error("Expected scanning a drained folder to fail")
+	}
+
+	// The folder isn't restarted until the model is stopped.
+	time.Sleep(100 * time.Millisecond)
+	m.fmut.RLock()
+	defer m.fmut.RUnlock()
+	if m.folderRunners[fcfg.ID] != runner {
+		t.Error("Expected the folder not to be replaced")
+	}
+	if running := atomic.LoadInt32(&m.foldersRunning); running != 1 {
+		t.Errorf("Expected the drained folder to keep running, got %v running", running)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"bytes"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// Files with fewer blocks are only detected as renamed if their contents
+// are identical, as a few matching blocks mean little for them.
+const fuzzyRenamePrefixBlocks = 4
+
+// findFuzzyRename looks for a file that was deleted from disk, shares the
+// first fuzzyRenamePrefixBlocks blocks with the given one and at least
+// FuzzyRenameThresholdPct percent of the blocks of the larger of both.
+// Candidates are found through the block map by the hash of their first
+// block.
+func (f *folder) findFuzzyRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUsedOrExisting map[string]struct{}, checkIgnores bool) (protocol.FileInfo, bool) {
+	if len(file.Blocks) < fuzzyRenamePrefixBlocks {
+		return protocol.FileInfo{}, false
+	}
+
+	var candidates []string
+	f.model.finder.Iterate([]string{f.ID}, file.Blocks[0].Hash, func(_, name string, index int32) bool {
+		if index == 0 && name != file.Name {
+			candidates = append(candidates, name)
+		}
+		return false
+	})
+
+	var hashes map[string]struct{}
+	for _, name := range candidates {
+		select {
+		case <-f.ctx.Done():
+			return protocol.FileInfo{}, false
+		default:
+		}
+
+		if _, ok := alreadyUsedOrExisting[name]; ok {
+			continue
+		}
+		fi, ok := snap.Get(protocol.LocalDeviceID, name)
+		if !ok || fi.IsDeleted() || fi.IsInvalid() || fi.Type != protocol.FileInfoTypeFile || fi.ShouldConflict() {
+			continue
+		}
+		if len(fi.Blocks) < fuzzyRenamePrefixBlocks || !blocksPrefixEqual(fi.Blocks, file.Blocks, fuzzyRenamePrefixBlocks) {
+			continue
+		}
+		if checkIgnores && f.ignores.Match(fi.Name).IsIgnored() {
+			continue
+		}
+
+		if hashes == nil {
+			hashes = make(map[string]struct{}, len(file.Blocks))
+			for _, b := range file.Blocks {
+				hashes[string(b.Hash)] = struct{}{}
+			}
+		}
+		matching := 0
+		for _, b := range fi.Blocks {
+			if _, ok := hashes[string(b.Hash)]; ok {
+				matching++
+			}
+		}
+		total := len(fi.Blocks)
+		if len(file.Blocks) > total {
+			total = len(file.Blocks)
+		}
+		if matching*100 < f.FuzzyRenameThresholdPct*total {
+			continue
+		}
+
+		alreadyUsedOrExisting[fi.Name] = struct{}{}
+		if !osutil.IsDeleted(f.mtimefs, fi.Name) {
+			continue
+		}
+
+		l.Debugf("%v: %v is a fuzzy rename of %v, %d of %d blocks match", f, file.Name, fi.Name, matching, total)
+		fi.SetDeleted(f.shortID)
+		fi.LocalFlags = f.localFlags
+		return fi, true
+	}
+
+	return protocol.FileInfo{}, false
+}
+
+func blocksPrefixEqual(a, b []protocol.BlockInfo, n int) bool {
+	for i := 0; i < n; i++ {
+		if a[i].Size != b[i].Size || !bytes.Equal(a[i].Hash, b[i].Hash) {
+			return false
+		}
+	}
+	return true
+}
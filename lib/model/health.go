@@ -0,0 +1,170 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+type FolderHealthStatus string
+
+const (
+	FolderHealthy   FolderHealthStatus = "healthy"
+	FolderDegraded  FolderHealthStatus = "degraded"
+	FolderUnhealthy FolderHealthStatus = "unhealthy"
+)
+
+// Thresholds and score penalties of the folder health, see FolderHealth.
+const (
+	healthStaleScanFactor       = 2 // times the rescan interval
+	healthStaleScanWatcherS     = 86400
+	healthStaleScanPenalty      = 25
+	healthWatchErrorPenalty     = 25
+	healthPullBackoffPenalty    = 10 // per level
+	healthPullBackoffMaxPenalty = 40
+	healthItemErrorsPenalty     = 20
+	healthIncompleteMaxPenalty  = 25 // at zero percent completion
+	healthUnhealthyScore        = 50
+)
+
+// FolderHealthFactors are the raw signals the folder health is based on.
+type FolderHealthFactors struct {
+	State            string    `json:"state"`
+	Error            string    `json:"error"`
+	LastScan         time.Time `json:"lastScan"`
+	RescanIntervalS  int       `json:"rescanIntervalS"`
+	WatcherEnabled   bool      `json:"watcherEnabled"`
+	WatchError       string    `json:"watchError"`
+	PullBackoffLevel int       `json:"pullBackoffLevel"`
+	ItemErrors       int       `json:"itemErrors"`
+	CompletionPct    float64   `json:"completionPct"`
+}
+
+// FolderHealth is a composite health indicator of a folder. The score
+// starts at 100 and is reduced by
+//   - 25 if the last scan is older than twice the rescan interval, or a
+//     day if periodic rescans are disabled while the watcher is enabled,
+//   - 25 if the watcher is enabled but failing,
+//   - 10 for every level of pull back-off, at most 40,
+//   - 20 if there are items that failed to scan or pull,
+//   - up to 25 depending on the completion percentage, if the folder is
+//     idle but not complete.
+//
+// A folder with an error, e.g. a missing path, has a score of zero. The
+// folder is unhealthy with a score below 50, degraded below 100 and
+// healthy otherwise.
+type FolderHealth struct {
+	Status  FolderHealthStatus  `json:"status"`
+	Score   int                 `json:"score"`
+	Reasons []string            `json:"reasons"`
+	Factors FolderHealthFactors `json:"factors"`
+}
+
+func newFolderHealth(factors FolderHealthFactors, now time.Time) FolderHealth {
+	h := FolderHealth{
+		Score:   100,
+		Reasons: make([]string, 0),
+		Factors: factors,
+	}
+
+	if factors.Error != "" {
+		h.Score = 0
+		h.Reasons = append(h.Reasons, fmt.Sprintf("folder error: %v", factors.Error))
+	}
+
+	staleAfter := time.Duration(healthStaleScanFactor*factors.RescanIntervalS) * time.Second
+	if factors.RescanIntervalS == 0 && factors.WatcherEnabled {
+		staleAfter = healthStaleScanWatcherS * time.Second
+	}
+	if staleAfter > 0 && factors.State != FolderScanning.String() && now.Sub(factors.LastScan) > staleAfter {
+		h.Score -= healthStaleScanPenalty
+		if factors.LastScan.IsZero() {
+			h.Reasons = append(h.Reasons, "never scanned")
+		} else {
+			h.Reasons = append(h.Reasons, fmt.Sprintf("last scan %v ago", now.Sub(factors.LastScan).Truncate(time.Second)))
+		}
+	}
+
+	if factors.WatcherEnabled && factors.WatchError != "" {
+		h.Score -= healthWatchErrorPenalty
+		h.Reasons = append(h.Reasons, fmt.Sprintf("watcher failing: %v", factors.WatchError))
+	}
+
+	if factors.PullBackoffLevel > 0 {
+		penalty := factors.PullBackoffLevel * healthPullBackoffPenalty
+		if penalty > healthPullBackoffMaxPenalty {
+			penalty = healthPullBackoffMaxPenalty
+		}
+		h.Score -= penalty
+		h.Reasons = append(h.Reasons, fmt.Sprintf("backing off from failing pulls (level %d)", factors.PullBackoffLevel))
+	}
+
+	if factors.ItemErrors > 0 {
+		h.Score -= healthItemErrorsPenalty
+		h.Reasons = append(h.Reasons, fmt.Sprintf("%d items have errors", factors.ItemErrors))
+	}
+
+	if factors.State == FolderIdle.String() && factors.CompletionPct < 100 {
+		h.Score -= int((100 - factors.CompletionPct) * healthIncompleteMaxPenalty / 100)
+		h.Reasons = append(h.Reasons, fmt.Sprintf("idle at %.1f%% completion", factors.CompletionPct))
+	}
+
+	if h.Score < 0 {
+		h.Score = 0
+	}
+	switch {
+	case h.Score < healthUnhealthyScore:
+		h.Status = FolderUnhealthy
+	case len(h.Reasons) > 0:
+		h.Status = FolderDegraded
+	default:
+		h.Status = FolderHealthy
+	}
+	return h
+}
+
+// FolderHealth returns the health of the given folder.
+func (m *model) FolderHealth(folder string) (FolderHealth, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	cfg := m.folderCfgs[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return FolderHealth{}, err
+	}
+
+	state, _, err := runner.getState()
+	factors := FolderHealthFactors{
+		State:            state.String(),
+		RescanIntervalS:  cfg.RescanIntervalS,
+		WatcherEnabled:   cfg.FSWatcherEnabled,
+		PullBackoffLevel: runner.PullBackoff(),
+		ItemErrors:       len(runner.Errors()),
+	}
+	if err != nil {
+		factors.Error = err.Error()
+	}
+	if err := runner.WatchError(); err != nil {
+		factors.WatchError = err.Error()
+	}
+	stats, err := runner.GetStatistics()
+	if err != nil {
+		return FolderHealth{}, err
+	}
+	factors.LastScan = stats.LastScan
+	comp, err := m.folderCompletion(protocol.LocalDeviceID, folder)
+	if err != nil {
+		return FolderHealth{}, err
+	}
+	factors.CompletionPct = comp.CompletionPct
+
+	return newFolderHealth(factors, time.Now()), nil
+}
@@ -33,6 +33,12 @@ type indexSender struct {
 	token                    suture.ServiceToken
 	pauseChan                chan struct{}
 	resumeChan               chan *db.FileSet
+
+	// What has been sent so far, for status reporting only.
+	statusMut    sync.Mutex
+	initialSent  bool
+	sentSequence int64
+	lastSent     time.Time
 }
 
 func (s *indexSender) Serve(ctx context.Context) (err error) {
@@ -45,6 +51,11 @@ func (s *indexSender) Serve(ctx context.Context) (err error) {
 
 	// We need to send one index, regardless of whether there is something to send or not
 	err = s.sendIndexTo(ctx)
+	if err == nil {
+		s.statusMut.Lock()
+		s.initialSent = true
+		s.statusMut.Unlock()
+	}
 
 	// Subscribe to LocalIndexUpdated (we have new information to send) and
 	// DeviceDisconnected (it might be us who disconnected, so we should
@@ -196,9 +207,22 @@ func (s *indexSender) sendIndexTo(ctx context.Context) error {
 	}
 
 	s.prevSequence = f.Sequence
+
+	if err == nil {
+		s.statusMut.Lock()
+		s.sentSequence = f.Sequence
+		s.lastSent = time.Now()
+		s.statusMut.Unlock()
+	}
 	return err
 }
 
+func (s *indexSender) status() (initialSent bool, sentSequence int64, lastSent time.Time) {
+	s.statusMut.Lock()
+	defer s.statusMut.Unlock()
+	return s.initialSent, s.sentSequence, s.lastSent
+}
+
 func prepareFileInfoForIndex(f protocol.FileInfo) protocol.FileInfo {
 	// Mark the file as invalid if any of the local bad stuff flags are set.
 	f.RawInvalid = f.IsInvalid()
@@ -226,9 +250,18 @@ type indexSenderRegistry struct {
 	closed       chan struct{}
 	indexSenders map[string]*indexSender
 	startInfos   map[string]*indexSenderStartInfo
+	received     map[string]*indexReceivedState
 	mut          sync.Mutex
 }
 
+// indexReceivedState is what we know about the index received from the
+// remote device for a folder.
+type indexReceivedState struct {
+	// The sequence they announced in their cluster config.
+	announcedSequence int64
+	lastReceived      time.Time
+}
+
 func newIndexSenderRegistry(conn protocol.Connection, closed chan struct{}, sup *suture.Supervisor, evLogger events.Logger) *indexSenderRegistry {
 	return &indexSenderRegistry{
 		deviceID:     conn.ID(),
@@ -238,6 +271,7 @@ func newIndexSenderRegistry(conn protocol.Connection, closed chan struct{}, sup
 		evLogger:     evLogger,
 		indexSenders: make(map[string]*indexSender),
 		startInfos:   make(map[string]*indexSenderStartInfo),
+		received:     make(map[string]*indexReceivedState),
 		mut:          sync.Mutex{},
 	}
 }
@@ -316,6 +350,7 @@ func (r *indexSenderRegistry) addLocked(folder config.FolderConfiguration, fset
 		delete(r.indexSenders, folder.ID)
 	}
 	delete(r.startInfos, folder.ID)
+	r.setAnnouncedLocked(folder.ID, startInfo)
 
 	is := &indexSender{
 		conn:                     r.conn,
@@ -345,6 +380,45 @@ func (r *indexSenderRegistry) addPending(folder config.FolderConfiguration, star
 		delete(r.indexSenders, folder.ID)
 	}
 	r.startInfos[folder.ID] = startInfo
+	r.setAnnouncedLocked(folder.ID, startInfo)
+}
+
+func (r *indexSenderRegistry) setAnnouncedLocked(folder string, startInfo *indexSenderStartInfo) {
+	state, ok := r.received[folder]
+	if !ok {
+		state = &indexReceivedState{}
+		r.received[folder] = state
+	}
+	state.announcedSequence = startInfo.remote.MaxSequence
+}
+
+// indexReceived records that an index (update) for the given folder was
+// received from the remote device.
+func (r *indexSenderRegistry) indexReceived(folder string) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	state, ok := r.received[folder]
+	if !ok {
+		state = &indexReceivedState{}
+		r.received[folder] = state
+	}
+	state.lastReceived = time.Now()
+}
+
+// status fills in what the registry knows about the index exchange for the
+// given folder.
+func (r *indexSenderRegistry) status(folder string, st *IndexExchangeStatus) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if is, ok := r.indexSenders[folder]; ok {
+		st.InitialIndexSent, st.SentSequence, st.LastSent = is.status()
+	}
+	if state, ok := r.received[folder]; ok {
+		st.AnnouncedSequence = state.announcedSequence
+		st.LastReceived = state.lastReceived
+	}
 }
 
 // remove stops a running index sender or removes one pending to be started.
@@ -358,6 +432,7 @@ func (r *indexSenderRegistry) remove(folder string) {
 		delete(r.indexSenders, folder)
 	}
 	delete(r.startInfos, folder)
+	delete(r.received, folder)
 }
 
 // removeAllExcept stops all running index senders and removes those pending to be started,
@@ -378,6 +453,11 @@ func (r *indexSenderRegistry) removeAllExcept(except map[string]struct{}) {
 			delete(r.startInfos, folder)
 		}
 	}
+	for folder := range r.received {
+		if _, ok := except[folder]; !ok {
+			delete(r.received, folder)
+		}
+	}
 }
 
 // pause stops a running index sender.
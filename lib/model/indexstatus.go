@@ -0,0 +1,73 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// IndexExchangeStatus describes the exchange of the index of a folder with
+// a device it is shared with. Sequences are those of the respective
+// sender's index.
+type IndexExchangeStatus struct {
+	Connected bool `json:"connected"`
+
+	// Our index as sent to them.
+	InitialIndexSent bool      `json:"initialIndexSent"`
+	LocalSequence    int64     `json:"localSequence"`
+	SentSequence     int64     `json:"sentSequence"`
+	LastSent         time.Time `json:"lastSent"`
+
+	// Their index as received from them. They announce their sequence on
+	// connecting, thus it's only known up to date while index updates are
+	// flowing.
+	InitialIndexReceived bool      `json:"initialIndexReceived"`
+	AnnouncedSequence    int64     `json:"announcedSequence"`
+	ReceivedSequence     int64     `json:"receivedSequence"`
+	LastReceived         time.Time `json:"lastReceived"`
+}
+
+// IndexExchangeStatus returns the status of the index exchange for the
+// given folder with each device it is shared with.
+func (m *model) IndexExchangeStatus(folder string) (map[protocol.DeviceID]IndexExchangeStatus, error) {
+	cfg, ok := m.cfg.Folder(folder)
+	if !ok {
+		return nil, fmt.Errorf("folder %s does not exist", folder)
+	}
+
+	m.fmut.RLock()
+	fset, ok := m.folderFiles[folder]
+	m.fmut.RUnlock()
+	if !ok {
+		return nil, ErrFolderNotRunning
+	}
+
+	localSequence := fset.Sequence(protocol.LocalDeviceID)
+	res := make(map[protocol.DeviceID]IndexExchangeStatus, len(cfg.Devices))
+	for _, dev := range cfg.DeviceIDs() {
+		if dev == m.id {
+			continue
+		}
+		st := IndexExchangeStatus{
+			LocalSequence:    localSequence,
+			ReceivedSequence: fset.Sequence(dev),
+		}
+		m.pmut.RLock()
+		registry, connected := m.indexSenders[dev]
+		m.pmut.RUnlock()
+		if connected {
+			st.Connected = true
+			registry.status(folder, &st)
+			st.InitialIndexReceived = st.ReceivedSequence >= st.AnnouncedSequence
+		}
+		res[dev] = st
+	}
+	return res, nil
+}
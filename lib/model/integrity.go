@@ -0,0 +1,123 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/scanner"
+)
+
+var errIntegrityUnsupported = errors.New("encrypted folders cannot be verified")
+
+// VerifyIntegrity reads the blocks of all local files that are in sync with
+// the global version and checks them against the hashes of the global
+// index. That catches corruption on disk, which scans miss as they trust
+// unchanged modification times. Files that don't match or can't be read
+// are returned and scheduled for a forced rescan. Files are verified one at
+// a time, each holding the folder I/O limiter, and reading is subject to
+// the scan read bandwidth limit.
+func (f *folder) VerifyIntegrity(ctx context.Context) ([]FileError, error) {
+	if f.Type == config.FolderTypeReceiveEncrypted {
+		return nil, errIntegrityUnsupported
+	}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+
+	var names []string
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
+		if !intf.IsDeleted() && !intf.IsInvalid() && intf.FileType() == protocol.FileInfoTypeFile {
+			names = append(names, intf.FileName())
+		}
+		return ctx.Err() == nil
+	})
+
+	ffs := f.scanFilesystem(ctx)
+	errs := make([]FileError, 0)
+	verified := 0
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			return errs, err
+		}
+		file, ok := snap.Get(protocol.LocalDeviceID, name)
+		if !ok {
+			continue
+		}
+		global, ok := snap.GetGlobal(name)
+		if !ok || global.IsDeleted() || !global.Version.Equal(file.Version) {
+			// Not in sync, which the next pull takes care of.
+			continue
+		}
+
+		if err := f.ioLimiter.takeWithContext(ctx, 1); err != nil {
+			return errs, err
+		}
+		err := verifyBlocks(ctx, ffs, global)
+		f.ioLimiter.give(1)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return errs, ctxErr
+		}
+		verified++
+		if err == nil {
+			continue
+		}
+		l.Debugf("%v integrity verification of %v failed: %v", f, name, err)
+		errs = append(errs, FileError{Path: name, Err: err.Error()})
+		f.ScheduleForceRescan(name)
+	}
+
+	if len(errs) > 0 {
+		l.Infof("Folder %v: %d of %d verified files don't match the global index, rescanning them", f.Description(), len(errs), verified)
+	} else {
+		l.Infof("Folder %v: Verified %d files against the global index", f.Description(), verified)
+	}
+	return errs, nil
+}
+
+// verifyBlocks reads the given file from disk and checks that its blocks
+// hash as expected.
+func verifyBlocks(ctx context.Context, ffs fs.Filesystem, file protocol.FileInfo) error {
+	info, err := ffs.Lstat(file.Name)
+	if err != nil {
+		return err
+	}
+	if !info.IsRegular() || info.Size() != file.Size {
+		return fmt.Errorf("size is %d instead of %d", info.Size(), file.Size)
+	}
+
+	fd, err := ffs.Open(file.Name)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	for _, block := range file.Blocks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		buf := protocol.BufferPool.Get(block.Size)
+		_, err := fd.ReadAt(buf, block.Offset)
+		valid := err == nil && scanner.Validate(buf, block.Hash, 0)
+		protocol.BufferPool.Put(buf)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("block at offset %d doesn't match the global index", block.Offset)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/scanner"
+)
+
+// An exported local index is a gzip compressed stream starting with
+// localIndexMagic, followed by length prefixed protocol.Index messages of
+// at most maxBatchSizeFiles files each.
+const localIndexMagic uint32 = 0x5e1dbe75
+
+var (
+	errLocalIndexFormat   = errors.New("not an exported local index")
+	errLocalIndexMismatch = errors.New("the index doesn't match the files on disk")
+)
+
+// ExportLocalIndex writes the local index of the given folder to w.
+func (m *model) ExportLocalIndex(folder string, w io.Writer) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	fset := m.folderFiles[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	snap, err := fset.Snapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	gw := gzip.NewWriter(w)
+	if err := binary.Write(gw, binary.BigEndian, localIndexMagic); err != nil {
+		return err
+	}
+	batch := make([]protocol.FileInfo, 0, maxBatchSizeFiles)
+	flush := func() error {
+		if err := writeLocalIndexBatch(gw, folder, batch); err != nil {
+			return err
+		}
+		batch = batch[:0]
+		return nil
+	}
+	snap.WithHave(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
+		fi := intf.(protocol.FileInfo)
+		fi.Sequence = 0
+		batch = append(batch, fi)
+		if len(batch) == maxBatchSizeFiles {
+			err = flush()
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	return gw.Close()
+}
+
+func writeLocalIndexBatch(w io.Writer, folder string, files []protocol.FileInfo) error {
+	idx := protocol.Index{Folder: folder, Files: files}
+	bs, err := idx.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.BigEndian, uint32(len(bs))); err != nil {
+		return err
+	}
+	_, err = w.Write(bs)
+	return err
+}
+
+// readLocalIndex calls fn with the batches of files of an exported local
+// index, making sure it belongs to the given folder.
+func readLocalIndex(r io.Reader, folder string, fn func([]protocol.FileInfo) error) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return errLocalIndexFormat
+	}
+	br := bufio.NewReader(gr)
+	var magic uint32
+	if err := binary.Read(br, binary.BigEndian, &magic); err != nil || magic != localIndexMagic {
+		return errLocalIndexFormat
+	}
+	for {
+		var msgLen uint32
+		if err := binary.Read(br, binary.BigEndian, &msgLen); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "reading local index")
+		}
+		if msgLen > protocol.MaxMessageLen {
+			return errLocalIndexFormat
+		}
+		bs := make([]byte, msgLen)
+		if _, err := io.ReadFull(br, bs); err != nil {
+			return errors.Wrap(err, "reading local index")
+		}
+		var idx protocol.Index
+		if err := idx.Unmarshal(bs); err != nil {
+			return errors.Wrap(err, "reading local index")
+		}
+		if idx.Folder != folder {
+			return fmt.Errorf("the index belongs to folder %q", idx.Folder)
+		}
+		if err := fn(idx.Files); err != nil {
+			return err
+		}
+	}
+}
+
+// ImportLocalIndex imports a local index exported by ExportLocalIndex into
+// the given folder. To not end up with a sync state that doesn't reflect
+// the files on disk, nothing is imported unless
+//   - the index was exported from a folder with the same ID, and
+//   - if spotCheck is positive, that many randomly chosen files from the
+//     index match those on disk, including their contents.
+//
+// If the local index of the folder is empty, all files are imported.
+// Otherwise, e.g. as the folder was scanned after its database was reset,
+// the index is merged: Only files whose local entry is equivalent to the
+// imported one, including their blocks, are replaced by it. All others are
+// left to the scan.
+//
+// The version vectors are imported as they are, even if the index was
+// exported on another device. That's intended: The files are then
+// considered identical to those of the devices already having them,
+// instead of all being in conflict.
+//
+// It returns the number of imported files.
+func (m *model) ImportLocalIndex(folder string, r io.ReadSeeker, spotCheck int) (int, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return 0, err
+	}
+
+	return runner.ImportLocalIndex(r, spotCheck)
+}
+
+// ImportLocalIndex imports the local index from r. This happens in the
+// serve loop, such that nothing is scanned or pulled meanwhile. Files that
+// changed since the export are picked up by the scan done afterwards.
+func (f *folder) ImportLocalIndex(r io.ReadSeeker, spotCheck int) (int, error) {
+	var imported int
+	var importErr error
+	err := f.doInSync(func() error {
+		// A refused import isn't an error of the folder.
+		if imported, importErr = f.importLocalIndex(r, spotCheck); importErr != nil {
+			return nil
+		}
+		return f.scanSubdirs(nil)
+	})
+	if importErr != nil {
+		return 0, importErr
+	}
+	return imported, err
+}
+
+// importLocalIndex reads r twice: First to check that it matches, then to
+// import it.
+func (f *folder) importLocalIndex(r io.ReadSeeker, spotCheck int) (int, error) {
+	if err := f.getHealthErrorWithoutIgnores(); err != nil {
+		return 0, err
+	}
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	merge := snap.LocalSize().TotalItems() > 0
+	snap.Release()
+
+	var sample []protocol.FileInfo
+	seen := 0
+	err = readLocalIndex(r, f.ID, func(files []protocol.FileInfo) error {
+		for _, fi := range files {
+			if spotCheck <= 0 || fi.IsDeleted() || fi.IsInvalid() || fi.Type != protocol.FileInfoTypeFile {
+				continue
+			}
+			seen++
+			if len(sample) < spotCheck {
+				sample = append(sample, fi)
+			} else if i := rand.Intn(seen); i < len(sample) {
+				// Reservoir sampling, every file has the same chance.
+				sample[i] = fi
+			}
+		}
+		return f.ctx.Err()
+	})
+	if err != nil {
+		return 0, err
+	}
+	if mismatches := f.spotCheckLocalIndex(sample); mismatches > 0 {
+		return 0, errors.Wrapf(errLocalIndexMismatch, "%d of %d checked files differ", mismatches, len(sample))
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	snap, err = f.dbSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Release()
+	imported := 0
+	err = readLocalIndex(r, f.ID, func(files []protocol.FileInfo) error {
+		n := 0
+		for _, fi := range files {
+			if merge && !f.mergeableLocalIndexFile(snap, fi) {
+				continue
+			}
+			fi.Sequence = 0
+			files[n] = fi
+			n++
+		}
+		if n > 0 {
+			f.updateLocals(files[:n])
+			imported += n
+		}
+		return nil
+	})
+	if err != nil {
+		return imported, err
+	}
+	l.Infof("Folder %v: Imported local index of %d files", f.Description(), imported)
+	return imported, nil
+}
+
+// mergeableLocalIndexFile returns whether the imported file may replace the
+// local entry, i.e. there is one and it's equivalent.
+func (f *folder) mergeableLocalIndexFile(snap *db.Snapshot, fi protocol.FileInfo) bool {
+	cur, ok := snap.Get(protocol.LocalDeviceID, fi.Name)
+	if !ok {
+		return false
+	}
+	return cur.IsEquivalentOptional(fi, f.modTimeWindow, f.IgnorePerms, false, 0)
+}
+
+// spotCheckLocalIndex returns how many of the given files differ from
+// those on disk.
+func (f *folder) spotCheckLocalIndex(files []protocol.FileInfo) int {
+	mismatches := 0
+	for _, fi := range files {
+		info, err := f.mtimefs.Lstat(fi.Name)
+		if err == nil && info.IsRegular() && info.Size() == fi.Size && protocol.ModTimeEqual(info.ModTime(), fi.ModTime(), f.modTimeWindow) {
+			if f.Type == config.FolderTypeReceiveEncrypted {
+				continue
+			}
+			blocks, err := scanner.HashFile(f.ctx, f.mtimefs, fi.Name, fi.BlockSize(), nil, false)
+			if err == nil && fi.BlocksEqual(protocol.FileInfo{Blocks: blocks}) {
+				continue
+			}
+		}
+		l.Debugln(f, "spot check of imported index found", fi.Name, "to differ")
+		mismatches++
+	}
+	return mismatches
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// LocalRemoval summarizes what was removed by IgnoreAndRemoveLocally.
+type LocalRemoval struct {
+	Removed     []string `json:"removed"`
+	Files       int      `json:"files"`
+	Directories int      `json:"directories"`
+	Symlinks    int      `json:"symlinks"`
+	Bytes       int64    `json:"bytes"`
+	// Paths that were not removed and why, e.g. because they are still
+	// matched by a preceding negated pattern.
+	Skipped map[string]string `json:"skipped"`
+}
+
+const globChars = `*?[]{}\`
+
+// literalIgnorePattern returns an ignore pattern matching exactly the given
+// path, relative to the folder root.
+func literalIgnorePattern(path string) (string, error) {
+	path = filepath.ToSlash(path)
+	if runtime.GOOS == "windows" {
+		// Backslashes are path separators in ignore patterns on Windows,
+		// so glob characters cannot be escaped.
+		if strings.ContainsAny(path, globChars) {
+			return "", fmt.Errorf("%v: cannot ignore paths containing any of %v", path, globChars)
+		}
+		return "/" + path, nil
+	}
+	var b strings.Builder
+	b.WriteByte('/')
+	for _, r := range path {
+		if strings.ContainsRune(globChars, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String(), nil
+}
+
+// IgnoreAndRemoveLocally adds the given paths to the ignore patterns of
+// the folder and then removes them from disk. Once ignored, the items are
+// marked invalid in the index, so their removal is never announced as a
+// deletion and other devices keep their copies.
+func (m *model) IgnoreAndRemoveLocally(folder string, paths []string) (LocalRemoval, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return LocalRemoval{}, err
+	}
+
+	lines, _, err := m.LoadIgnores(folder)
+	if err != nil {
+		return LocalRemoval{}, err
+	}
+	seen := make(map[string]struct{}, len(lines))
+	for _, line := range lines {
+		seen[line] = struct{}{}
+	}
+	canonical := make([]string, 0, len(paths))
+	for _, orig := range paths {
+		path, err := fs.Canonicalize(orig)
+		if err != nil {
+			return LocalRemoval{}, fmt.Errorf("%v: %w", orig, err)
+		}
+		if path == "." || fs.IsInternal(path) {
+			return LocalRemoval{}, fmt.Errorf("%v: cannot remove folder root or internal files", path)
+		}
+		pattern, err := literalIgnorePattern(path)
+		if err != nil {
+			return LocalRemoval{}, err
+		}
+		if _, ok := seen[pattern]; !ok {
+			seen[pattern] = struct{}{}
+			lines = append(lines, pattern)
+		}
+		canonical = append(canonical, path)
+	}
+
+	// Setting the ignores scans the folder, which marks the now ignored
+	// items as such in the database before anything is removed.
+	if err := m.SetIgnores(folder, lines); err != nil {
+		return LocalRemoval{}, err
+	}
+
+	return runner.RemoveIgnoredLocally(canonical)
+}
+
+// RemoveIgnoredLocally removes the given ignored paths from disk, without
+// changing the database.
+func (f *folder) RemoveIgnoredLocally(paths []string) (LocalRemoval, error) {
+	res := LocalRemoval{
+		Removed: make([]string, 0, len(paths)),
+		Skipped: make(map[string]string),
+	}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return res, err
+	}
+	defer snap.Release()
+
+	for _, path := range paths {
+		if !f.ignores.Match(path).IsIgnored() {
+			res.Skipped[path] = "not ignored"
+			continue
+		}
+		if err := unignoredInDB(snap, path); err != nil {
+			// The scan didn't get to mark everything as ignored, removing
+			// it now would be picked up as a deletion.
+			res.Skipped[path] = err.Error()
+			continue
+		}
+		if _, err := f.mtimefs.Lstat(path); fs.IsNotExist(err) {
+			continue
+		}
+		if err := f.removeLocally(path, &res); err != nil {
+			res.Skipped[path] = err.Error()
+			continue
+		}
+		res.Removed = append(res.Removed, path)
+	}
+
+	l.Infof("Folder %v: Removed %d ignored files, %d directories and %d symlinks (%d bytes) locally", f.Description(), res.Files, res.Directories, res.Symlinks, res.Bytes)
+	return res, nil
+}
+
+// unignoredInDB returns an error if the local database has an existing item
+// at or below the given path that isn't marked as ignored.
+func unignoredInDB(snap *db.Snapshot, path string) error {
+	var unignored string
+	snap.WithPrefixedHaveTruncated(protocol.LocalDeviceID, path, func(intf protocol.FileIntf) bool {
+		name := intf.FileName()
+		if intf.IsIgnored() || intf.IsDeleted() || (name != path && !fs.IsParent(name, path)) {
+			return true
+		}
+		unignored = name
+		return false
+	})
+	if unignored != "" {
+		return fmt.Errorf("%v is not marked as ignored in the database", unignored)
+	}
+	return nil
+}
+
+func (f *folder) removeLocally(path string, res *LocalRemoval) error {
+	var counted LocalRemoval
+	err := f.mtimefs.Walk(path, func(name string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		switch {
+		case info.IsSymlink():
+			counted.Symlinks++
+		case info.IsDir():
+			counted.Directories++
+		default:
+			counted.Files++
+			counted.Bytes += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := f.mtimefs.RemoveAll(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	res.Files += counted.Files
+	res.Directories += counted.Directories
+	res.Symlinks += counted.Symlinks
+	res.Bytes += counted.Bytes
+	return nil
+}
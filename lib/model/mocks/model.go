@@ -3,6 +3,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"net"
 	"sync"
 	"time"
@@ -16,12 +17,37 @@ import (
 )
 
 type Model struct {
+	AcknowledgeEmptyPathStub        func(string) error
+	acknowledgeEmptyPathMutex       sync.RWMutex
+	acknowledgeEmptyPathArgsForCall []struct {
+		arg1 string
+	}
+	acknowledgeEmptyPathReturns struct {
+		result1 error
+	}
+	acknowledgeEmptyPathReturnsOnCall map[int]struct {
+		result1 error
+	}
 	AddConnectionStub        func(protocol.Connection, protocol.Hello)
 	addConnectionMutex       sync.RWMutex
 	addConnectionArgsForCall []struct {
 		arg1 protocol.Connection
 		arg2 protocol.Hello
 	}
+	AuditEntriesStub        func(string, model.AuditQuery) ([]model.AuditEntry, error)
+	auditEntriesMutex       sync.RWMutex
+	auditEntriesArgsForCall []struct {
+		arg1 string
+		arg2 model.AuditQuery
+	}
+	auditEntriesReturns struct {
+		result1 []model.AuditEntry
+		result2 error
+	}
+	auditEntriesReturnsOnCall map[int]struct {
+		result1 []model.AuditEntry
+		result2 error
+	}
 	AvailabilityStub        func(string, protocol.FileInfo, protocol.BlockInfo) ([]model.Availability, error)
 	availabilityMutex       sync.RWMutex
 	availabilityArgsForCall []struct {
@@ -43,6 +69,42 @@ type Model struct {
 		arg1 string
 		arg2 string
 	}
+	CancelRehashStub        func(string) error
+	cancelRehashMutex       sync.RWMutex
+	cancelRehashArgsForCall []struct {
+		arg1 string
+	}
+	cancelRehashReturns struct {
+		result1 error
+	}
+	cancelRehashReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ChronicConflictsStub        func(string) ([]model.ChronicConflict, error)
+	chronicConflictsMutex       sync.RWMutex
+	chronicConflictsArgsForCall []struct {
+		arg1 string
+	}
+	chronicConflictsReturns struct {
+		result1 []model.ChronicConflict
+		result2 error
+	}
+	chronicConflictsReturnsOnCall map[int]struct {
+		result1 []model.ChronicConflict
+		result2 error
+	}
+	ClearPullErrorStub        func(string, string) error
+	clearPullErrorMutex       sync.RWMutex
+	clearPullErrorArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	clearPullErrorReturns struct {
+		result1 error
+	}
+	clearPullErrorReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ClosedStub        func(protocol.DeviceID, error)
 	closedMutex       sync.RWMutex
 	closedArgsForCall []struct {
@@ -61,6 +123,22 @@ type Model struct {
 	clusterConfigReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CompareFoldersStub        func(string, string, int, int) ([]model.FolderDifference, error)
+	compareFoldersMutex       sync.RWMutex
+	compareFoldersArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	compareFoldersReturns struct {
+		result1 []model.FolderDifference
+		result2 error
+	}
+	compareFoldersReturnsOnCall map[int]struct {
+		result1 []model.FolderDifference
+		result2 error
+	}
 	CompletionStub        func(protocol.DeviceID, string) (model.FolderCompletion, error)
 	completionMutex       sync.RWMutex
 	completionArgsForCall []struct {
@@ -164,6 +242,13 @@ type Model struct {
 		arg1 string
 		arg2 time.Duration
 	}
+	DelayScanWithReasonStub        func(string, time.Duration, string)
+	delayScanWithReasonMutex       sync.RWMutex
+	delayScanWithReasonArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+		arg3 string
+	}
 	DeviceStatisticsStub        func() (map[protocol.DeviceID]stats.DeviceStatistics, error)
 	deviceStatisticsMutex       sync.RWMutex
 	deviceStatisticsArgsForCall []struct {
@@ -189,6 +274,37 @@ type Model struct {
 	downloadProgressReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DrainStub        func(time.Duration)
+	drainMutex       sync.RWMutex
+	drainArgsForCall []struct {
+		arg1 time.Duration
+	}
+	ExportLocalIndexStub        func(string, io.Writer) error
+	exportLocalIndexMutex       sync.RWMutex
+	exportLocalIndexArgsForCall []struct {
+		arg1 string
+		arg2 io.Writer
+	}
+	exportLocalIndexReturns struct {
+		result1 error
+	}
+	exportLocalIndexReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FilesModifiedByStub        func(string, string, string, func(db.FileInfoTruncated) bool) error
+	filesModifiedByMutex       sync.RWMutex
+	filesModifiedByArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 func(db.FileInfoTruncated) bool
+	}
+	filesModifiedByReturns struct {
+		result1 error
+	}
+	filesModifiedByReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FolderErrorsStub        func(string) ([]model.FileError, error)
 	folderErrorsMutex       sync.RWMutex
 	folderErrorsArgsForCall []struct {
@@ -202,6 +318,19 @@ type Model struct {
 		result1 []model.FileError
 		result2 error
 	}
+	FolderHealthStub        func(string) (model.FolderHealth, error)
+	folderHealthMutex       sync.RWMutex
+	folderHealthArgsForCall []struct {
+		arg1 string
+	}
+	folderHealthReturns struct {
+		result1 model.FolderHealth
+		result2 error
+	}
+	folderHealthReturnsOnCall map[int]struct {
+		result1 model.FolderHealth
+		result2 error
+	}
 	FolderProgressBytesCompletedStub        func(string) int64
 	folderProgressBytesCompletedMutex       sync.RWMutex
 	folderProgressBytesCompletedArgsForCall []struct {
@@ -225,6 +354,18 @@ type Model struct {
 		result1 map[string]stats.FolderStatistics
 		result2 error
 	}
+	ForceScanFolderSubdirsStub        func(string, []string) error
+	forceScanFolderSubdirsMutex       sync.RWMutex
+	forceScanFolderSubdirsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	forceScanFolderSubdirsReturns struct {
+		result1 error
+	}
+	forceScanFolderSubdirsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetFolderVersionsStub        func(string) (map[string][]versioner.FileVersion, error)
 	getFolderVersionsMutex       sync.RWMutex
 	getFolderVersionsArgsForCall []struct {
@@ -265,6 +406,35 @@ type Model struct {
 		result1 []*model.TreeEntry
 		result2 error
 	}
+	IgnoreAndRemoveLocallyStub        func(string, []string) (model.LocalRemoval, error)
+	ignoreAndRemoveLocallyMutex       sync.RWMutex
+	ignoreAndRemoveLocallyArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	ignoreAndRemoveLocallyReturns struct {
+		result1 model.LocalRemoval
+		result2 error
+	}
+	ignoreAndRemoveLocallyReturnsOnCall map[int]struct {
+		result1 model.LocalRemoval
+		result2 error
+	}
+	ImportLocalIndexStub        func(string, io.ReadSeeker, int) (int, error)
+	importLocalIndexMutex       sync.RWMutex
+	importLocalIndexArgsForCall []struct {
+		arg1 string
+		arg2 io.ReadSeeker
+		arg3 int
+	}
+	importLocalIndexReturns struct {
+		result1 int
+		result2 error
+	}
+	importLocalIndexReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	IndexStub        func(protocol.DeviceID, string, []protocol.FileInfo) error
 	indexMutex       sync.RWMutex
 	indexArgsForCall []struct {
@@ -278,6 +448,19 @@ type Model struct {
 	indexReturnsOnCall map[int]struct {
 		result1 error
 	}
+	IndexExchangeStatusStub        func(string) (map[protocol.DeviceID]model.IndexExchangeStatus, error)
+	indexExchangeStatusMutex       sync.RWMutex
+	indexExchangeStatusArgsForCall []struct {
+		arg1 string
+	}
+	indexExchangeStatusReturns struct {
+		result1 map[protocol.DeviceID]model.IndexExchangeStatus
+		result2 error
+	}
+	indexExchangeStatusReturnsOnCall map[int]struct {
+		result1 map[protocol.DeviceID]model.IndexExchangeStatus
+		result2 error
+	}
 	IndexUpdateStub        func(protocol.DeviceID, string, []protocol.FileInfo) error
 	indexUpdateMutex       sync.RWMutex
 	indexUpdateArgsForCall []struct {
@@ -291,6 +474,22 @@ type Model struct {
 	indexUpdateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	IsPathIgnoredStub        func(string, string) (bool, string, error)
+	isPathIgnoredMutex       sync.RWMutex
+	isPathIgnoredArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	isPathIgnoredReturns struct {
+		result1 bool
+		result2 string
+		result3 error
+	}
+	isPathIgnoredReturnsOnCall map[int]struct {
+		result1 bool
+		result2 string
+		result3 error
+	}
 	LoadIgnoresStub        func(string) ([]string, []string, error)
 	loadIgnoresMutex       sync.RWMutex
 	loadIgnoresArgsForCall []struct {
@@ -321,6 +520,32 @@ type Model struct {
 		result1 []db.FileInfoTruncated
 		result2 error
 	}
+	LocalChangesSinceStub        func(string, int64, int) (model.LocalChanges, error)
+	localChangesSinceMutex       sync.RWMutex
+	localChangesSinceArgsForCall []struct {
+		arg1 string
+		arg2 int64
+		arg3 int
+	}
+	localChangesSinceReturns struct {
+		result1 model.LocalChanges
+		result2 error
+	}
+	localChangesSinceReturnsOnCall map[int]struct {
+		result1 model.LocalChanges
+		result2 error
+	}
+	ModTimeWindowStub        func(string) time.Duration
+	modTimeWindowMutex       sync.RWMutex
+	modTimeWindowArgsForCall []struct {
+		arg1 string
+	}
+	modTimeWindowReturns struct {
+		result1 time.Duration
+	}
+	modTimeWindowReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	NeedFolderFilesStub        func(string, int, int) ([]db.FileInfoTruncated, []db.FileInfoTruncated, []db.FileInfoTruncated, error)
 	needFolderFilesMutex       sync.RWMutex
 	needFolderFilesArgsForCall []struct {
@@ -393,6 +618,80 @@ type Model struct {
 		result1 map[string]db.PendingFolder
 		result2 error
 	}
+	PullConcurrencyStub        func(string) (model.PullConcurrency, error)
+	pullConcurrencyMutex       sync.RWMutex
+	pullConcurrencyArgsForCall []struct {
+		arg1 string
+	}
+	pullConcurrencyReturns struct {
+		result1 model.PullConcurrency
+		result2 error
+	}
+	pullConcurrencyReturnsOnCall map[int]struct {
+		result1 model.PullConcurrency
+		result2 error
+	}
+	PullPausedStub        func(string) bool
+	pullPausedMutex       sync.RWMutex
+	pullPausedArgsForCall []struct {
+		arg1 string
+	}
+	pullPausedReturns struct {
+		result1 bool
+	}
+	pullPausedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	PullPlanStub        func(string) (model.PullPlan, error)
+	pullPlanMutex       sync.RWMutex
+	pullPlanArgsForCall []struct {
+		arg1 string
+	}
+	pullPlanReturns struct {
+		result1 model.PullPlan
+		result2 error
+	}
+	pullPlanReturnsOnCall map[int]struct {
+		result1 model.PullPlan
+		result2 error
+	}
+	PullRetriesStub        func(string) (model.PullRetries, error)
+	pullRetriesMutex       sync.RWMutex
+	pullRetriesArgsForCall []struct {
+		arg1 string
+	}
+	pullRetriesReturns struct {
+		result1 model.PullRetries
+		result2 error
+	}
+	pullRetriesReturnsOnCall map[int]struct {
+		result1 model.PullRetries
+		result2 error
+	}
+	RehashFolderStub        func(string) error
+	rehashFolderMutex       sync.RWMutex
+	rehashFolderArgsForCall []struct {
+		arg1 string
+	}
+	rehashFolderReturns struct {
+		result1 error
+	}
+	rehashFolderReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RehashStatusStub        func(string) (model.RehashStatus, error)
+	rehashStatusMutex       sync.RWMutex
+	rehashStatusArgsForCall []struct {
+		arg1 string
+	}
+	rehashStatusReturns struct {
+		result1 model.RehashStatus
+		result2 error
+	}
+	rehashStatusReturnsOnCall map[int]struct {
+		result1 model.RehashStatus
+		result2 error
+	}
 	RemoteNeedFolderFilesStub        func(string, protocol.DeviceID, int, int) ([]db.FileInfoTruncated, error)
 	remoteNeedFolderFilesMutex       sync.RWMutex
 	remoteNeedFolderFilesArgsForCall []struct {
@@ -409,6 +708,19 @@ type Model struct {
 		result1 []db.FileInfoTruncated
 		result2 error
 	}
+	RepairMtimesStub        func(string) (int, error)
+	repairMtimesMutex       sync.RWMutex
+	repairMtimesArgsForCall []struct {
+		arg1 string
+	}
+	repairMtimesReturns struct {
+		result1 int
+		result2 error
+	}
+	repairMtimesReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	RequestStub        func(protocol.DeviceID, string, string, int32, int32, int64, []byte, uint32, bool) (protocol.RequestResponse, error)
 	requestMutex       sync.RWMutex
 	requestArgsForCall []struct {
@@ -435,6 +747,18 @@ type Model struct {
 	resetFolderArgsForCall []struct {
 		arg1 string
 	}
+	RestartWatchStub        func(context.Context, string) error
+	restartWatchMutex       sync.RWMutex
+	restartWatchArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	restartWatchReturns struct {
+		result1 error
+	}
+	restartWatchReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RestoreFolderVersionsStub        func(string, map[string]time.Time) (map[string]error, error)
 	restoreFolderVersionsMutex       sync.RWMutex
 	restoreFolderVersionsArgsForCall []struct {
@@ -449,11 +773,61 @@ type Model struct {
 		result1 map[string]error
 		result2 error
 	}
+	ResumePullingStub        func(string) error
+	resumePullingMutex       sync.RWMutex
+	resumePullingArgsForCall []struct {
+		arg1 string
+	}
+	resumePullingReturns struct {
+		result1 error
+	}
+	resumePullingReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RetryPullErrorStub        func(string, string) error
+	retryPullErrorMutex       sync.RWMutex
+	retryPullErrorArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	retryPullErrorReturns struct {
+		result1 error
+	}
+	retryPullErrorReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RevertStub        func(string)
 	revertMutex       sync.RWMutex
 	revertArgsForCall []struct {
 		arg1 string
 	}
+	ScanDelayStub        func(string) (string, time.Time)
+	scanDelayMutex       sync.RWMutex
+	scanDelayArgsForCall []struct {
+		arg1 string
+	}
+	scanDelayReturns struct {
+		result1 string
+		result2 time.Time
+	}
+	scanDelayReturnsOnCall map[int]struct {
+		result1 string
+		result2 time.Time
+	}
+	ScanDryRunStub        func(string, []string) ([]protocol.FileInfo, error)
+	scanDryRunMutex       sync.RWMutex
+	scanDryRunArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	scanDryRunReturns struct {
+		result1 []protocol.FileInfo
+		result2 error
+	}
+	scanDryRunReturnsOnCall map[int]struct {
+		result1 []protocol.FileInfo
+		result2 error
+	}
 	ScanFolderStub        func(string) error
 	scanFolderMutex       sync.RWMutex
 	scanFolderArgsForCall []struct {
@@ -477,6 +851,19 @@ type Model struct {
 	scanFolderSubdirsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ScanFolderSubdirsWithHashersStub        func(string, []string, int) error
+	scanFolderSubdirsWithHashersMutex       sync.RWMutex
+	scanFolderSubdirsWithHashersArgsForCall []struct {
+		arg1 string
+		arg2 []string
+		arg3 int
+	}
+	scanFolderSubdirsWithHashersReturns struct {
+		result1 error
+	}
+	scanFolderSubdirsWithHashersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ScanFoldersStub        func() map[string]error
 	scanFoldersMutex       sync.RWMutex
 	scanFoldersArgsForCall []struct {
@@ -487,6 +874,21 @@ type Model struct {
 	scanFoldersReturnsOnCall map[int]struct {
 		result1 map[string]error
 	}
+	ScanProgressStub        func(string) (int64, int64, float64)
+	scanProgressMutex       sync.RWMutex
+	scanProgressArgsForCall []struct {
+		arg1 string
+	}
+	scanProgressReturns struct {
+		result1 int64
+		result2 int64
+		result3 float64
+	}
+	scanProgressReturnsOnCall map[int]struct {
+		result1 int64
+		result2 int64
+		result3 float64
+	}
 	ServeStub        func(context.Context) error
 	serveMutex       sync.RWMutex
 	serveArgsForCall []struct {
@@ -510,6 +912,44 @@ type Model struct {
 	setIgnoresReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetPullPausedStub        func(string, bool) error
+	setPullPausedMutex       sync.RWMutex
+	setPullPausedArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	setPullPausedReturns struct {
+		result1 error
+	}
+	setPullPausedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SizeDistributionStub        func(string) (model.SizeDistribution, error)
+	sizeDistributionMutex       sync.RWMutex
+	sizeDistributionArgsForCall []struct {
+		arg1 string
+	}
+	sizeDistributionReturns struct {
+		result1 model.SizeDistribution
+		result2 error
+	}
+	sizeDistributionReturnsOnCall map[int]struct {
+		result1 model.SizeDistribution
+		result2 error
+	}
+	SkippedSymlinksStub        func(string) ([]string, error)
+	skippedSymlinksMutex       sync.RWMutex
+	skippedSymlinksArgsForCall []struct {
+		arg1 string
+	}
+	skippedSymlinksReturns struct {
+		result1 []string
+		result2 error
+	}
+	skippedSymlinksReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	StartDeadlockDetectorStub        func(time.Duration)
 	startDeadlockDetectorMutex       sync.RWMutex
 	startDeadlockDetectorArgsForCall []struct {
@@ -530,6 +970,32 @@ type Model struct {
 		result2 time.Time
 		result3 error
 	}
+	TempFilesStub        func(string) ([]model.TempFile, error)
+	tempFilesMutex       sync.RWMutex
+	tempFilesArgsForCall []struct {
+		arg1 string
+	}
+	tempFilesReturns struct {
+		result1 []model.TempFile
+		result2 error
+	}
+	tempFilesReturnsOnCall map[int]struct {
+		result1 []model.TempFile
+		result2 error
+	}
+	TransferTotalsStub        func(string) (stats.TransferTotals, error)
+	transferTotalsMutex       sync.RWMutex
+	transferTotalsArgsForCall []struct {
+		arg1 string
+	}
+	transferTotalsReturns struct {
+		result1 stats.TransferTotals
+		result2 error
+	}
+	transferTotalsReturnsOnCall map[int]struct {
+		result1 stats.TransferTotals
+		result2 error
+	}
 	UsageReportingStatsStub        func(*contract.Report, int, bool)
 	usageReportingStatsMutex       sync.RWMutex
 	usageReportingStatsArgsForCall []struct {
@@ -548,21 +1014,97 @@ type Model struct {
 	watchErrorReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WatchStatusStub        func(string) (error, time.Time, int)
+	watchStatusMutex       sync.RWMutex
+	watchStatusArgsForCall []struct {
+		arg1 string
+	}
+	watchStatusReturns struct {
+		result1 error
+		result2 time.Time
+		result3 int
+	}
+	watchStatusReturnsOnCall map[int]struct {
+		result1 error
+		result2 time.Time
+		result3 int
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *Model) AddConnection(arg1 protocol.Connection, arg2 protocol.Hello) {
-	fake.addConnectionMutex.Lock()
-	fake.addConnectionArgsForCall = append(fake.addConnectionArgsForCall, struct {
-		arg1 protocol.Connection
-		arg2 protocol.Hello
-	}{arg1, arg2})
-	stub := fake.AddConnectionStub
-	fake.recordInvocation("AddConnection", []interface{}{arg1, arg2})
-	fake.addConnectionMutex.Unlock()
+func (fake *Model) AcknowledgeEmptyPath(arg1 string) error {
+	fake.acknowledgeEmptyPathMutex.Lock()
+	ret, specificReturn := fake.acknowledgeEmptyPathReturnsOnCall[len(fake.acknowledgeEmptyPathArgsForCall)]
+	fake.acknowledgeEmptyPathArgsForCall = append(fake.acknowledgeEmptyPathArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.AcknowledgeEmptyPathStub
+	fakeReturns := fake.acknowledgeEmptyPathReturns
+	fake.recordInvocation("AcknowledgeEmptyPath", []interface{}{arg1})
+	fake.acknowledgeEmptyPathMutex.Unlock()
 	if stub != nil {
-		fake.AddConnectionStub(arg1, arg2)
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) AcknowledgeEmptyPathCallCount() int {
+	fake.acknowledgeEmptyPathMutex.RLock()
+	defer fake.acknowledgeEmptyPathMutex.RUnlock()
+	return len(fake.acknowledgeEmptyPathArgsForCall)
+}
+
+func (fake *Model) AcknowledgeEmptyPathCalls(stub func(string) error) {
+	fake.acknowledgeEmptyPathMutex.Lock()
+	defer fake.acknowledgeEmptyPathMutex.Unlock()
+	fake.AcknowledgeEmptyPathStub = stub
+}
+
+func (fake *Model) AcknowledgeEmptyPathArgsForCall(i int) string {
+	fake.acknowledgeEmptyPathMutex.RLock()
+	defer fake.acknowledgeEmptyPathMutex.RUnlock()
+	argsForCall := fake.acknowledgeEmptyPathArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) AcknowledgeEmptyPathReturns(result1 error) {
+	fake.acknowledgeEmptyPathMutex.Lock()
+	defer fake.acknowledgeEmptyPathMutex.Unlock()
+	fake.AcknowledgeEmptyPathStub = nil
+	fake.acknowledgeEmptyPathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) AcknowledgeEmptyPathReturnsOnCall(i int, result1 error) {
+	fake.acknowledgeEmptyPathMutex.Lock()
+	defer fake.acknowledgeEmptyPathMutex.Unlock()
+	fake.AcknowledgeEmptyPathStub = nil
+	if fake.acknowledgeEmptyPathReturnsOnCall == nil {
+		fake.acknowledgeEmptyPathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.acknowledgeEmptyPathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) AddConnection(arg1 protocol.Connection, arg2 protocol.Hello) {
+	fake.addConnectionMutex.Lock()
+	fake.addConnectionArgsForCall = append(fake.addConnectionArgsForCall, struct {
+		arg1 protocol.Connection
+		arg2 protocol.Hello
+	}{arg1, arg2})
+	stub := fake.AddConnectionStub
+	fake.recordInvocation("AddConnection", []interface{}{arg1, arg2})
+	fake.addConnectionMutex.Unlock()
+	if stub != nil {
+		fake.AddConnectionStub(arg1, arg2)
 	}
 }
 
@@ -585,6 +1127,71 @@ func (fake *Model) AddConnectionArgsForCall(i int) (protocol.Connection, protoco
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *Model) AuditEntries(arg1 string, arg2 model.AuditQuery) ([]model.AuditEntry, error) {
+	fake.auditEntriesMutex.Lock()
+	ret, specificReturn := fake.auditEntriesReturnsOnCall[len(fake.auditEntriesArgsForCall)]
+	fake.auditEntriesArgsForCall = append(fake.auditEntriesArgsForCall, struct {
+		arg1 string
+		arg2 model.AuditQuery
+	}{arg1, arg2})
+	stub := fake.AuditEntriesStub
+	fakeReturns := fake.auditEntriesReturns
+	fake.recordInvocation("AuditEntries", []interface{}{arg1, arg2})
+	fake.auditEntriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) AuditEntriesCallCount() int {
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	return len(fake.auditEntriesArgsForCall)
+}
+
+func (fake *Model) AuditEntriesCalls(stub func(string, model.AuditQuery) ([]model.AuditEntry, error)) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = stub
+}
+
+func (fake *Model) AuditEntriesArgsForCall(i int) (string, model.AuditQuery) {
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	argsForCall := fake.auditEntriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) AuditEntriesReturns(result1 []model.AuditEntry, result2 error) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = nil
+	fake.auditEntriesReturns = struct {
+		result1 []model.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) AuditEntriesReturnsOnCall(i int, result1 []model.AuditEntry, result2 error) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = nil
+	if fake.auditEntriesReturnsOnCall == nil {
+		fake.auditEntriesReturnsOnCall = make(map[int]struct {
+			result1 []model.AuditEntry
+			result2 error
+		})
+	}
+	fake.auditEntriesReturnsOnCall[i] = struct {
+		result1 []model.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) Availability(arg1 string, arg2 protocol.FileInfo, arg3 protocol.BlockInfo) ([]model.Availability, error) {
 	fake.availabilityMutex.Lock()
 	ret, specificReturn := fake.availabilityReturnsOnCall[len(fake.availabilityArgsForCall)]
@@ -684,6 +1291,193 @@ func (fake *Model) BringToFrontArgsForCall(i int) (string, string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *Model) CancelRehash(arg1 string) error {
+	fake.cancelRehashMutex.Lock()
+	ret, specificReturn := fake.cancelRehashReturnsOnCall[len(fake.cancelRehashArgsForCall)]
+	fake.cancelRehashArgsForCall = append(fake.cancelRehashArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CancelRehashStub
+	fakeReturns := fake.cancelRehashReturns
+	fake.recordInvocation("CancelRehash", []interface{}{arg1})
+	fake.cancelRehashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) CancelRehashCallCount() int {
+	fake.cancelRehashMutex.RLock()
+	defer fake.cancelRehashMutex.RUnlock()
+	return len(fake.cancelRehashArgsForCall)
+}
+
+func (fake *Model) CancelRehashCalls(stub func(string) error) {
+	fake.cancelRehashMutex.Lock()
+	defer fake.cancelRehashMutex.Unlock()
+	fake.CancelRehashStub = stub
+}
+
+func (fake *Model) CancelRehashArgsForCall(i int) string {
+	fake.cancelRehashMutex.RLock()
+	defer fake.cancelRehashMutex.RUnlock()
+	argsForCall := fake.cancelRehashArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) CancelRehashReturns(result1 error) {
+	fake.cancelRehashMutex.Lock()
+	defer fake.cancelRehashMutex.Unlock()
+	fake.CancelRehashStub = nil
+	fake.cancelRehashReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) CancelRehashReturnsOnCall(i int, result1 error) {
+	fake.cancelRehashMutex.Lock()
+	defer fake.cancelRehashMutex.Unlock()
+	fake.CancelRehashStub = nil
+	if fake.cancelRehashReturnsOnCall == nil {
+		fake.cancelRehashReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelRehashReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ChronicConflicts(arg1 string) ([]model.ChronicConflict, error) {
+	fake.chronicConflictsMutex.Lock()
+	ret, specificReturn := fake.chronicConflictsReturnsOnCall[len(fake.chronicConflictsArgsForCall)]
+	fake.chronicConflictsArgsForCall = append(fake.chronicConflictsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ChronicConflictsStub
+	fakeReturns := fake.chronicConflictsReturns
+	fake.recordInvocation("ChronicConflicts", []interface{}{arg1})
+	fake.chronicConflictsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ChronicConflictsCallCount() int {
+	fake.chronicConflictsMutex.RLock()
+	defer fake.chronicConflictsMutex.RUnlock()
+	return len(fake.chronicConflictsArgsForCall)
+}
+
+func (fake *Model) ChronicConflictsCalls(stub func(string) ([]model.ChronicConflict, error)) {
+	fake.chronicConflictsMutex.Lock()
+	defer fake.chronicConflictsMutex.Unlock()
+	fake.ChronicConflictsStub = stub
+}
+
+func (fake *Model) ChronicConflictsArgsForCall(i int) string {
+	fake.chronicConflictsMutex.RLock()
+	defer fake.chronicConflictsMutex.RUnlock()
+	argsForCall := fake.chronicConflictsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ChronicConflictsReturns(result1 []model.ChronicConflict, result2 error) {
+	fake.chronicConflictsMutex.Lock()
+	defer fake.chronicConflictsMutex.Unlock()
+	fake.ChronicConflictsStub = nil
+	fake.chronicConflictsReturns = struct {
+		result1 []model.ChronicConflict
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ChronicConflictsReturnsOnCall(i int, result1 []model.ChronicConflict, result2 error) {
+	fake.chronicConflictsMutex.Lock()
+	defer fake.chronicConflictsMutex.Unlock()
+	fake.ChronicConflictsStub = nil
+	if fake.chronicConflictsReturnsOnCall == nil {
+		fake.chronicConflictsReturnsOnCall = make(map[int]struct {
+			result1 []model.ChronicConflict
+			result2 error
+		})
+	}
+	fake.chronicConflictsReturnsOnCall[i] = struct {
+		result1 []model.ChronicConflict
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ClearPullError(arg1 string, arg2 string) error {
+	fake.clearPullErrorMutex.Lock()
+	ret, specificReturn := fake.clearPullErrorReturnsOnCall[len(fake.clearPullErrorArgsForCall)]
+	fake.clearPullErrorArgsForCall = append(fake.clearPullErrorArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ClearPullErrorStub
+	fakeReturns := fake.clearPullErrorReturns
+	fake.recordInvocation("ClearPullError", []interface{}{arg1, arg2})
+	fake.clearPullErrorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ClearPullErrorCallCount() int {
+	fake.clearPullErrorMutex.RLock()
+	defer fake.clearPullErrorMutex.RUnlock()
+	return len(fake.clearPullErrorArgsForCall)
+}
+
+func (fake *Model) ClearPullErrorCalls(stub func(string, string) error) {
+	fake.clearPullErrorMutex.Lock()
+	defer fake.clearPullErrorMutex.Unlock()
+	fake.ClearPullErrorStub = stub
+}
+
+func (fake *Model) ClearPullErrorArgsForCall(i int) (string, string) {
+	fake.clearPullErrorMutex.RLock()
+	defer fake.clearPullErrorMutex.RUnlock()
+	argsForCall := fake.clearPullErrorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) ClearPullErrorReturns(result1 error) {
+	fake.clearPullErrorMutex.Lock()
+	defer fake.clearPullErrorMutex.Unlock()
+	fake.ClearPullErrorStub = nil
+	fake.clearPullErrorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ClearPullErrorReturnsOnCall(i int, result1 error) {
+	fake.clearPullErrorMutex.Lock()
+	defer fake.clearPullErrorMutex.Unlock()
+	fake.ClearPullErrorStub = nil
+	if fake.clearPullErrorReturnsOnCall == nil {
+		fake.clearPullErrorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearPullErrorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) Closed(arg1 protocol.DeviceID, arg2 error) {
 	fake.closedMutex.Lock()
 	fake.closedArgsForCall = append(fake.closedArgsForCall, struct {
@@ -779,6 +1573,73 @@ func (fake *Model) ClusterConfigReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) CompareFolders(arg1 string, arg2 string, arg3 int, arg4 int) ([]model.FolderDifference, error) {
+	fake.compareFoldersMutex.Lock()
+	ret, specificReturn := fake.compareFoldersReturnsOnCall[len(fake.compareFoldersArgsForCall)]
+	fake.compareFoldersArgsForCall = append(fake.compareFoldersArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CompareFoldersStub
+	fakeReturns := fake.compareFoldersReturns
+	fake.recordInvocation("CompareFolders", []interface{}{arg1, arg2, arg3, arg4})
+	fake.compareFoldersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) CompareFoldersCallCount() int {
+	fake.compareFoldersMutex.RLock()
+	defer fake.compareFoldersMutex.RUnlock()
+	return len(fake.compareFoldersArgsForCall)
+}
+
+func (fake *Model) CompareFoldersCalls(stub func(string, string, int, int) ([]model.FolderDifference, error)) {
+	fake.compareFoldersMutex.Lock()
+	defer fake.compareFoldersMutex.Unlock()
+	fake.CompareFoldersStub = stub
+}
+
+func (fake *Model) CompareFoldersArgsForCall(i int) (string, string, int, int) {
+	fake.compareFoldersMutex.RLock()
+	defer fake.compareFoldersMutex.RUnlock()
+	argsForCall := fake.compareFoldersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *Model) CompareFoldersReturns(result1 []model.FolderDifference, result2 error) {
+	fake.compareFoldersMutex.Lock()
+	defer fake.compareFoldersMutex.Unlock()
+	fake.CompareFoldersStub = nil
+	fake.compareFoldersReturns = struct {
+		result1 []model.FolderDifference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) CompareFoldersReturnsOnCall(i int, result1 []model.FolderDifference, result2 error) {
+	fake.compareFoldersMutex.Lock()
+	defer fake.compareFoldersMutex.Unlock()
+	fake.CompareFoldersStub = nil
+	if fake.compareFoldersReturnsOnCall == nil {
+		fake.compareFoldersReturnsOnCall = make(map[int]struct {
+			result1 []model.FolderDifference
+			result2 error
+		})
+	}
+	fake.compareFoldersReturnsOnCall[i] = struct {
+		result1 []model.FolderDifference
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) Completion(arg1 protocol.DeviceID, arg2 string) (model.FolderCompletion, error) {
 	fake.completionMutex.Lock()
 	ret, specificReturn := fake.completionReturnsOnCall[len(fake.completionArgsForCall)]
@@ -1261,6 +2122,40 @@ func (fake *Model) DelayScanArgsForCall(i int) (string, time.Duration) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *Model) DelayScanWithReason(arg1 string, arg2 time.Duration, arg3 string) {
+	fake.delayScanWithReasonMutex.Lock()
+	fake.delayScanWithReasonArgsForCall = append(fake.delayScanWithReasonArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DelayScanWithReasonStub
+	fake.recordInvocation("DelayScanWithReason", []interface{}{arg1, arg2, arg3})
+	fake.delayScanWithReasonMutex.Unlock()
+	if stub != nil {
+		fake.DelayScanWithReasonStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *Model) DelayScanWithReasonCallCount() int {
+	fake.delayScanWithReasonMutex.RLock()
+	defer fake.delayScanWithReasonMutex.RUnlock()
+	return len(fake.delayScanWithReasonArgsForCall)
+}
+
+func (fake *Model) DelayScanWithReasonCalls(stub func(string, time.Duration, string)) {
+	fake.delayScanWithReasonMutex.Lock()
+	defer fake.delayScanWithReasonMutex.Unlock()
+	fake.DelayScanWithReasonStub = stub
+}
+
+func (fake *Model) DelayScanWithReasonArgsForCall(i int) (string, time.Duration, string) {
+	fake.delayScanWithReasonMutex.RLock()
+	defer fake.delayScanWithReasonMutex.RUnlock()
+	argsForCall := fake.delayScanWithReasonArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *Model) DeviceStatistics() (map[protocol.DeviceID]stats.DeviceStatistics, error) {
 	fake.deviceStatisticsMutex.Lock()
 	ret, specificReturn := fake.deviceStatisticsReturnsOnCall[len(fake.deviceStatisticsArgsForCall)]
@@ -1385,56 +2280,214 @@ func (fake *Model) DownloadProgressReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *Model) FolderErrors(arg1 string) ([]model.FileError, error) {
-	fake.folderErrorsMutex.Lock()
-	ret, specificReturn := fake.folderErrorsReturnsOnCall[len(fake.folderErrorsArgsForCall)]
-	fake.folderErrorsArgsForCall = append(fake.folderErrorsArgsForCall, struct {
-		arg1 string
+func (fake *Model) Drain(arg1 time.Duration) {
+	fake.drainMutex.Lock()
+	fake.drainArgsForCall = append(fake.drainArgsForCall, struct {
+		arg1 time.Duration
 	}{arg1})
-	stub := fake.FolderErrorsStub
-	fakeReturns := fake.folderErrorsReturns
-	fake.recordInvocation("FolderErrors", []interface{}{arg1})
-	fake.folderErrorsMutex.Unlock()
+	stub := fake.DrainStub
+	fake.recordInvocation("Drain", []interface{}{arg1})
+	fake.drainMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		fake.DrainStub(arg1)
+	}
+}
+
+func (fake *Model) DrainCallCount() int {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	return len(fake.drainArgsForCall)
+}
+
+func (fake *Model) DrainCalls(stub func(time.Duration)) {
+	fake.drainMutex.Lock()
+	defer fake.drainMutex.Unlock()
+	fake.DrainStub = stub
+}
+
+func (fake *Model) DrainArgsForCall(i int) time.Duration {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	argsForCall := fake.drainArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ExportLocalIndex(arg1 string, arg2 io.Writer) error {
+	fake.exportLocalIndexMutex.Lock()
+	ret, specificReturn := fake.exportLocalIndexReturnsOnCall[len(fake.exportLocalIndexArgsForCall)]
+	fake.exportLocalIndexArgsForCall = append(fake.exportLocalIndexArgsForCall, struct {
+		arg1 string
+		arg2 io.Writer
+	}{arg1, arg2})
+	stub := fake.ExportLocalIndexStub
+	fakeReturns := fake.exportLocalIndexReturns
+	fake.recordInvocation("ExportLocalIndex", []interface{}{arg1, arg2})
+	fake.exportLocalIndexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *Model) FolderErrorsCallCount() int {
-	fake.folderErrorsMutex.RLock()
-	defer fake.folderErrorsMutex.RUnlock()
-	return len(fake.folderErrorsArgsForCall)
+func (fake *Model) ExportLocalIndexCallCount() int {
+	fake.exportLocalIndexMutex.RLock()
+	defer fake.exportLocalIndexMutex.RUnlock()
+	return len(fake.exportLocalIndexArgsForCall)
 }
 
-func (fake *Model) FolderErrorsCalls(stub func(string) ([]model.FileError, error)) {
-	fake.folderErrorsMutex.Lock()
-	defer fake.folderErrorsMutex.Unlock()
-	fake.FolderErrorsStub = stub
+func (fake *Model) ExportLocalIndexCalls(stub func(string, io.Writer) error) {
+	fake.exportLocalIndexMutex.Lock()
+	defer fake.exportLocalIndexMutex.Unlock()
+	fake.ExportLocalIndexStub = stub
 }
 
-func (fake *Model) FolderErrorsArgsForCall(i int) string {
-	fake.folderErrorsMutex.RLock()
-	defer fake.folderErrorsMutex.RUnlock()
-	argsForCall := fake.folderErrorsArgsForCall[i]
-	return argsForCall.arg1
+func (fake *Model) ExportLocalIndexArgsForCall(i int) (string, io.Writer) {
+	fake.exportLocalIndexMutex.RLock()
+	defer fake.exportLocalIndexMutex.RUnlock()
+	argsForCall := fake.exportLocalIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *Model) FolderErrorsReturns(result1 []model.FileError, result2 error) {
-	fake.folderErrorsMutex.Lock()
-	defer fake.folderErrorsMutex.Unlock()
-	fake.FolderErrorsStub = nil
-	fake.folderErrorsReturns = struct {
-		result1 []model.FileError
-		result2 error
-	}{result1, result2}
+func (fake *Model) ExportLocalIndexReturns(result1 error) {
+	fake.exportLocalIndexMutex.Lock()
+	defer fake.exportLocalIndexMutex.Unlock()
+	fake.ExportLocalIndexStub = nil
+	fake.exportLocalIndexReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *Model) FolderErrorsReturnsOnCall(i int, result1 []model.FileError, result2 error) {
-	fake.folderErrorsMutex.Lock()
+func (fake *Model) ExportLocalIndexReturnsOnCall(i int, result1 error) {
+	fake.exportLocalIndexMutex.Lock()
+	defer fake.exportLocalIndexMutex.Unlock()
+	fake.ExportLocalIndexStub = nil
+	if fake.exportLocalIndexReturnsOnCall == nil {
+		fake.exportLocalIndexReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.exportLocalIndexReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) FilesModifiedBy(arg1 string, arg2 string, arg3 string, arg4 func(db.FileInfoTruncated) bool) error {
+	fake.filesModifiedByMutex.Lock()
+	ret, specificReturn := fake.filesModifiedByReturnsOnCall[len(fake.filesModifiedByArgsForCall)]
+	fake.filesModifiedByArgsForCall = append(fake.filesModifiedByArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 func(db.FileInfoTruncated) bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FilesModifiedByStub
+	fakeReturns := fake.filesModifiedByReturns
+	fake.recordInvocation("FilesModifiedBy", []interface{}{arg1, arg2, arg3, arg4})
+	fake.filesModifiedByMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) FilesModifiedByCallCount() int {
+	fake.filesModifiedByMutex.RLock()
+	defer fake.filesModifiedByMutex.RUnlock()
+	return len(fake.filesModifiedByArgsForCall)
+}
+
+func (fake *Model) FilesModifiedByCalls(stub func(string, string, string, func(db.FileInfoTruncated) bool) error) {
+	fake.filesModifiedByMutex.Lock()
+	defer fake.filesModifiedByMutex.Unlock()
+	fake.FilesModifiedByStub = stub
+}
+
+func (fake *Model) FilesModifiedByArgsForCall(i int) (string, string, string, func(db.FileInfoTruncated) bool) {
+	fake.filesModifiedByMutex.RLock()
+	defer fake.filesModifiedByMutex.RUnlock()
+	argsForCall := fake.filesModifiedByArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *Model) FilesModifiedByReturns(result1 error) {
+	fake.filesModifiedByMutex.Lock()
+	defer fake.filesModifiedByMutex.Unlock()
+	fake.FilesModifiedByStub = nil
+	fake.filesModifiedByReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) FilesModifiedByReturnsOnCall(i int, result1 error) {
+	fake.filesModifiedByMutex.Lock()
+	defer fake.filesModifiedByMutex.Unlock()
+	fake.FilesModifiedByStub = nil
+	if fake.filesModifiedByReturnsOnCall == nil {
+		fake.filesModifiedByReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.filesModifiedByReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) FolderErrors(arg1 string) ([]model.FileError, error) {
+	fake.folderErrorsMutex.Lock()
+	ret, specificReturn := fake.folderErrorsReturnsOnCall[len(fake.folderErrorsArgsForCall)]
+	fake.folderErrorsArgsForCall = append(fake.folderErrorsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FolderErrorsStub
+	fakeReturns := fake.folderErrorsReturns
+	fake.recordInvocation("FolderErrors", []interface{}{arg1})
+	fake.folderErrorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) FolderErrorsCallCount() int {
+	fake.folderErrorsMutex.RLock()
+	defer fake.folderErrorsMutex.RUnlock()
+	return len(fake.folderErrorsArgsForCall)
+}
+
+func (fake *Model) FolderErrorsCalls(stub func(string) ([]model.FileError, error)) {
+	fake.folderErrorsMutex.Lock()
+	defer fake.folderErrorsMutex.Unlock()
+	fake.FolderErrorsStub = stub
+}
+
+func (fake *Model) FolderErrorsArgsForCall(i int) string {
+	fake.folderErrorsMutex.RLock()
+	defer fake.folderErrorsMutex.RUnlock()
+	argsForCall := fake.folderErrorsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) FolderErrorsReturns(result1 []model.FileError, result2 error) {
+	fake.folderErrorsMutex.Lock()
+	defer fake.folderErrorsMutex.Unlock()
+	fake.FolderErrorsStub = nil
+	fake.folderErrorsReturns = struct {
+		result1 []model.FileError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderErrorsReturnsOnCall(i int, result1 []model.FileError, result2 error) {
+	fake.folderErrorsMutex.Lock()
 	defer fake.folderErrorsMutex.Unlock()
 	fake.FolderErrorsStub = nil
 	if fake.folderErrorsReturnsOnCall == nil {
@@ -1449,6 +2502,70 @@ func (fake *Model) FolderErrorsReturnsOnCall(i int, result1 []model.FileError, r
 	}{result1, result2}
 }
 
+func (fake *Model) FolderHealth(arg1 string) (model.FolderHealth, error) {
+	fake.folderHealthMutex.Lock()
+	ret, specificReturn := fake.folderHealthReturnsOnCall[len(fake.folderHealthArgsForCall)]
+	fake.folderHealthArgsForCall = append(fake.folderHealthArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FolderHealthStub
+	fakeReturns := fake.folderHealthReturns
+	fake.recordInvocation("FolderHealth", []interface{}{arg1})
+	fake.folderHealthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) FolderHealthCallCount() int {
+	fake.folderHealthMutex.RLock()
+	defer fake.folderHealthMutex.RUnlock()
+	return len(fake.folderHealthArgsForCall)
+}
+
+func (fake *Model) FolderHealthCalls(stub func(string) (model.FolderHealth, error)) {
+	fake.folderHealthMutex.Lock()
+	defer fake.folderHealthMutex.Unlock()
+	fake.FolderHealthStub = stub
+}
+
+func (fake *Model) FolderHealthArgsForCall(i int) string {
+	fake.folderHealthMutex.RLock()
+	defer fake.folderHealthMutex.RUnlock()
+	argsForCall := fake.folderHealthArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) FolderHealthReturns(result1 model.FolderHealth, result2 error) {
+	fake.folderHealthMutex.Lock()
+	defer fake.folderHealthMutex.Unlock()
+	fake.FolderHealthStub = nil
+	fake.folderHealthReturns = struct {
+		result1 model.FolderHealth
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderHealthReturnsOnCall(i int, result1 model.FolderHealth, result2 error) {
+	fake.folderHealthMutex.Lock()
+	defer fake.folderHealthMutex.Unlock()
+	fake.FolderHealthStub = nil
+	if fake.folderHealthReturnsOnCall == nil {
+		fake.folderHealthReturnsOnCall = make(map[int]struct {
+			result1 model.FolderHealth
+			result2 error
+		})
+	}
+	fake.folderHealthReturnsOnCall[i] = struct {
+		result1 model.FolderHealth
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) FolderProgressBytesCompleted(arg1 string) int64 {
 	fake.folderProgressBytesCompletedMutex.Lock()
 	ret, specificReturn := fake.folderProgressBytesCompletedReturnsOnCall[len(fake.folderProgressBytesCompletedArgsForCall)]
@@ -1566,6 +2683,73 @@ func (fake *Model) FolderStatisticsReturnsOnCall(i int, result1 map[string]stats
 	}{result1, result2}
 }
 
+func (fake *Model) ForceScanFolderSubdirs(arg1 string, arg2 []string) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.forceScanFolderSubdirsMutex.Lock()
+	ret, specificReturn := fake.forceScanFolderSubdirsReturnsOnCall[len(fake.forceScanFolderSubdirsArgsForCall)]
+	fake.forceScanFolderSubdirsArgsForCall = append(fake.forceScanFolderSubdirsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.ForceScanFolderSubdirsStub
+	fakeReturns := fake.forceScanFolderSubdirsReturns
+	fake.recordInvocation("ForceScanFolderSubdirs", []interface{}{arg1, arg2Copy})
+	fake.forceScanFolderSubdirsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ForceScanFolderSubdirsCallCount() int {
+	fake.forceScanFolderSubdirsMutex.RLock()
+	defer fake.forceScanFolderSubdirsMutex.RUnlock()
+	return len(fake.forceScanFolderSubdirsArgsForCall)
+}
+
+func (fake *Model) ForceScanFolderSubdirsCalls(stub func(string, []string) error) {
+	fake.forceScanFolderSubdirsMutex.Lock()
+	defer fake.forceScanFolderSubdirsMutex.Unlock()
+	fake.ForceScanFolderSubdirsStub = stub
+}
+
+func (fake *Model) ForceScanFolderSubdirsArgsForCall(i int) (string, []string) {
+	fake.forceScanFolderSubdirsMutex.RLock()
+	defer fake.forceScanFolderSubdirsMutex.RUnlock()
+	argsForCall := fake.forceScanFolderSubdirsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) ForceScanFolderSubdirsReturns(result1 error) {
+	fake.forceScanFolderSubdirsMutex.Lock()
+	defer fake.forceScanFolderSubdirsMutex.Unlock()
+	fake.ForceScanFolderSubdirsStub = nil
+	fake.forceScanFolderSubdirsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ForceScanFolderSubdirsReturnsOnCall(i int, result1 error) {
+	fake.forceScanFolderSubdirsMutex.Lock()
+	defer fake.forceScanFolderSubdirsMutex.Unlock()
+	fake.ForceScanFolderSubdirsStub = nil
+	if fake.forceScanFolderSubdirsReturnsOnCall == nil {
+		fake.forceScanFolderSubdirsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forceScanFolderSubdirsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) GetFolderVersions(arg1 string) (map[string][]versioner.FileVersion, error) {
 	fake.getFolderVersionsMutex.Lock()
 	ret, specificReturn := fake.getFolderVersionsReturnsOnCall[len(fake.getFolderVersionsArgsForCall)]
@@ -1758,6 +2942,142 @@ func (fake *Model) GlobalDirectoryTreeReturnsOnCall(i int, result1 []*model.Tree
 	}{result1, result2}
 }
 
+func (fake *Model) IgnoreAndRemoveLocally(arg1 string, arg2 []string) (model.LocalRemoval, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.ignoreAndRemoveLocallyMutex.Lock()
+	ret, specificReturn := fake.ignoreAndRemoveLocallyReturnsOnCall[len(fake.ignoreAndRemoveLocallyArgsForCall)]
+	fake.ignoreAndRemoveLocallyArgsForCall = append(fake.ignoreAndRemoveLocallyArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.IgnoreAndRemoveLocallyStub
+	fakeReturns := fake.ignoreAndRemoveLocallyReturns
+	fake.recordInvocation("IgnoreAndRemoveLocally", []interface{}{arg1, arg2Copy})
+	fake.ignoreAndRemoveLocallyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) IgnoreAndRemoveLocallyCallCount() int {
+	fake.ignoreAndRemoveLocallyMutex.RLock()
+	defer fake.ignoreAndRemoveLocallyMutex.RUnlock()
+	return len(fake.ignoreAndRemoveLocallyArgsForCall)
+}
+
+func (fake *Model) IgnoreAndRemoveLocallyCalls(stub func(string, []string) (model.LocalRemoval, error)) {
+	fake.ignoreAndRemoveLocallyMutex.Lock()
+	defer fake.ignoreAndRemoveLocallyMutex.Unlock()
+	fake.IgnoreAndRemoveLocallyStub = stub
+}
+
+func (fake *Model) IgnoreAndRemoveLocallyArgsForCall(i int) (string, []string) {
+	fake.ignoreAndRemoveLocallyMutex.RLock()
+	defer fake.ignoreAndRemoveLocallyMutex.RUnlock()
+	argsForCall := fake.ignoreAndRemoveLocallyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) IgnoreAndRemoveLocallyReturns(result1 model.LocalRemoval, result2 error) {
+	fake.ignoreAndRemoveLocallyMutex.Lock()
+	defer fake.ignoreAndRemoveLocallyMutex.Unlock()
+	fake.IgnoreAndRemoveLocallyStub = nil
+	fake.ignoreAndRemoveLocallyReturns = struct {
+		result1 model.LocalRemoval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) IgnoreAndRemoveLocallyReturnsOnCall(i int, result1 model.LocalRemoval, result2 error) {
+	fake.ignoreAndRemoveLocallyMutex.Lock()
+	defer fake.ignoreAndRemoveLocallyMutex.Unlock()
+	fake.IgnoreAndRemoveLocallyStub = nil
+	if fake.ignoreAndRemoveLocallyReturnsOnCall == nil {
+		fake.ignoreAndRemoveLocallyReturnsOnCall = make(map[int]struct {
+			result1 model.LocalRemoval
+			result2 error
+		})
+	}
+	fake.ignoreAndRemoveLocallyReturnsOnCall[i] = struct {
+		result1 model.LocalRemoval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ImportLocalIndex(arg1 string, arg2 io.ReadSeeker, arg3 int) (int, error) {
+	fake.importLocalIndexMutex.Lock()
+	ret, specificReturn := fake.importLocalIndexReturnsOnCall[len(fake.importLocalIndexArgsForCall)]
+	fake.importLocalIndexArgsForCall = append(fake.importLocalIndexArgsForCall, struct {
+		arg1 string
+		arg2 io.ReadSeeker
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ImportLocalIndexStub
+	fakeReturns := fake.importLocalIndexReturns
+	fake.recordInvocation("ImportLocalIndex", []interface{}{arg1, arg2, arg3})
+	fake.importLocalIndexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ImportLocalIndexCallCount() int {
+	fake.importLocalIndexMutex.RLock()
+	defer fake.importLocalIndexMutex.RUnlock()
+	return len(fake.importLocalIndexArgsForCall)
+}
+
+func (fake *Model) ImportLocalIndexCalls(stub func(string, io.ReadSeeker, int) (int, error)) {
+	fake.importLocalIndexMutex.Lock()
+	defer fake.importLocalIndexMutex.Unlock()
+	fake.ImportLocalIndexStub = stub
+}
+
+func (fake *Model) ImportLocalIndexArgsForCall(i int) (string, io.ReadSeeker, int) {
+	fake.importLocalIndexMutex.RLock()
+	defer fake.importLocalIndexMutex.RUnlock()
+	argsForCall := fake.importLocalIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) ImportLocalIndexReturns(result1 int, result2 error) {
+	fake.importLocalIndexMutex.Lock()
+	defer fake.importLocalIndexMutex.Unlock()
+	fake.ImportLocalIndexStub = nil
+	fake.importLocalIndexReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ImportLocalIndexReturnsOnCall(i int, result1 int, result2 error) {
+	fake.importLocalIndexMutex.Lock()
+	defer fake.importLocalIndexMutex.Unlock()
+	fake.ImportLocalIndexStub = nil
+	if fake.importLocalIndexReturnsOnCall == nil {
+		fake.importLocalIndexReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.importLocalIndexReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) Index(arg1 protocol.DeviceID, arg2 string, arg3 []protocol.FileInfo) error {
 	var arg3Copy []protocol.FileInfo
 	if arg3 != nil {
@@ -1826,6 +3146,70 @@ func (fake *Model) IndexReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) IndexExchangeStatus(arg1 string) (map[protocol.DeviceID]model.IndexExchangeStatus, error) {
+	fake.indexExchangeStatusMutex.Lock()
+	ret, specificReturn := fake.indexExchangeStatusReturnsOnCall[len(fake.indexExchangeStatusArgsForCall)]
+	fake.indexExchangeStatusArgsForCall = append(fake.indexExchangeStatusArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IndexExchangeStatusStub
+	fakeReturns := fake.indexExchangeStatusReturns
+	fake.recordInvocation("IndexExchangeStatus", []interface{}{arg1})
+	fake.indexExchangeStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) IndexExchangeStatusCallCount() int {
+	fake.indexExchangeStatusMutex.RLock()
+	defer fake.indexExchangeStatusMutex.RUnlock()
+	return len(fake.indexExchangeStatusArgsForCall)
+}
+
+func (fake *Model) IndexExchangeStatusCalls(stub func(string) (map[protocol.DeviceID]model.IndexExchangeStatus, error)) {
+	fake.indexExchangeStatusMutex.Lock()
+	defer fake.indexExchangeStatusMutex.Unlock()
+	fake.IndexExchangeStatusStub = stub
+}
+
+func (fake *Model) IndexExchangeStatusArgsForCall(i int) string {
+	fake.indexExchangeStatusMutex.RLock()
+	defer fake.indexExchangeStatusMutex.RUnlock()
+	argsForCall := fake.indexExchangeStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) IndexExchangeStatusReturns(result1 map[protocol.DeviceID]model.IndexExchangeStatus, result2 error) {
+	fake.indexExchangeStatusMutex.Lock()
+	defer fake.indexExchangeStatusMutex.Unlock()
+	fake.IndexExchangeStatusStub = nil
+	fake.indexExchangeStatusReturns = struct {
+		result1 map[protocol.DeviceID]model.IndexExchangeStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) IndexExchangeStatusReturnsOnCall(i int, result1 map[protocol.DeviceID]model.IndexExchangeStatus, result2 error) {
+	fake.indexExchangeStatusMutex.Lock()
+	defer fake.indexExchangeStatusMutex.Unlock()
+	fake.IndexExchangeStatusStub = nil
+	if fake.indexExchangeStatusReturnsOnCall == nil {
+		fake.indexExchangeStatusReturnsOnCall = make(map[int]struct {
+			result1 map[protocol.DeviceID]model.IndexExchangeStatus
+			result2 error
+		})
+	}
+	fake.indexExchangeStatusReturnsOnCall[i] = struct {
+		result1 map[protocol.DeviceID]model.IndexExchangeStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) IndexUpdate(arg1 protocol.DeviceID, arg2 string, arg3 []protocol.FileInfo) error {
 	var arg3Copy []protocol.FileInfo
 	if arg3 != nil {
@@ -1894,14 +3278,82 @@ func (fake *Model) IndexUpdateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *Model) LoadIgnores(arg1 string) ([]string, []string, error) {
-	fake.loadIgnoresMutex.Lock()
-	ret, specificReturn := fake.loadIgnoresReturnsOnCall[len(fake.loadIgnoresArgsForCall)]
-	fake.loadIgnoresArgsForCall = append(fake.loadIgnoresArgsForCall, struct {
+func (fake *Model) IsPathIgnored(arg1 string, arg2 string) (bool, string, error) {
+	fake.isPathIgnoredMutex.Lock()
+	ret, specificReturn := fake.isPathIgnoredReturnsOnCall[len(fake.isPathIgnoredArgsForCall)]
+	fake.isPathIgnoredArgsForCall = append(fake.isPathIgnoredArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	stub := fake.LoadIgnoresStub
-	fakeReturns := fake.loadIgnoresReturns
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IsPathIgnoredStub
+	fakeReturns := fake.isPathIgnoredReturns
+	fake.recordInvocation("IsPathIgnored", []interface{}{arg1, arg2})
+	fake.isPathIgnoredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *Model) IsPathIgnoredCallCount() int {
+	fake.isPathIgnoredMutex.RLock()
+	defer fake.isPathIgnoredMutex.RUnlock()
+	return len(fake.isPathIgnoredArgsForCall)
+}
+
+func (fake *Model) IsPathIgnoredCalls(stub func(string, string) (bool, string, error)) {
+	fake.isPathIgnoredMutex.Lock()
+	defer fake.isPathIgnoredMutex.Unlock()
+	fake.IsPathIgnoredStub = stub
+}
+
+func (fake *Model) IsPathIgnoredArgsForCall(i int) (string, string) {
+	fake.isPathIgnoredMutex.RLock()
+	defer fake.isPathIgnoredMutex.RUnlock()
+	argsForCall := fake.isPathIgnoredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) IsPathIgnoredReturns(result1 bool, result2 string, result3 error) {
+	fake.isPathIgnoredMutex.Lock()
+	defer fake.isPathIgnoredMutex.Unlock()
+	fake.IsPathIgnoredStub = nil
+	fake.isPathIgnoredReturns = struct {
+		result1 bool
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *Model) IsPathIgnoredReturnsOnCall(i int, result1 bool, result2 string, result3 error) {
+	fake.isPathIgnoredMutex.Lock()
+	defer fake.isPathIgnoredMutex.Unlock()
+	fake.IsPathIgnoredStub = nil
+	if fake.isPathIgnoredReturnsOnCall == nil {
+		fake.isPathIgnoredReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 string
+			result3 error
+		})
+	}
+	fake.isPathIgnoredReturnsOnCall[i] = struct {
+		result1 bool
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *Model) LoadIgnores(arg1 string) ([]string, []string, error) {
+	fake.loadIgnoresMutex.Lock()
+	ret, specificReturn := fake.loadIgnoresReturnsOnCall[len(fake.loadIgnoresArgsForCall)]
+	fake.loadIgnoresArgsForCall = append(fake.loadIgnoresArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.LoadIgnoresStub
+	fakeReturns := fake.loadIgnoresReturns
 	fake.recordInvocation("LoadIgnores", []interface{}{arg1})
 	fake.loadIgnoresMutex.Unlock()
 	if stub != nil {
@@ -2027,6 +3479,133 @@ func (fake *Model) LocalChangedFolderFilesReturnsOnCall(i int, result1 []db.File
 	}{result1, result2}
 }
 
+func (fake *Model) LocalChangesSince(arg1 string, arg2 int64, arg3 int) (model.LocalChanges, error) {
+	fake.localChangesSinceMutex.Lock()
+	ret, specificReturn := fake.localChangesSinceReturnsOnCall[len(fake.localChangesSinceArgsForCall)]
+	fake.localChangesSinceArgsForCall = append(fake.localChangesSinceArgsForCall, struct {
+		arg1 string
+		arg2 int64
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.LocalChangesSinceStub
+	fakeReturns := fake.localChangesSinceReturns
+	fake.recordInvocation("LocalChangesSince", []interface{}{arg1, arg2, arg3})
+	fake.localChangesSinceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) LocalChangesSinceCallCount() int {
+	fake.localChangesSinceMutex.RLock()
+	defer fake.localChangesSinceMutex.RUnlock()
+	return len(fake.localChangesSinceArgsForCall)
+}
+
+func (fake *Model) LocalChangesSinceCalls(stub func(string, int64, int) (model.LocalChanges, error)) {
+	fake.localChangesSinceMutex.Lock()
+	defer fake.localChangesSinceMutex.Unlock()
+	fake.LocalChangesSinceStub = stub
+}
+
+func (fake *Model) LocalChangesSinceArgsForCall(i int) (string, int64, int) {
+	fake.localChangesSinceMutex.RLock()
+	defer fake.localChangesSinceMutex.RUnlock()
+	argsForCall := fake.localChangesSinceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) LocalChangesSinceReturns(result1 model.LocalChanges, result2 error) {
+	fake.localChangesSinceMutex.Lock()
+	defer fake.localChangesSinceMutex.Unlock()
+	fake.LocalChangesSinceStub = nil
+	fake.localChangesSinceReturns = struct {
+		result1 model.LocalChanges
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) LocalChangesSinceReturnsOnCall(i int, result1 model.LocalChanges, result2 error) {
+	fake.localChangesSinceMutex.Lock()
+	defer fake.localChangesSinceMutex.Unlock()
+	fake.LocalChangesSinceStub = nil
+	if fake.localChangesSinceReturnsOnCall == nil {
+		fake.localChangesSinceReturnsOnCall = make(map[int]struct {
+			result1 model.LocalChanges
+			result2 error
+		})
+	}
+	fake.localChangesSinceReturnsOnCall[i] = struct {
+		result1 model.LocalChanges
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ModTimeWindow(arg1 string) time.Duration {
+	fake.modTimeWindowMutex.Lock()
+	ret, specificReturn := fake.modTimeWindowReturnsOnCall[len(fake.modTimeWindowArgsForCall)]
+	fake.modTimeWindowArgsForCall = append(fake.modTimeWindowArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ModTimeWindowStub
+	fakeReturns := fake.modTimeWindowReturns
+	fake.recordInvocation("ModTimeWindow", []interface{}{arg1})
+	fake.modTimeWindowMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ModTimeWindowCallCount() int {
+	fake.modTimeWindowMutex.RLock()
+	defer fake.modTimeWindowMutex.RUnlock()
+	return len(fake.modTimeWindowArgsForCall)
+}
+
+func (fake *Model) ModTimeWindowCalls(stub func(string) time.Duration) {
+	fake.modTimeWindowMutex.Lock()
+	defer fake.modTimeWindowMutex.Unlock()
+	fake.ModTimeWindowStub = stub
+}
+
+func (fake *Model) ModTimeWindowArgsForCall(i int) string {
+	fake.modTimeWindowMutex.RLock()
+	defer fake.modTimeWindowMutex.RUnlock()
+	argsForCall := fake.modTimeWindowArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ModTimeWindowReturns(result1 time.Duration) {
+	fake.modTimeWindowMutex.Lock()
+	defer fake.modTimeWindowMutex.Unlock()
+	fake.ModTimeWindowStub = nil
+	fake.modTimeWindowReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *Model) ModTimeWindowReturnsOnCall(i int, result1 time.Duration) {
+	fake.modTimeWindowMutex.Lock()
+	defer fake.modTimeWindowMutex.Unlock()
+	fake.ModTimeWindowStub = nil
+	if fake.modTimeWindowReturnsOnCall == nil {
+		fake.modTimeWindowReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.modTimeWindowReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *Model) NeedFolderFiles(arg1 string, arg2 int, arg3 int) ([]db.FileInfoTruncated, []db.FileInfoTruncated, []db.FileInfoTruncated, error) {
 	fake.needFolderFilesMutex.Lock()
 	ret, specificReturn := fake.needFolderFilesReturnsOnCall[len(fake.needFolderFilesArgsForCall)]
@@ -2367,6 +3946,384 @@ func (fake *Model) PendingFoldersReturnsOnCall(i int, result1 map[string]db.Pend
 	}{result1, result2}
 }
 
+func (fake *Model) PullConcurrency(arg1 string) (model.PullConcurrency, error) {
+	fake.pullConcurrencyMutex.Lock()
+	ret, specificReturn := fake.pullConcurrencyReturnsOnCall[len(fake.pullConcurrencyArgsForCall)]
+	fake.pullConcurrencyArgsForCall = append(fake.pullConcurrencyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PullConcurrencyStub
+	fakeReturns := fake.pullConcurrencyReturns
+	fake.recordInvocation("PullConcurrency", []interface{}{arg1})
+	fake.pullConcurrencyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) PullConcurrencyCallCount() int {
+	fake.pullConcurrencyMutex.RLock()
+	defer fake.pullConcurrencyMutex.RUnlock()
+	return len(fake.pullConcurrencyArgsForCall)
+}
+
+func (fake *Model) PullConcurrencyCalls(stub func(string) (model.PullConcurrency, error)) {
+	fake.pullConcurrencyMutex.Lock()
+	defer fake.pullConcurrencyMutex.Unlock()
+	fake.PullConcurrencyStub = stub
+}
+
+func (fake *Model) PullConcurrencyArgsForCall(i int) string {
+	fake.pullConcurrencyMutex.RLock()
+	defer fake.pullConcurrencyMutex.RUnlock()
+	argsForCall := fake.pullConcurrencyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) PullConcurrencyReturns(result1 model.PullConcurrency, result2 error) {
+	fake.pullConcurrencyMutex.Lock()
+	defer fake.pullConcurrencyMutex.Unlock()
+	fake.PullConcurrencyStub = nil
+	fake.pullConcurrencyReturns = struct {
+		result1 model.PullConcurrency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PullConcurrencyReturnsOnCall(i int, result1 model.PullConcurrency, result2 error) {
+	fake.pullConcurrencyMutex.Lock()
+	defer fake.pullConcurrencyMutex.Unlock()
+	fake.PullConcurrencyStub = nil
+	if fake.pullConcurrencyReturnsOnCall == nil {
+		fake.pullConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 model.PullConcurrency
+			result2 error
+		})
+	}
+	fake.pullConcurrencyReturnsOnCall[i] = struct {
+		result1 model.PullConcurrency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PullPaused(arg1 string) bool {
+	fake.pullPausedMutex.Lock()
+	ret, specificReturn := fake.pullPausedReturnsOnCall[len(fake.pullPausedArgsForCall)]
+	fake.pullPausedArgsForCall = append(fake.pullPausedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PullPausedStub
+	fakeReturns := fake.pullPausedReturns
+	fake.recordInvocation("PullPaused", []interface{}{arg1})
+	fake.pullPausedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) PullPausedCallCount() int {
+	fake.pullPausedMutex.RLock()
+	defer fake.pullPausedMutex.RUnlock()
+	return len(fake.pullPausedArgsForCall)
+}
+
+func (fake *Model) PullPausedCalls(stub func(string) bool) {
+	fake.pullPausedMutex.Lock()
+	defer fake.pullPausedMutex.Unlock()
+	fake.PullPausedStub = stub
+}
+
+func (fake *Model) PullPausedArgsForCall(i int) string {
+	fake.pullPausedMutex.RLock()
+	defer fake.pullPausedMutex.RUnlock()
+	argsForCall := fake.pullPausedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) PullPausedReturns(result1 bool) {
+	fake.pullPausedMutex.Lock()
+	defer fake.pullPausedMutex.Unlock()
+	fake.PullPausedStub = nil
+	fake.pullPausedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Model) PullPausedReturnsOnCall(i int, result1 bool) {
+	fake.pullPausedMutex.Lock()
+	defer fake.pullPausedMutex.Unlock()
+	fake.PullPausedStub = nil
+	if fake.pullPausedReturnsOnCall == nil {
+		fake.pullPausedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pullPausedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Model) PullPlan(arg1 string) (model.PullPlan, error) {
+	fake.pullPlanMutex.Lock()
+	ret, specificReturn := fake.pullPlanReturnsOnCall[len(fake.pullPlanArgsForCall)]
+	fake.pullPlanArgsForCall = append(fake.pullPlanArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PullPlanStub
+	fakeReturns := fake.pullPlanReturns
+	fake.recordInvocation("PullPlan", []interface{}{arg1})
+	fake.pullPlanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) PullPlanCallCount() int {
+	fake.pullPlanMutex.RLock()
+	defer fake.pullPlanMutex.RUnlock()
+	return len(fake.pullPlanArgsForCall)
+}
+
+func (fake *Model) PullPlanCalls(stub func(string) (model.PullPlan, error)) {
+	fake.pullPlanMutex.Lock()
+	defer fake.pullPlanMutex.Unlock()
+	fake.PullPlanStub = stub
+}
+
+func (fake *Model) PullPlanArgsForCall(i int) string {
+	fake.pullPlanMutex.RLock()
+	defer fake.pullPlanMutex.RUnlock()
+	argsForCall := fake.pullPlanArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) PullPlanReturns(result1 model.PullPlan, result2 error) {
+	fake.pullPlanMutex.Lock()
+	defer fake.pullPlanMutex.Unlock()
+	fake.PullPlanStub = nil
+	fake.pullPlanReturns = struct {
+		result1 model.PullPlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PullPlanReturnsOnCall(i int, result1 model.PullPlan, result2 error) {
+	fake.pullPlanMutex.Lock()
+	defer fake.pullPlanMutex.Unlock()
+	fake.PullPlanStub = nil
+	if fake.pullPlanReturnsOnCall == nil {
+		fake.pullPlanReturnsOnCall = make(map[int]struct {
+			result1 model.PullPlan
+			result2 error
+		})
+	}
+	fake.pullPlanReturnsOnCall[i] = struct {
+		result1 model.PullPlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PullRetries(arg1 string) (model.PullRetries, error) {
+	fake.pullRetriesMutex.Lock()
+	ret, specificReturn := fake.pullRetriesReturnsOnCall[len(fake.pullRetriesArgsForCall)]
+	fake.pullRetriesArgsForCall = append(fake.pullRetriesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PullRetriesStub
+	fakeReturns := fake.pullRetriesReturns
+	fake.recordInvocation("PullRetries", []interface{}{arg1})
+	fake.pullRetriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) PullRetriesCallCount() int {
+	fake.pullRetriesMutex.RLock()
+	defer fake.pullRetriesMutex.RUnlock()
+	return len(fake.pullRetriesArgsForCall)
+}
+
+func (fake *Model) PullRetriesCalls(stub func(string) (model.PullRetries, error)) {
+	fake.pullRetriesMutex.Lock()
+	defer fake.pullRetriesMutex.Unlock()
+	fake.PullRetriesStub = stub
+}
+
+func (fake *Model) PullRetriesArgsForCall(i int) string {
+	fake.pullRetriesMutex.RLock()
+	defer fake.pullRetriesMutex.RUnlock()
+	argsForCall := fake.pullRetriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) PullRetriesReturns(result1 model.PullRetries, result2 error) {
+	fake.pullRetriesMutex.Lock()
+	defer fake.pullRetriesMutex.Unlock()
+	fake.PullRetriesStub = nil
+	fake.pullRetriesReturns = struct {
+		result1 model.PullRetries
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PullRetriesReturnsOnCall(i int, result1 model.PullRetries, result2 error) {
+	fake.pullRetriesMutex.Lock()
+	defer fake.pullRetriesMutex.Unlock()
+	fake.PullRetriesStub = nil
+	if fake.pullRetriesReturnsOnCall == nil {
+		fake.pullRetriesReturnsOnCall = make(map[int]struct {
+			result1 model.PullRetries
+			result2 error
+		})
+	}
+	fake.pullRetriesReturnsOnCall[i] = struct {
+		result1 model.PullRetries
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) RehashFolder(arg1 string) error {
+	fake.rehashFolderMutex.Lock()
+	ret, specificReturn := fake.rehashFolderReturnsOnCall[len(fake.rehashFolderArgsForCall)]
+	fake.rehashFolderArgsForCall = append(fake.rehashFolderArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RehashFolderStub
+	fakeReturns := fake.rehashFolderReturns
+	fake.recordInvocation("RehashFolder", []interface{}{arg1})
+	fake.rehashFolderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) RehashFolderCallCount() int {
+	fake.rehashFolderMutex.RLock()
+	defer fake.rehashFolderMutex.RUnlock()
+	return len(fake.rehashFolderArgsForCall)
+}
+
+func (fake *Model) RehashFolderCalls(stub func(string) error) {
+	fake.rehashFolderMutex.Lock()
+	defer fake.rehashFolderMutex.Unlock()
+	fake.RehashFolderStub = stub
+}
+
+func (fake *Model) RehashFolderArgsForCall(i int) string {
+	fake.rehashFolderMutex.RLock()
+	defer fake.rehashFolderMutex.RUnlock()
+	argsForCall := fake.rehashFolderArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) RehashFolderReturns(result1 error) {
+	fake.rehashFolderMutex.Lock()
+	defer fake.rehashFolderMutex.Unlock()
+	fake.RehashFolderStub = nil
+	fake.rehashFolderReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) RehashFolderReturnsOnCall(i int, result1 error) {
+	fake.rehashFolderMutex.Lock()
+	defer fake.rehashFolderMutex.Unlock()
+	fake.RehashFolderStub = nil
+	if fake.rehashFolderReturnsOnCall == nil {
+		fake.rehashFolderReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rehashFolderReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) RehashStatus(arg1 string) (model.RehashStatus, error) {
+	fake.rehashStatusMutex.Lock()
+	ret, specificReturn := fake.rehashStatusReturnsOnCall[len(fake.rehashStatusArgsForCall)]
+	fake.rehashStatusArgsForCall = append(fake.rehashStatusArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RehashStatusStub
+	fakeReturns := fake.rehashStatusReturns
+	fake.recordInvocation("RehashStatus", []interface{}{arg1})
+	fake.rehashStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) RehashStatusCallCount() int {
+	fake.rehashStatusMutex.RLock()
+	defer fake.rehashStatusMutex.RUnlock()
+	return len(fake.rehashStatusArgsForCall)
+}
+
+func (fake *Model) RehashStatusCalls(stub func(string) (model.RehashStatus, error)) {
+	fake.rehashStatusMutex.Lock()
+	defer fake.rehashStatusMutex.Unlock()
+	fake.RehashStatusStub = stub
+}
+
+func (fake *Model) RehashStatusArgsForCall(i int) string {
+	fake.rehashStatusMutex.RLock()
+	defer fake.rehashStatusMutex.RUnlock()
+	argsForCall := fake.rehashStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) RehashStatusReturns(result1 model.RehashStatus, result2 error) {
+	fake.rehashStatusMutex.Lock()
+	defer fake.rehashStatusMutex.Unlock()
+	fake.RehashStatusStub = nil
+	fake.rehashStatusReturns = struct {
+		result1 model.RehashStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) RehashStatusReturnsOnCall(i int, result1 model.RehashStatus, result2 error) {
+	fake.rehashStatusMutex.Lock()
+	defer fake.rehashStatusMutex.Unlock()
+	fake.RehashStatusStub = nil
+	if fake.rehashStatusReturnsOnCall == nil {
+		fake.rehashStatusReturnsOnCall = make(map[int]struct {
+			result1 model.RehashStatus
+			result2 error
+		})
+	}
+	fake.rehashStatusReturnsOnCall[i] = struct {
+		result1 model.RehashStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) RemoteNeedFolderFiles(arg1 string, arg2 protocol.DeviceID, arg3 int, arg4 int) ([]db.FileInfoTruncated, error) {
 	fake.remoteNeedFolderFilesMutex.Lock()
 	ret, specificReturn := fake.remoteNeedFolderFilesReturnsOnCall[len(fake.remoteNeedFolderFilesArgsForCall)]
@@ -2434,6 +4391,70 @@ func (fake *Model) RemoteNeedFolderFilesReturnsOnCall(i int, result1 []db.FileIn
 	}{result1, result2}
 }
 
+func (fake *Model) RepairMtimes(arg1 string) (int, error) {
+	fake.repairMtimesMutex.Lock()
+	ret, specificReturn := fake.repairMtimesReturnsOnCall[len(fake.repairMtimesArgsForCall)]
+	fake.repairMtimesArgsForCall = append(fake.repairMtimesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RepairMtimesStub
+	fakeReturns := fake.repairMtimesReturns
+	fake.recordInvocation("RepairMtimes", []interface{}{arg1})
+	fake.repairMtimesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) RepairMtimesCallCount() int {
+	fake.repairMtimesMutex.RLock()
+	defer fake.repairMtimesMutex.RUnlock()
+	return len(fake.repairMtimesArgsForCall)
+}
+
+func (fake *Model) RepairMtimesCalls(stub func(string) (int, error)) {
+	fake.repairMtimesMutex.Lock()
+	defer fake.repairMtimesMutex.Unlock()
+	fake.RepairMtimesStub = stub
+}
+
+func (fake *Model) RepairMtimesArgsForCall(i int) string {
+	fake.repairMtimesMutex.RLock()
+	defer fake.repairMtimesMutex.RUnlock()
+	argsForCall := fake.repairMtimesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) RepairMtimesReturns(result1 int, result2 error) {
+	fake.repairMtimesMutex.Lock()
+	defer fake.repairMtimesMutex.Unlock()
+	fake.RepairMtimesStub = nil
+	fake.repairMtimesReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) RepairMtimesReturnsOnCall(i int, result1 int, result2 error) {
+	fake.repairMtimesMutex.Lock()
+	defer fake.repairMtimesMutex.Unlock()
+	fake.RepairMtimesStub = nil
+	if fake.repairMtimesReturnsOnCall == nil {
+		fake.repairMtimesReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.repairMtimesReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) Request(arg1 protocol.DeviceID, arg2 string, arg3 string, arg4 int32, arg5 int32, arg6 int64, arg7 []byte, arg8 uint32, arg9 bool) (protocol.RequestResponse, error) {
 	var arg7Copy []byte
 	if arg7 != nil {
@@ -2543,6 +4564,68 @@ func (fake *Model) ResetFolderArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *Model) RestartWatch(arg1 context.Context, arg2 string) error {
+	fake.restartWatchMutex.Lock()
+	ret, specificReturn := fake.restartWatchReturnsOnCall[len(fake.restartWatchArgsForCall)]
+	fake.restartWatchArgsForCall = append(fake.restartWatchArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RestartWatchStub
+	fakeReturns := fake.restartWatchReturns
+	fake.recordInvocation("RestartWatch", []interface{}{arg1, arg2})
+	fake.restartWatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) RestartWatchCallCount() int {
+	fake.restartWatchMutex.RLock()
+	defer fake.restartWatchMutex.RUnlock()
+	return len(fake.restartWatchArgsForCall)
+}
+
+func (fake *Model) RestartWatchCalls(stub func(context.Context, string) error) {
+	fake.restartWatchMutex.Lock()
+	defer fake.restartWatchMutex.Unlock()
+	fake.RestartWatchStub = stub
+}
+
+func (fake *Model) RestartWatchArgsForCall(i int) (context.Context, string) {
+	fake.restartWatchMutex.RLock()
+	defer fake.restartWatchMutex.RUnlock()
+	argsForCall := fake.restartWatchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) RestartWatchReturns(result1 error) {
+	fake.restartWatchMutex.Lock()
+	defer fake.restartWatchMutex.Unlock()
+	fake.RestartWatchStub = nil
+	fake.restartWatchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) RestartWatchReturnsOnCall(i int, result1 error) {
+	fake.restartWatchMutex.Lock()
+	defer fake.restartWatchMutex.Unlock()
+	fake.RestartWatchStub = nil
+	if fake.restartWatchReturnsOnCall == nil {
+		fake.restartWatchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restartWatchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) RestoreFolderVersions(arg1 string, arg2 map[string]time.Time) (map[string]error, error) {
 	fake.restoreFolderVersionsMutex.Lock()
 	ret, specificReturn := fake.restoreFolderVersionsReturnsOnCall[len(fake.restoreFolderVersionsArgsForCall)]
@@ -2608,6 +4691,129 @@ func (fake *Model) RestoreFolderVersionsReturnsOnCall(i int, result1 map[string]
 	}{result1, result2}
 }
 
+func (fake *Model) ResumePulling(arg1 string) error {
+	fake.resumePullingMutex.Lock()
+	ret, specificReturn := fake.resumePullingReturnsOnCall[len(fake.resumePullingArgsForCall)]
+	fake.resumePullingArgsForCall = append(fake.resumePullingArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ResumePullingStub
+	fakeReturns := fake.resumePullingReturns
+	fake.recordInvocation("ResumePulling", []interface{}{arg1})
+	fake.resumePullingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ResumePullingCallCount() int {
+	fake.resumePullingMutex.RLock()
+	defer fake.resumePullingMutex.RUnlock()
+	return len(fake.resumePullingArgsForCall)
+}
+
+func (fake *Model) ResumePullingCalls(stub func(string) error) {
+	fake.resumePullingMutex.Lock()
+	defer fake.resumePullingMutex.Unlock()
+	fake.ResumePullingStub = stub
+}
+
+func (fake *Model) ResumePullingArgsForCall(i int) string {
+	fake.resumePullingMutex.RLock()
+	defer fake.resumePullingMutex.RUnlock()
+	argsForCall := fake.resumePullingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ResumePullingReturns(result1 error) {
+	fake.resumePullingMutex.Lock()
+	defer fake.resumePullingMutex.Unlock()
+	fake.ResumePullingStub = nil
+	fake.resumePullingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ResumePullingReturnsOnCall(i int, result1 error) {
+	fake.resumePullingMutex.Lock()
+	defer fake.resumePullingMutex.Unlock()
+	fake.ResumePullingStub = nil
+	if fake.resumePullingReturnsOnCall == nil {
+		fake.resumePullingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resumePullingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) RetryPullError(arg1 string, arg2 string) error {
+	fake.retryPullErrorMutex.Lock()
+	ret, specificReturn := fake.retryPullErrorReturnsOnCall[len(fake.retryPullErrorArgsForCall)]
+	fake.retryPullErrorArgsForCall = append(fake.retryPullErrorArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RetryPullErrorStub
+	fakeReturns := fake.retryPullErrorReturns
+	fake.recordInvocation("RetryPullError", []interface{}{arg1, arg2})
+	fake.retryPullErrorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) RetryPullErrorCallCount() int {
+	fake.retryPullErrorMutex.RLock()
+	defer fake.retryPullErrorMutex.RUnlock()
+	return len(fake.retryPullErrorArgsForCall)
+}
+
+func (fake *Model) RetryPullErrorCalls(stub func(string, string) error) {
+	fake.retryPullErrorMutex.Lock()
+	defer fake.retryPullErrorMutex.Unlock()
+	fake.RetryPullErrorStub = stub
+}
+
+func (fake *Model) RetryPullErrorArgsForCall(i int) (string, string) {
+	fake.retryPullErrorMutex.RLock()
+	defer fake.retryPullErrorMutex.RUnlock()
+	argsForCall := fake.retryPullErrorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) RetryPullErrorReturns(result1 error) {
+	fake.retryPullErrorMutex.Lock()
+	defer fake.retryPullErrorMutex.Unlock()
+	fake.RetryPullErrorStub = nil
+	fake.retryPullErrorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) RetryPullErrorReturnsOnCall(i int, result1 error) {
+	fake.retryPullErrorMutex.Lock()
+	defer fake.retryPullErrorMutex.Unlock()
+	fake.RetryPullErrorStub = nil
+	if fake.retryPullErrorReturnsOnCall == nil {
+		fake.retryPullErrorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.retryPullErrorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) Revert(arg1 string) {
 	fake.revertMutex.Lock()
 	fake.revertArgsForCall = append(fake.revertArgsForCall, struct {
@@ -2633,11 +4839,145 @@ func (fake *Model) RevertCalls(stub func(string)) {
 	fake.RevertStub = stub
 }
 
-func (fake *Model) RevertArgsForCall(i int) string {
-	fake.revertMutex.RLock()
-	defer fake.revertMutex.RUnlock()
-	argsForCall := fake.revertArgsForCall[i]
-	return argsForCall.arg1
+func (fake *Model) RevertArgsForCall(i int) string {
+	fake.revertMutex.RLock()
+	defer fake.revertMutex.RUnlock()
+	argsForCall := fake.revertArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ScanDelay(arg1 string) (string, time.Time) {
+	fake.scanDelayMutex.Lock()
+	ret, specificReturn := fake.scanDelayReturnsOnCall[len(fake.scanDelayArgsForCall)]
+	fake.scanDelayArgsForCall = append(fake.scanDelayArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ScanDelayStub
+	fakeReturns := fake.scanDelayReturns
+	fake.recordInvocation("ScanDelay", []interface{}{arg1})
+	fake.scanDelayMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ScanDelayCallCount() int {
+	fake.scanDelayMutex.RLock()
+	defer fake.scanDelayMutex.RUnlock()
+	return len(fake.scanDelayArgsForCall)
+}
+
+func (fake *Model) ScanDelayCalls(stub func(string) (string, time.Time)) {
+	fake.scanDelayMutex.Lock()
+	defer fake.scanDelayMutex.Unlock()
+	fake.ScanDelayStub = stub
+}
+
+func (fake *Model) ScanDelayArgsForCall(i int) string {
+	fake.scanDelayMutex.RLock()
+	defer fake.scanDelayMutex.RUnlock()
+	argsForCall := fake.scanDelayArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ScanDelayReturns(result1 string, result2 time.Time) {
+	fake.scanDelayMutex.Lock()
+	defer fake.scanDelayMutex.Unlock()
+	fake.ScanDelayStub = nil
+	fake.scanDelayReturns = struct {
+		result1 string
+		result2 time.Time
+	}{result1, result2}
+}
+
+func (fake *Model) ScanDelayReturnsOnCall(i int, result1 string, result2 time.Time) {
+	fake.scanDelayMutex.Lock()
+	defer fake.scanDelayMutex.Unlock()
+	fake.ScanDelayStub = nil
+	if fake.scanDelayReturnsOnCall == nil {
+		fake.scanDelayReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 time.Time
+		})
+	}
+	fake.scanDelayReturnsOnCall[i] = struct {
+		result1 string
+		result2 time.Time
+	}{result1, result2}
+}
+
+func (fake *Model) ScanDryRun(arg1 string, arg2 []string) ([]protocol.FileInfo, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.scanDryRunMutex.Lock()
+	ret, specificReturn := fake.scanDryRunReturnsOnCall[len(fake.scanDryRunArgsForCall)]
+	fake.scanDryRunArgsForCall = append(fake.scanDryRunArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.ScanDryRunStub
+	fakeReturns := fake.scanDryRunReturns
+	fake.recordInvocation("ScanDryRun", []interface{}{arg1, arg2Copy})
+	fake.scanDryRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ScanDryRunCallCount() int {
+	fake.scanDryRunMutex.RLock()
+	defer fake.scanDryRunMutex.RUnlock()
+	return len(fake.scanDryRunArgsForCall)
+}
+
+func (fake *Model) ScanDryRunCalls(stub func(string, []string) ([]protocol.FileInfo, error)) {
+	fake.scanDryRunMutex.Lock()
+	defer fake.scanDryRunMutex.Unlock()
+	fake.ScanDryRunStub = stub
+}
+
+func (fake *Model) ScanDryRunArgsForCall(i int) (string, []string) {
+	fake.scanDryRunMutex.RLock()
+	defer fake.scanDryRunMutex.RUnlock()
+	argsForCall := fake.scanDryRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) ScanDryRunReturns(result1 []protocol.FileInfo, result2 error) {
+	fake.scanDryRunMutex.Lock()
+	defer fake.scanDryRunMutex.Unlock()
+	fake.ScanDryRunStub = nil
+	fake.scanDryRunReturns = struct {
+		result1 []protocol.FileInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ScanDryRunReturnsOnCall(i int, result1 []protocol.FileInfo, result2 error) {
+	fake.scanDryRunMutex.Lock()
+	defer fake.scanDryRunMutex.Unlock()
+	fake.ScanDryRunStub = nil
+	if fake.scanDryRunReturnsOnCall == nil {
+		fake.scanDryRunReturnsOnCall = make(map[int]struct {
+			result1 []protocol.FileInfo
+			result2 error
+		})
+	}
+	fake.scanDryRunReturnsOnCall[i] = struct {
+		result1 []protocol.FileInfo
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *Model) ScanFolder(arg1 string) error {
@@ -2768,6 +5108,74 @@ func (fake *Model) ScanFolderSubdirsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) ScanFolderSubdirsWithHashers(arg1 string, arg2 []string, arg3 int) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.scanFolderSubdirsWithHashersMutex.Lock()
+	ret, specificReturn := fake.scanFolderSubdirsWithHashersReturnsOnCall[len(fake.scanFolderSubdirsWithHashersArgsForCall)]
+	fake.scanFolderSubdirsWithHashersArgsForCall = append(fake.scanFolderSubdirsWithHashersArgsForCall, struct {
+		arg1 string
+		arg2 []string
+		arg3 int
+	}{arg1, arg2Copy, arg3})
+	stub := fake.ScanFolderSubdirsWithHashersStub
+	fakeReturns := fake.scanFolderSubdirsWithHashersReturns
+	fake.recordInvocation("ScanFolderSubdirsWithHashers", []interface{}{arg1, arg2Copy, arg3})
+	fake.scanFolderSubdirsWithHashersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ScanFolderSubdirsWithHashersCallCount() int {
+	fake.scanFolderSubdirsWithHashersMutex.RLock()
+	defer fake.scanFolderSubdirsWithHashersMutex.RUnlock()
+	return len(fake.scanFolderSubdirsWithHashersArgsForCall)
+}
+
+func (fake *Model) ScanFolderSubdirsWithHashersCalls(stub func(string, []string, int) error) {
+	fake.scanFolderSubdirsWithHashersMutex.Lock()
+	defer fake.scanFolderSubdirsWithHashersMutex.Unlock()
+	fake.ScanFolderSubdirsWithHashersStub = stub
+}
+
+func (fake *Model) ScanFolderSubdirsWithHashersArgsForCall(i int) (string, []string, int) {
+	fake.scanFolderSubdirsWithHashersMutex.RLock()
+	defer fake.scanFolderSubdirsWithHashersMutex.RUnlock()
+	argsForCall := fake.scanFolderSubdirsWithHashersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) ScanFolderSubdirsWithHashersReturns(result1 error) {
+	fake.scanFolderSubdirsWithHashersMutex.Lock()
+	defer fake.scanFolderSubdirsWithHashersMutex.Unlock()
+	fake.ScanFolderSubdirsWithHashersStub = nil
+	fake.scanFolderSubdirsWithHashersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ScanFolderSubdirsWithHashersReturnsOnCall(i int, result1 error) {
+	fake.scanFolderSubdirsWithHashersMutex.Lock()
+	defer fake.scanFolderSubdirsWithHashersMutex.Unlock()
+	fake.ScanFolderSubdirsWithHashersStub = nil
+	if fake.scanFolderSubdirsWithHashersReturnsOnCall == nil {
+		fake.scanFolderSubdirsWithHashersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scanFolderSubdirsWithHashersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) ScanFolders() map[string]error {
 	fake.scanFoldersMutex.Lock()
 	ret, specificReturn := fake.scanFoldersReturnsOnCall[len(fake.scanFoldersArgsForCall)]
@@ -2821,6 +5229,73 @@ func (fake *Model) ScanFoldersReturnsOnCall(i int, result1 map[string]error) {
 	}{result1}
 }
 
+func (fake *Model) ScanProgress(arg1 string) (int64, int64, float64) {
+	fake.scanProgressMutex.Lock()
+	ret, specificReturn := fake.scanProgressReturnsOnCall[len(fake.scanProgressArgsForCall)]
+	fake.scanProgressArgsForCall = append(fake.scanProgressArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ScanProgressStub
+	fakeReturns := fake.scanProgressReturns
+	fake.recordInvocation("ScanProgress", []interface{}{arg1})
+	fake.scanProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *Model) ScanProgressCallCount() int {
+	fake.scanProgressMutex.RLock()
+	defer fake.scanProgressMutex.RUnlock()
+	return len(fake.scanProgressArgsForCall)
+}
+
+func (fake *Model) ScanProgressCalls(stub func(string) (int64, int64, float64)) {
+	fake.scanProgressMutex.Lock()
+	defer fake.scanProgressMutex.Unlock()
+	fake.ScanProgressStub = stub
+}
+
+func (fake *Model) ScanProgressArgsForCall(i int) string {
+	fake.scanProgressMutex.RLock()
+	defer fake.scanProgressMutex.RUnlock()
+	argsForCall := fake.scanProgressArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ScanProgressReturns(result1 int64, result2 int64, result3 float64) {
+	fake.scanProgressMutex.Lock()
+	defer fake.scanProgressMutex.Unlock()
+	fake.ScanProgressStub = nil
+	fake.scanProgressReturns = struct {
+		result1 int64
+		result2 int64
+		result3 float64
+	}{result1, result2, result3}
+}
+
+func (fake *Model) ScanProgressReturnsOnCall(i int, result1 int64, result2 int64, result3 float64) {
+	fake.scanProgressMutex.Lock()
+	defer fake.scanProgressMutex.Unlock()
+	fake.ScanProgressStub = nil
+	if fake.scanProgressReturnsOnCall == nil {
+		fake.scanProgressReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 int64
+			result3 float64
+		})
+	}
+	fake.scanProgressReturnsOnCall[i] = struct {
+		result1 int64
+		result2 int64
+		result3 float64
+	}{result1, result2, result3}
+}
+
 func (fake *Model) Serve(arg1 context.Context) error {
 	fake.serveMutex.Lock()
 	ret, specificReturn := fake.serveReturnsOnCall[len(fake.serveArgsForCall)]
@@ -2949,6 +5424,196 @@ func (fake *Model) SetIgnoresReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) SetPullPaused(arg1 string, arg2 bool) error {
+	fake.setPullPausedMutex.Lock()
+	ret, specificReturn := fake.setPullPausedReturnsOnCall[len(fake.setPullPausedArgsForCall)]
+	fake.setPullPausedArgsForCall = append(fake.setPullPausedArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.SetPullPausedStub
+	fakeReturns := fake.setPullPausedReturns
+	fake.recordInvocation("SetPullPaused", []interface{}{arg1, arg2})
+	fake.setPullPausedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) SetPullPausedCallCount() int {
+	fake.setPullPausedMutex.RLock()
+	defer fake.setPullPausedMutex.RUnlock()
+	return len(fake.setPullPausedArgsForCall)
+}
+
+func (fake *Model) SetPullPausedCalls(stub func(string, bool) error) {
+	fake.setPullPausedMutex.Lock()
+	defer fake.setPullPausedMutex.Unlock()
+	fake.SetPullPausedStub = stub
+}
+
+func (fake *Model) SetPullPausedArgsForCall(i int) (string, bool) {
+	fake.setPullPausedMutex.RLock()
+	defer fake.setPullPausedMutex.RUnlock()
+	argsForCall := fake.setPullPausedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) SetPullPausedReturns(result1 error) {
+	fake.setPullPausedMutex.Lock()
+	defer fake.setPullPausedMutex.Unlock()
+	fake.SetPullPausedStub = nil
+	fake.setPullPausedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) SetPullPausedReturnsOnCall(i int, result1 error) {
+	fake.setPullPausedMutex.Lock()
+	defer fake.setPullPausedMutex.Unlock()
+	fake.SetPullPausedStub = nil
+	if fake.setPullPausedReturnsOnCall == nil {
+		fake.setPullPausedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setPullPausedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) SizeDistribution(arg1 string) (model.SizeDistribution, error) {
+	fake.sizeDistributionMutex.Lock()
+	ret, specificReturn := fake.sizeDistributionReturnsOnCall[len(fake.sizeDistributionArgsForCall)]
+	fake.sizeDistributionArgsForCall = append(fake.sizeDistributionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SizeDistributionStub
+	fakeReturns := fake.sizeDistributionReturns
+	fake.recordInvocation("SizeDistribution", []interface{}{arg1})
+	fake.sizeDistributionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) SizeDistributionCallCount() int {
+	fake.sizeDistributionMutex.RLock()
+	defer fake.sizeDistributionMutex.RUnlock()
+	return len(fake.sizeDistributionArgsForCall)
+}
+
+func (fake *Model) SizeDistributionCalls(stub func(string) (model.SizeDistribution, error)) {
+	fake.sizeDistributionMutex.Lock()
+	defer fake.sizeDistributionMutex.Unlock()
+	fake.SizeDistributionStub = stub
+}
+
+func (fake *Model) SizeDistributionArgsForCall(i int) string {
+	fake.sizeDistributionMutex.RLock()
+	defer fake.sizeDistributionMutex.RUnlock()
+	argsForCall := fake.sizeDistributionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) SizeDistributionReturns(result1 model.SizeDistribution, result2 error) {
+	fake.sizeDistributionMutex.Lock()
+	defer fake.sizeDistributionMutex.Unlock()
+	fake.SizeDistributionStub = nil
+	fake.sizeDistributionReturns = struct {
+		result1 model.SizeDistribution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) SizeDistributionReturnsOnCall(i int, result1 model.SizeDistribution, result2 error) {
+	fake.sizeDistributionMutex.Lock()
+	defer fake.sizeDistributionMutex.Unlock()
+	fake.SizeDistributionStub = nil
+	if fake.sizeDistributionReturnsOnCall == nil {
+		fake.sizeDistributionReturnsOnCall = make(map[int]struct {
+			result1 model.SizeDistribution
+			result2 error
+		})
+	}
+	fake.sizeDistributionReturnsOnCall[i] = struct {
+		result1 model.SizeDistribution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) SkippedSymlinks(arg1 string) ([]string, error) {
+	fake.skippedSymlinksMutex.Lock()
+	ret, specificReturn := fake.skippedSymlinksReturnsOnCall[len(fake.skippedSymlinksArgsForCall)]
+	fake.skippedSymlinksArgsForCall = append(fake.skippedSymlinksArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SkippedSymlinksStub
+	fakeReturns := fake.skippedSymlinksReturns
+	fake.recordInvocation("SkippedSymlinks", []interface{}{arg1})
+	fake.skippedSymlinksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) SkippedSymlinksCallCount() int {
+	fake.skippedSymlinksMutex.RLock()
+	defer fake.skippedSymlinksMutex.RUnlock()
+	return len(fake.skippedSymlinksArgsForCall)
+}
+
+func (fake *Model) SkippedSymlinksCalls(stub func(string) ([]string, error)) {
+	fake.skippedSymlinksMutex.Lock()
+	defer fake.skippedSymlinksMutex.Unlock()
+	fake.SkippedSymlinksStub = stub
+}
+
+func (fake *Model) SkippedSymlinksArgsForCall(i int) string {
+	fake.skippedSymlinksMutex.RLock()
+	defer fake.skippedSymlinksMutex.RUnlock()
+	argsForCall := fake.skippedSymlinksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) SkippedSymlinksReturns(result1 []string, result2 error) {
+	fake.skippedSymlinksMutex.Lock()
+	defer fake.skippedSymlinksMutex.Unlock()
+	fake.SkippedSymlinksStub = nil
+	fake.skippedSymlinksReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) SkippedSymlinksReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.skippedSymlinksMutex.Lock()
+	defer fake.skippedSymlinksMutex.Unlock()
+	fake.SkippedSymlinksStub = nil
+	if fake.skippedSymlinksReturnsOnCall == nil {
+		fake.skippedSymlinksReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.skippedSymlinksReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) StartDeadlockDetector(arg1 time.Duration) {
 	fake.startDeadlockDetectorMutex.Lock()
 	fake.startDeadlockDetectorArgsForCall = append(fake.startDeadlockDetectorArgsForCall, struct {
@@ -3048,6 +5713,134 @@ func (fake *Model) StateReturnsOnCall(i int, result1 string, result2 time.Time,
 	}{result1, result2, result3}
 }
 
+func (fake *Model) TempFiles(arg1 string) ([]model.TempFile, error) {
+	fake.tempFilesMutex.Lock()
+	ret, specificReturn := fake.tempFilesReturnsOnCall[len(fake.tempFilesArgsForCall)]
+	fake.tempFilesArgsForCall = append(fake.tempFilesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.TempFilesStub
+	fakeReturns := fake.tempFilesReturns
+	fake.recordInvocation("TempFiles", []interface{}{arg1})
+	fake.tempFilesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) TempFilesCallCount() int {
+	fake.tempFilesMutex.RLock()
+	defer fake.tempFilesMutex.RUnlock()
+	return len(fake.tempFilesArgsForCall)
+}
+
+func (fake *Model) TempFilesCalls(stub func(string) ([]model.TempFile, error)) {
+	fake.tempFilesMutex.Lock()
+	defer fake.tempFilesMutex.Unlock()
+	fake.TempFilesStub = stub
+}
+
+func (fake *Model) TempFilesArgsForCall(i int) string {
+	fake.tempFilesMutex.RLock()
+	defer fake.tempFilesMutex.RUnlock()
+	argsForCall := fake.tempFilesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) TempFilesReturns(result1 []model.TempFile, result2 error) {
+	fake.tempFilesMutex.Lock()
+	defer fake.tempFilesMutex.Unlock()
+	fake.TempFilesStub = nil
+	fake.tempFilesReturns = struct {
+		result1 []model.TempFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) TempFilesReturnsOnCall(i int, result1 []model.TempFile, result2 error) {
+	fake.tempFilesMutex.Lock()
+	defer fake.tempFilesMutex.Unlock()
+	fake.TempFilesStub = nil
+	if fake.tempFilesReturnsOnCall == nil {
+		fake.tempFilesReturnsOnCall = make(map[int]struct {
+			result1 []model.TempFile
+			result2 error
+		})
+	}
+	fake.tempFilesReturnsOnCall[i] = struct {
+		result1 []model.TempFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) TransferTotals(arg1 string) (stats.TransferTotals, error) {
+	fake.transferTotalsMutex.Lock()
+	ret, specificReturn := fake.transferTotalsReturnsOnCall[len(fake.transferTotalsArgsForCall)]
+	fake.transferTotalsArgsForCall = append(fake.transferTotalsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.TransferTotalsStub
+	fakeReturns := fake.transferTotalsReturns
+	fake.recordInvocation("TransferTotals", []interface{}{arg1})
+	fake.transferTotalsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) TransferTotalsCallCount() int {
+	fake.transferTotalsMutex.RLock()
+	defer fake.transferTotalsMutex.RUnlock()
+	return len(fake.transferTotalsArgsForCall)
+}
+
+func (fake *Model) TransferTotalsCalls(stub func(string) (stats.TransferTotals, error)) {
+	fake.transferTotalsMutex.Lock()
+	defer fake.transferTotalsMutex.Unlock()
+	fake.TransferTotalsStub = stub
+}
+
+func (fake *Model) TransferTotalsArgsForCall(i int) string {
+	fake.transferTotalsMutex.RLock()
+	defer fake.transferTotalsMutex.RUnlock()
+	argsForCall := fake.transferTotalsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) TransferTotalsReturns(result1 stats.TransferTotals, result2 error) {
+	fake.transferTotalsMutex.Lock()
+	defer fake.transferTotalsMutex.Unlock()
+	fake.TransferTotalsStub = nil
+	fake.transferTotalsReturns = struct {
+		result1 stats.TransferTotals
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) TransferTotalsReturnsOnCall(i int, result1 stats.TransferTotals, result2 error) {
+	fake.transferTotalsMutex.Lock()
+	defer fake.transferTotalsMutex.Unlock()
+	fake.TransferTotalsStub = nil
+	if fake.transferTotalsReturnsOnCall == nil {
+		fake.transferTotalsReturnsOnCall = make(map[int]struct {
+			result1 stats.TransferTotals
+			result2 error
+		})
+	}
+	fake.transferTotalsReturnsOnCall[i] = struct {
+		result1 stats.TransferTotals
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) UsageReportingStats(arg1 *contract.Report, arg2 int, arg3 bool) {
 	fake.usageReportingStatsMutex.Lock()
 	fake.usageReportingStatsArgsForCall = append(fake.usageReportingStatsArgsForCall, struct {
@@ -3143,19 +5936,98 @@ func (fake *Model) WatchErrorReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) WatchStatus(arg1 string) (error, time.Time, int) {
+	fake.watchStatusMutex.Lock()
+	ret, specificReturn := fake.watchStatusReturnsOnCall[len(fake.watchStatusArgsForCall)]
+	fake.watchStatusArgsForCall = append(fake.watchStatusArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.WatchStatusStub
+	fakeReturns := fake.watchStatusReturns
+	fake.recordInvocation("WatchStatus", []interface{}{arg1})
+	fake.watchStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *Model) WatchStatusCallCount() int {
+	fake.watchStatusMutex.RLock()
+	defer fake.watchStatusMutex.RUnlock()
+	return len(fake.watchStatusArgsForCall)
+}
+
+func (fake *Model) WatchStatusCalls(stub func(string) (error, time.Time, int)) {
+	fake.watchStatusMutex.Lock()
+	defer fake.watchStatusMutex.Unlock()
+	fake.WatchStatusStub = stub
+}
+
+func (fake *Model) WatchStatusArgsForCall(i int) string {
+	fake.watchStatusMutex.RLock()
+	defer fake.watchStatusMutex.RUnlock()
+	argsForCall := fake.watchStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) WatchStatusReturns(result1 error, result2 time.Time, result3 int) {
+	fake.watchStatusMutex.Lock()
+	defer fake.watchStatusMutex.Unlock()
+	fake.WatchStatusStub = nil
+	fake.watchStatusReturns = struct {
+		result1 error
+		result2 time.Time
+		result3 int
+	}{result1, result2, result3}
+}
+
+func (fake *Model) WatchStatusReturnsOnCall(i int, result1 error, result2 time.Time, result3 int) {
+	fake.watchStatusMutex.Lock()
+	defer fake.watchStatusMutex.Unlock()
+	fake.WatchStatusStub = nil
+	if fake.watchStatusReturnsOnCall == nil {
+		fake.watchStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+			result2 time.Time
+			result3 int
+		})
+	}
+	fake.watchStatusReturnsOnCall[i] = struct {
+		result1 error
+		result2 time.Time
+		result3 int
+	}{result1, result2, result3}
+}
+
 func (fake *Model) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acknowledgeEmptyPathMutex.RLock()
+	defer fake.acknowledgeEmptyPathMutex.RUnlock()
 	fake.addConnectionMutex.RLock()
 	defer fake.addConnectionMutex.RUnlock()
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
 	fake.availabilityMutex.RLock()
 	defer fake.availabilityMutex.RUnlock()
 	fake.bringToFrontMutex.RLock()
 	defer fake.bringToFrontMutex.RUnlock()
+	fake.cancelRehashMutex.RLock()
+	defer fake.cancelRehashMutex.RUnlock()
+	fake.chronicConflictsMutex.RLock()
+	defer fake.chronicConflictsMutex.RUnlock()
+	fake.clearPullErrorMutex.RLock()
+	defer fake.clearPullErrorMutex.RUnlock()
 	fake.closedMutex.RLock()
 	defer fake.closedMutex.RUnlock()
 	fake.clusterConfigMutex.RLock()
 	defer fake.clusterConfigMutex.RUnlock()
+	fake.compareFoldersMutex.RLock()
+	defer fake.compareFoldersMutex.RUnlock()
 	fake.completionMutex.RLock()
 	defer fake.completionMutex.RUnlock()
 	fake.connectionMutex.RLock()
@@ -3172,30 +6044,54 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.dBSnapshotMutex.RUnlock()
 	fake.delayScanMutex.RLock()
 	defer fake.delayScanMutex.RUnlock()
+	fake.delayScanWithReasonMutex.RLock()
+	defer fake.delayScanWithReasonMutex.RUnlock()
 	fake.deviceStatisticsMutex.RLock()
 	defer fake.deviceStatisticsMutex.RUnlock()
 	fake.downloadProgressMutex.RLock()
 	defer fake.downloadProgressMutex.RUnlock()
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	fake.exportLocalIndexMutex.RLock()
+	defer fake.exportLocalIndexMutex.RUnlock()
+	fake.filesModifiedByMutex.RLock()
+	defer fake.filesModifiedByMutex.RUnlock()
 	fake.folderErrorsMutex.RLock()
 	defer fake.folderErrorsMutex.RUnlock()
+	fake.folderHealthMutex.RLock()
+	defer fake.folderHealthMutex.RUnlock()
 	fake.folderProgressBytesCompletedMutex.RLock()
 	defer fake.folderProgressBytesCompletedMutex.RUnlock()
 	fake.folderStatisticsMutex.RLock()
 	defer fake.folderStatisticsMutex.RUnlock()
+	fake.forceScanFolderSubdirsMutex.RLock()
+	defer fake.forceScanFolderSubdirsMutex.RUnlock()
 	fake.getFolderVersionsMutex.RLock()
 	defer fake.getFolderVersionsMutex.RUnlock()
 	fake.getHelloMutex.RLock()
 	defer fake.getHelloMutex.RUnlock()
 	fake.globalDirectoryTreeMutex.RLock()
 	defer fake.globalDirectoryTreeMutex.RUnlock()
+	fake.ignoreAndRemoveLocallyMutex.RLock()
+	defer fake.ignoreAndRemoveLocallyMutex.RUnlock()
+	fake.importLocalIndexMutex.RLock()
+	defer fake.importLocalIndexMutex.RUnlock()
 	fake.indexMutex.RLock()
 	defer fake.indexMutex.RUnlock()
+	fake.indexExchangeStatusMutex.RLock()
+	defer fake.indexExchangeStatusMutex.RUnlock()
 	fake.indexUpdateMutex.RLock()
 	defer fake.indexUpdateMutex.RUnlock()
+	fake.isPathIgnoredMutex.RLock()
+	defer fake.isPathIgnoredMutex.RUnlock()
 	fake.loadIgnoresMutex.RLock()
 	defer fake.loadIgnoresMutex.RUnlock()
 	fake.localChangedFolderFilesMutex.RLock()
 	defer fake.localChangedFolderFilesMutex.RUnlock()
+	fake.localChangesSinceMutex.RLock()
+	defer fake.localChangesSinceMutex.RUnlock()
+	fake.modTimeWindowMutex.RLock()
+	defer fake.modTimeWindowMutex.RUnlock()
 	fake.needFolderFilesMutex.RLock()
 	defer fake.needFolderFilesMutex.RUnlock()
 	fake.numConnectionsMutex.RLock()
@@ -3208,34 +6104,74 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.pendingDevicesMutex.RUnlock()
 	fake.pendingFoldersMutex.RLock()
 	defer fake.pendingFoldersMutex.RUnlock()
+	fake.pullConcurrencyMutex.RLock()
+	defer fake.pullConcurrencyMutex.RUnlock()
+	fake.pullPausedMutex.RLock()
+	defer fake.pullPausedMutex.RUnlock()
+	fake.pullPlanMutex.RLock()
+	defer fake.pullPlanMutex.RUnlock()
+	fake.pullRetriesMutex.RLock()
+	defer fake.pullRetriesMutex.RUnlock()
+	fake.rehashFolderMutex.RLock()
+	defer fake.rehashFolderMutex.RUnlock()
+	fake.rehashStatusMutex.RLock()
+	defer fake.rehashStatusMutex.RUnlock()
 	fake.remoteNeedFolderFilesMutex.RLock()
 	defer fake.remoteNeedFolderFilesMutex.RUnlock()
+	fake.repairMtimesMutex.RLock()
+	defer fake.repairMtimesMutex.RUnlock()
 	fake.requestMutex.RLock()
 	defer fake.requestMutex.RUnlock()
 	fake.resetFolderMutex.RLock()
 	defer fake.resetFolderMutex.RUnlock()
+	fake.restartWatchMutex.RLock()
+	defer fake.restartWatchMutex.RUnlock()
 	fake.restoreFolderVersionsMutex.RLock()
 	defer fake.restoreFolderVersionsMutex.RUnlock()
+	fake.resumePullingMutex.RLock()
+	defer fake.resumePullingMutex.RUnlock()
+	fake.retryPullErrorMutex.RLock()
+	defer fake.retryPullErrorMutex.RUnlock()
 	fake.revertMutex.RLock()
 	defer fake.revertMutex.RUnlock()
+	fake.scanDelayMutex.RLock()
+	defer fake.scanDelayMutex.RUnlock()
+	fake.scanDryRunMutex.RLock()
+	defer fake.scanDryRunMutex.RUnlock()
 	fake.scanFolderMutex.RLock()
 	defer fake.scanFolderMutex.RUnlock()
 	fake.scanFolderSubdirsMutex.RLock()
 	defer fake.scanFolderSubdirsMutex.RUnlock()
+	fake.scanFolderSubdirsWithHashersMutex.RLock()
+	defer fake.scanFolderSubdirsWithHashersMutex.RUnlock()
 	fake.scanFoldersMutex.RLock()
 	defer fake.scanFoldersMutex.RUnlock()
+	fake.scanProgressMutex.RLock()
+	defer fake.scanProgressMutex.RUnlock()
 	fake.serveMutex.RLock()
 	defer fake.serveMutex.RUnlock()
 	fake.setIgnoresMutex.RLock()
 	defer fake.setIgnoresMutex.RUnlock()
+	fake.setPullPausedMutex.RLock()
+	defer fake.setPullPausedMutex.RUnlock()
+	fake.sizeDistributionMutex.RLock()
+	defer fake.sizeDistributionMutex.RUnlock()
+	fake.skippedSymlinksMutex.RLock()
+	defer fake.skippedSymlinksMutex.RUnlock()
 	fake.startDeadlockDetectorMutex.RLock()
 	defer fake.startDeadlockDetectorMutex.RUnlock()
 	fake.stateMutex.RLock()
 	defer fake.stateMutex.RUnlock()
+	fake.tempFilesMutex.RLock()
+	defer fake.tempFilesMutex.RUnlock()
+	fake.transferTotalsMutex.RLock()
+	defer fake.transferTotalsMutex.RUnlock()
 	fake.usageReportingStatsMutex.RLock()
 	defer fake.usageReportingStatsMutex.RUnlock()
 	fake.watchErrorMutex.RLock()
 	defer fake.watchErrorMutex.RUnlock()
+	fake.watchStatusMutex.RLock()
+	defer fake.watchStatusMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
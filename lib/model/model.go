@@ -53,13 +53,47 @@ type service interface {
 	Override()
 	Revert()
 	DelayScan(d time.Duration)
+	DelayScanWithReason(d time.Duration, reason string)
+	ScanDelay() (string, time.Time)
+	ModTimeWindow() time.Duration
+	IsPathIgnored(path string) (bool, string, error)
 	SchedulePull()                                    // something relevant changed, we should try a pull
 	Jobs(page, perpage int) ([]string, []string, int) // In progress, Queued, skipped
 	Scan(subs []string) error
+	ForceScan(subs []string) error
+	ScanWithHashers(subs []string, hashers int) error
+	ScanDryRun(subs []string) ([]protocol.FileInfo, error)
 	Errors() []FileError
+	RetryPullError(path string) error
+	ClearPullError(path string)
 	WatchError() error
+	WatchStatus() (err error, nextRetry time.Time, failures int)
+	RestartWatchNow(ctx context.Context) error
+	ScanProgress() (current, total int64, rate float64)
 	ScheduleForceRescan(path string)
+	ScheduleVerifyBeforePull(names []string)
+	OnInitialScanComplete(fn func())
 	GetStatistics() (stats.FolderStatistics, error)
+	GetTransferTotals() (stats.TransferTotals, error)
+	RepairMtimes() (int, error)
+	ChronicConflicts() ([]ChronicConflict, error)
+	AuditEntries(q AuditQuery) ([]AuditEntry, error)
+	PullPlan() (PullPlan, error)
+	TempFiles() ([]TempFile, error)
+	RemoveIgnoredLocally(paths []string) (LocalRemoval, error)
+	SkippedSymlinks() []string
+	AcknowledgeEmptyPath() error
+	SetPullPaused(paused bool)
+	PullPaused() bool
+	PullConcurrency() PullConcurrency
+	PullBackoff() int
+	Rehash() error
+	CancelRehash() error
+	RehashStatus() RehashStatus
+	ImportLocalIndex(r io.ReadSeeker, spotCheck int) (int, error)
+	PullRetries() PullRetries
+	ResumePulling() error
+	Drain() <-chan struct{}
 
 	getState() (folderState, time.Time, error)
 }
@@ -76,18 +110,52 @@ type Model interface {
 
 	ResetFolder(folder string)
 	DelayScan(folder string, next time.Duration)
+	DelayScanWithReason(folder string, next time.Duration, reason string)
+	ScanDelay(folder string) (string, time.Time)
+	ModTimeWindow(folder string) time.Duration
 	ScanFolder(folder string) error
 	ScanFolders() map[string]error
 	ScanFolderSubdirs(folder string, subs []string) error
+	ForceScanFolderSubdirs(folder string, subs []string) error
+	ScanFolderSubdirsWithHashers(folder string, subs []string, hashers int) error
+	ScanDryRun(folder string, subs []string) ([]protocol.FileInfo, error)
 	State(folder string) (string, time.Time, error)
 	FolderErrors(folder string) ([]FileError, error)
+	RetryPullError(folder, path string) error
+	ClearPullError(folder, path string) error
+	SkippedSymlinks(folder string) ([]string, error)
+	IndexExchangeStatus(folder string) (map[protocol.DeviceID]IndexExchangeStatus, error)
 	WatchError(folder string) error
+	WatchStatus(folder string) (err error, nextRetry time.Time, failures int)
+	RestartWatch(ctx context.Context, folder string) error
+	ScanProgress(folder string) (current, total int64, rate float64)
 	Override(folder string)
 	Revert(folder string)
+	RepairMtimes(folder string) (int, error)
+	AcknowledgeEmptyPath(folder string) error
+	SetPullPaused(folder string, paused bool) error
+	PullPaused(folder string) bool
+	TransferTotals(folder string) (stats.TransferTotals, error)
+	PullConcurrency(folder string) (PullConcurrency, error)
+	FolderHealth(folder string) (FolderHealth, error)
+	RehashFolder(folder string) error
+	CancelRehash(folder string) error
+	RehashStatus(folder string) (RehashStatus, error)
+	ExportLocalIndex(folder string, w io.Writer) error
+	ImportLocalIndex(folder string, r io.ReadSeeker, spotCheck int) (int, error)
+	PullRetries(folder string) (PullRetries, error)
+	ResumePulling(folder string) error
+	ChronicConflicts(folder string) ([]ChronicConflict, error)
+	AuditEntries(folder string, q AuditQuery) ([]AuditEntry, error)
+	PullPlan(folder string) (PullPlan, error)
+	TempFiles(folder string) ([]TempFile, error)
+	SizeDistribution(folder string) (SizeDistribution, error)
 	BringToFront(folder, file string)
 	LoadIgnores(folder string) ([]string, []string, error)
 	CurrentIgnores(folder string) ([]string, []string, error)
+	IsPathIgnored(folder, path string) (bool, string, error)
 	SetIgnores(folder string, content []string) error
+	IgnoreAndRemoveLocally(folder string, paths []string) (LocalRemoval, error)
 
 	GetFolderVersions(folder string) (map[string][]versioner.FileVersion, error)
 	RestoreFolderVersions(folder string, versions map[string]time.Time) (map[string]error, error)
@@ -96,6 +164,9 @@ type Model interface {
 	NeedFolderFiles(folder string, page, perpage int) ([]db.FileInfoTruncated, []db.FileInfoTruncated, []db.FileInfoTruncated, error)
 	RemoteNeedFolderFiles(folder string, device protocol.DeviceID, page, perpage int) ([]db.FileInfoTruncated, error)
 	LocalChangedFolderFiles(folder string, page, perpage int) ([]db.FileInfoTruncated, error)
+	CompareFolders(first, second string, page, perpage int) ([]FolderDifference, error)
+	LocalChangesSince(folder string, since int64, limit int) (LocalChanges, error)
+	FilesModifiedBy(folder, by, prefix string, fn func(db.FileInfoTruncated) bool) error
 	FolderProgressBytesCompleted(folder string) int64
 
 	CurrentFolderFile(folder string, file string) (protocol.FileInfo, bool, error)
@@ -112,6 +183,7 @@ type Model interface {
 	PendingFolders(device protocol.DeviceID) (map[string]db.PendingFolder, error)
 
 	StartDeadlockDetector(timeout time.Duration)
+	Drain(timeout time.Duration)
 	GlobalDirectoryTree(folder, prefix string, levels int, dirsOnly bool) ([]*TreeEntry, error)
 }
 
@@ -137,8 +209,14 @@ type model struct {
 	// folderIOLimiter limits the number of concurrent I/O heavy operations,
 	// such as scans and pulls.
 	folderIOLimiter *byteSemaphore
-	fatalChan       chan error
-	started         chan struct{}
+	// pullsHoldingIO is the number of folders pulling with a
+	// folderIOLimiter token, accessed atomically.
+	pullsHoldingIO int32
+	// folderScanLimiter limits the number of folders scanning at the same
+	// time, on top of the folderIOLimiter.
+	folderScanLimiter *byteSemaphore
+	fatalChan         chan error
+	started           chan struct{}
 
 	// fields protected by fmut
 	fmut                           sync.RWMutex
@@ -181,8 +259,13 @@ var (
 	ErrFolderPaused      = errors.New("folder is paused")
 	ErrFolderNotRunning  = errors.New("folder is not running")
 	ErrFolderMissing     = errors.New("no such folder")
+	ErrResyncRequired    = errors.New("sequence is ahead of the local index, which was reset; a full resync is required")
 	errNetworkNotAllowed = errors.New("network not allowed")
 	errNoVersioner       = errors.New("folder has no versioner")
+	errNoPullError       = errors.New("no pull error for the given path")
+	errEmptyCommand      = errors.New("command is empty")
+	errWatchNotEnabled   = errors.New("watching for changes is not enabled")
+	ErrHashersOutOfRange = errors.New("number of hashers out of range")
 	// errors about why a connection is closed
 	errReplacingConnection             = errors.New("replacing connection")
 	errStopped                         = errors.New("Syncthing is being stopped")
@@ -223,6 +306,7 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, clientName, clientVersio
 		shortID:              id.Short(),
 		globalRequestLimiter: newByteSemaphore(1024 * cfg.Options().MaxConcurrentIncomingRequestKiB()),
 		folderIOLimiter:      newByteSemaphore(cfg.Options().MaxFolderConcurrency()),
+		folderScanLimiter:    newByteSemaphore(cfg.Options().MaxScanningFolders),
 		fatalChan:            make(chan error),
 		started:              make(chan struct{}),
 
@@ -312,6 +396,30 @@ func (m *model) closeAllConnectionsAndWait() {
 	}
 }
 
+// Drain makes all folders stop at the next point where that's safe, i.e.
+// after flushing their current database batch, and waits for them to be
+// done for up to the given timeout. Folders still busy by then are
+// cancelled when the model is stopped.
+func (m *model) Drain(timeout time.Duration) {
+	m.fmut.RLock()
+	drained := make([]<-chan struct{}, 0, len(m.folderRunners))
+	for _, r := range m.folderRunners {
+		drained = append(drained, r.Drain())
+	}
+	m.fmut.RUnlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for _, c := range drained {
+		select {
+		case <-c:
+		case <-timer.C:
+			l.Infof("Folders didn't stop within %v, cancelling them", timeout)
+			return
+		}
+	}
+}
+
 func (m *model) fatal(err error) {
 	select {
 	case m.fatalChan <- err:
@@ -543,6 +651,7 @@ func (m *model) restartFolder(from, to config.FolderConfiguration, cacheIgnoredF
 
 	m.cleanupFolderLocked(from)
 	if !to.Paused {
+		to = resolveFolderRoot(to)
 		if fsetNil {
 			// Create a new fset. Might take a while and we do it under
 			// locking, but it's unsafe to create fset:s concurrently so
@@ -591,6 +700,8 @@ func (m *model) restartFolder(from, to config.FolderConfiguration, cacheIgnoredF
 }
 
 func (m *model) newFolder(cfg config.FolderConfiguration, cacheIgnoredFiles bool) error {
+	cfg = resolveFolderRoot(cfg)
+
 	// Creating the fileset can take a long time (metadata calculation) so
 	// we do it outside of the lock.
 	fset, err := db.NewFileSet(cfg.ID, cfg.Filesystem(), m.db)
@@ -616,6 +727,16 @@ func (m *model) newFolder(cfg config.FolderConfiguration, cacheIgnoredFiles bool
 	return nil
 }
 
+// resolveFolderRoot resolves a symlinked folder root as configured. It's
+// done once, before the file set, ignores, versioner and folder are created
+// from the config, such that they all use the same root while running.
+func resolveFolderRoot(cfg config.FolderConfiguration) config.FolderConfiguration {
+	if err := cfg.ResolveRootSymlink(); err != nil {
+		l.Infof("Failed to resolve the root of folder %v, using it as is: %v", cfg.Description(), err)
+	}
+	return cfg
+}
+
 func (m *model) UsageReportingStats(report *contract.Report, version int, preview bool) {
 	if version >= 3 {
 		// Block stats
@@ -1013,6 +1134,9 @@ func (m *model) NeedFolderFiles(folder string, page, perpage int) ([]db.FileInfo
 		if cfg.IgnoreDelete && f.IsDeleted() {
 			return true
 		}
+		if cfg.PullDeletionsOnly && !f.IsDeleted() {
+			return true
+		}
 
 		if p.skip() {
 			return true
@@ -1097,6 +1221,219 @@ func (m *model) LocalChangedFolderFiles(folder string, page, perpage int) ([]db.
 	return files, nil
 }
 
+// LocalChanges is a batch of the local index entries of a folder, ordered by
+// sequence.
+type LocalChanges struct {
+	// IndexID identifies the local index. If it changes, the index was
+	// reset and previously seen sequence numbers are meaningless.
+	IndexID protocol.IndexID `json:"indexID"`
+	// Sequence is the current local sequence of the folder.
+	Sequence int64 `json:"sequence"`
+	// Reset is true if the requested sequence was higher than the current
+	// one, in which case the changes start from the beginning.
+	Reset bool                `json:"reset"`
+	Files []protocol.FileInfo `json:"files"`
+}
+
+// LocalChangesSince returns up to limit (unlimited if not positive) local
+// index entries with a sequence higher than since. The database only
+// retains the latest entry per item, thus if since is older than the oldest
+// retained sequence, the changes simply start at the oldest entry without
+// anything being lost.
+func (m *model) LocalChangesSince(folder string, since int64, limit int) (LocalChanges, error) {
+	m.fmut.RLock()
+	rf, ok := m.folderFiles[folder]
+	m.fmut.RUnlock()
+
+	if !ok {
+		return LocalChanges{}, ErrFolderMissing
+	}
+
+	snap, err := rf.Snapshot()
+	if err != nil {
+		return LocalChanges{}, err
+	}
+	defer snap.Release()
+
+	changes := LocalChanges{
+		IndexID:  rf.IndexID(protocol.LocalDeviceID),
+		Sequence: snap.Sequence(protocol.LocalDeviceID),
+	}
+	if since > changes.Sequence {
+		changes.Reset = true
+		since = 0
+	}
+	if since < 0 {
+		since = 0
+	}
+
+	changes.Files = haveSince(snap, since, limit)
+	return changes, nil
+}
+
+// haveSince returns up to limit (unlimited if not positive) local index
+// entries with a sequence higher than since.
+func haveSince(snap *db.Snapshot, since int64, limit int) []protocol.FileInfo {
+	files := make([]protocol.FileInfo, 0)
+	snap.WithHaveSequence(since+1, func(fi protocol.FileIntf) bool {
+		files = append(files, fi.(protocol.FileInfo))
+		return limit <= 0 || len(files) < limit
+	})
+	return files
+}
+
+// FilesModifiedBy calls fn for every global item at or below prefix (all
+// items if empty) that was last modified by the device with the given short
+// ID, until fn returns false.
+func (m *model) FilesModifiedBy(folder, by, prefix string, fn func(db.FileInfoTruncated) bool) error {
+	m.fmut.RLock()
+	rf, ok := m.folderFiles[folder]
+	m.fmut.RUnlock()
+
+	if !ok {
+		return ErrFolderMissing
+	}
+
+	snap, err := rf.Snapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	snap.WithPrefixedGlobalTruncated(prefix, func(fi protocol.FileIntf) bool {
+		if fi.FileModifiedBy().String() != by {
+			return true
+		}
+		return fn(fi.(db.FileInfoTruncated))
+	})
+	return nil
+}
+
+type FolderDifferenceType string
+
+const (
+	FolderDifferenceOnlyInFirst  FolderDifferenceType = "onlyInFirst"
+	FolderDifferenceOnlyInSecond FolderDifferenceType = "onlyInSecond"
+	FolderDifferenceContent      FolderDifferenceType = "contentDiffers"
+)
+
+// FolderDifference describes an item that doesn't match between two folders.
+// The size of an item missing on one side is zero.
+type FolderDifference struct {
+	Name       string               `json:"name"`
+	Type       FolderDifferenceType `json:"type"`
+	FirstSize  int64                `json:"firstSize"`
+	SecondSize int64                `json:"secondSize"`
+}
+
+// CompareFolders returns a paginated list of the differences between the
+// local contents of two folders on this device, i.e. items present in only
+// one of them and items present in both but of different type, size or
+// content.
+func (m *model) CompareFolders(first, second string, page, perpage int) ([]FolderDifference, error) {
+	m.fmut.RLock()
+	firstFiles, firstOk := m.folderFiles[first]
+	secondFiles, secondOk := m.folderFiles[second]
+	m.fmut.RUnlock()
+
+	if !firstOk || !secondOk {
+		return nil, ErrFolderMissing
+	}
+
+	firstSnap, err := firstFiles.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer firstSnap.Release()
+	secondSnap, err := secondFiles.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer secondSnap.Release()
+
+	diffs := make([]FolderDifference, 0, perpage)
+	p := newPager(page, perpage)
+	compareSnapshots(firstSnap, secondSnap, func(diff FolderDifference) bool {
+		if p.skip() {
+			return true
+		}
+		diffs = append(diffs, diff)
+		return !p.done()
+	})
+	return diffs, nil
+}
+
+// compareSnapshots calls fn for every difference between the local files in
+// the two snapshots, until fn returns false. Only one item is held in memory
+// at a time, such that it's safe to use on arbitrarily large folders.
+func compareSnapshots(first, second *db.Snapshot, fn func(FolderDifference) bool) {
+	cont := true
+	first.WithHaveTruncated(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
+		if !isPresentLocally(intf) {
+			return true
+		}
+		other, ok := second.Get(protocol.LocalDeviceID, intf.FileName())
+		if !ok || !isPresentLocally(other) {
+			cont = fn(FolderDifference{
+				Name:      intf.FileName(),
+				Type:      FolderDifferenceOnlyInFirst,
+				FirstSize: intf.FileSize(),
+			})
+			return cont
+		}
+		if contentEqual(first, intf, other) {
+			return true
+		}
+		cont = fn(FolderDifference{
+			Name:       intf.FileName(),
+			Type:       FolderDifferenceContent,
+			FirstSize:  intf.FileSize(),
+			SecondSize: other.FileSize(),
+		})
+		return cont
+	})
+	if !cont {
+		return
+	}
+	second.WithHaveTruncated(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
+		if !isPresentLocally(intf) {
+			return true
+		}
+		if other, ok := first.Get(protocol.LocalDeviceID, intf.FileName()); ok && isPresentLocally(other) {
+			// Already handled when iterating the first folder.
+			return true
+		}
+		return fn(FolderDifference{
+			Name:       intf.FileName(),
+			Type:       FolderDifferenceOnlyInSecond,
+			SecondSize: intf.FileSize(),
+		})
+	})
+}
+
+func isPresentLocally(f protocol.FileIntf) bool {
+	return !f.IsDeleted() && !f.IsInvalid()
+}
+
+// contentEqual checks whether the (truncated) file from snap has the same
+// type and content as other. The full file is only retrieved if the block
+// lists need to be compared.
+func contentEqual(snap *db.Snapshot, intf protocol.FileIntf, other protocol.FileInfo) bool {
+	if intf.FileType() != other.Type {
+		return false
+	}
+	switch {
+	case intf.IsDirectory():
+		return true
+	case intf.IsSymlink():
+		return intf.(db.FileInfoTruncated).SymlinkTarget == other.SymlinkTarget
+	case intf.FileSize() != other.Size:
+		return false
+	}
+	fi, ok := snap.Get(protocol.LocalDeviceID, intf.FileName())
+	return ok && fi.BlocksEqual(other)
+}
+
 type pager struct {
 	toSkip, get int
 }
@@ -1143,7 +1480,8 @@ func (m *model) handleIndex(deviceID protocol.DeviceID, folder string, fs []prot
 
 	l.Debugf("%v (in): %s / %q: %d files", op, deviceID, folder, len(fs))
 
-	if cfg, ok := m.cfg.Folder(folder); !ok || !cfg.SharedWith(deviceID) {
+	cfg, ok := m.cfg.Folder(folder)
+	if !ok || !cfg.SharedWith(deviceID) {
 		l.Infof("%v for unexpected folder ID %q sent from device %q; ensure that the folder exists and that this device is selected under \"Share With\" in the folder configuration.", op, folder, deviceID)
 		return errors.Wrap(ErrFolderMissing, folder)
 	} else if cfg.Paused {
@@ -1163,6 +1501,13 @@ func (m *model) handleIndex(deviceID protocol.DeviceID, folder string, fs []prot
 
 	if running {
 		defer runner.SchedulePull()
+		if cfg.VerifyBeforePull {
+			names := make([]string, len(fs))
+			for i := range fs {
+				names[i] = fs[i].Name
+			}
+			runner.ScheduleVerifyBeforePull(names)
+		}
 	}
 
 	m.pmut.RLock()
@@ -1181,6 +1526,13 @@ func (m *model) handleIndex(deviceID protocol.DeviceID, folder string, fs []prot
 	}
 	files.Update(deviceID, fs)
 
+	m.pmut.RLock()
+	indexSenders, ok := m.indexSenders[deviceID]
+	m.pmut.RUnlock()
+	if ok {
+		indexSenders.indexReceived(folder)
+	}
+
 	seq := files.Sequence(deviceID)
 	m.evLogger.Log(events.RemoteIndexUpdated, map[string]interface{}{
 		"device":   deviceID.String(),
@@ -2110,6 +2462,19 @@ func (m *model) CurrentIgnores(folder string) ([]string, []string, error) {
 	return ignores.Lines(), ignores.Patterns(), nil
 }
 
+// IsPathIgnored returns whether the path is ignored in the given folder
+// and the ignore pattern that decided that, if any.
+func (m *model) IsPathIgnored(folder, path string) (bool, string, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return false, "", err
+	}
+	return runner.IsPathIgnored(path)
+}
+
 func (m *model) SetIgnores(folder string, content []string) error {
 	cfg, ok := m.cfg.Folder(folder)
 	if !ok {
@@ -2392,14 +2757,85 @@ func (m *model) ScanFolderSubdirs(folder string, subs []string) error {
 	return runner.Scan(subs)
 }
 
+// ScanDryRun returns the changes a scan of the given subdirectories, or
+// the entire folder, would apply, without applying them.
+func (m *model) ScanDryRun(folder string, subs []string) ([]protocol.FileInfo, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return runner.ScanDryRun(subs)
+}
+
+// ForceScanFolderSubdirs scans like ScanFolderSubdirs, but without skipping
+// directories that look unchanged.
+func (m *model) ForceScanFolderSubdirs(folder string, subs []string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return runner.ForceScan(subs)
+}
+
+// ScanFolderSubdirsWithHashers scans like ScanFolderSubdirs, using the
+// given number of hasher routines for this scan only.
+func (m *model) ScanFolderSubdirsWithHashers(folder string, subs []string, hashers int) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return runner.ScanWithHashers(subs, hashers)
+}
+
 func (m *model) DelayScan(folder string, next time.Duration) {
+	m.DelayScanWithReason(folder, next, defaultScanDelayReason)
+}
+
+func (m *model) DelayScanWithReason(folder string, next time.Duration, reason string) {
 	m.fmut.RLock()
 	runner, ok := m.folderRunners[folder]
 	m.fmut.RUnlock()
 	if !ok {
 		return
 	}
-	runner.DelayScan(next)
+	runner.DelayScanWithReason(next, reason)
+}
+
+// ScanDelay returns why and until when the next scan of the given folder
+// was delayed, if it was.
+func (m *model) ScanDelay(folder string) (string, time.Time) {
+	m.fmut.RLock()
+	runner, ok := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if !ok {
+		return "", time.Time{}
+	}
+	return runner.ScanDelay()
+}
+
+// ModTimeWindow returns the effective modification time window of the given
+// folder, zero if it isn't running.
+func (m *model) ModTimeWindow(folder string) time.Duration {
+	m.fmut.RLock()
+	runner, ok := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if !ok {
+		return 0
+	}
+	return runner.ModTimeWindow()
 }
 
 // numHashers returns the number of hasher routines to use for a given folder,
@@ -2540,6 +2976,44 @@ func (m *model) FolderErrors(folder string) ([]FileError, error) {
 	return runner.Errors(), nil
 }
 
+// RetryPullError drops the pull error of the given file and retries it.
+func (m *model) RetryPullError(folder, path string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+	return runner.RetryPullError(path)
+}
+
+// ClearPullError dismisses the pull error of the given file.
+func (m *model) ClearPullError(folder, path string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+	runner.ClearPullError(path)
+	return nil
+}
+
+// SkippedSymlinks returns the symlinks in the given folder that aren't
+// synced due to its symlink policy.
+func (m *model) SkippedSymlinks(folder string) ([]string, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return runner.SkippedSymlinks(), nil
+}
+
 func (m *model) WatchError(folder string) error {
 	m.fmut.RLock()
 	err := m.checkFolderRunningLocked(folder)
@@ -2551,6 +3025,59 @@ func (m *model) WatchError(folder string) error {
 	return runner.WatchError()
 }
 
+// WatchStatus returns the watch error of the given folder, when watching
+// is retried next and how many times in a row it failed. It's all zero if
+// the folder isn't running or watching is healthy.
+func (m *model) WatchStatus(folder string) (error, time.Time, int) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return nil, time.Time{}, 0
+	}
+	return runner.WatchStatus()
+}
+
+// RestartWatch restarts watching the given folder for changes and returns
+// the resulting watch error, see folder.RestartWatchNow.
+func (m *model) RestartWatch(ctx context.Context, folder string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+	return runner.RestartWatchNow(ctx)
+}
+
+// ScanProgress returns the progress of the in-flight scan of the given
+// folder, see folder.ScanProgress.
+func (m *model) ScanProgress(folder string) (current, total int64, rate float64) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return 0, 0, 0
+	}
+	return runner.ScanProgress()
+}
+
+// TransferTotals returns the lifetime totals of the changes pulled into the
+// given folder.
+func (m *model) TransferTotals(folder string) (stats.TransferTotals, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return stats.TransferTotals{}, err
+	}
+	return runner.GetTransferTotals()
+}
+
 func (m *model) Override(folder string) {
 	// Grab the runner and the file set.
 
@@ -2581,6 +3108,211 @@ func (m *model) Revert(folder string) {
 	runner.Revert()
 }
 
+// RepairMtimes drops stale modification time mappings of the given folder,
+// returning how many were removed.
+func (m *model) RepairMtimes(folder string) (int, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return runner.RepairMtimes()
+}
+
+// AcknowledgeEmptyPath lets the given folder scan even though its path is
+// suspected to be an empty mountpoint.
+func (m *model) AcknowledgeEmptyPath(folder string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return runner.AcknowledgeEmptyPath()
+}
+
+// SetPullPaused pauses or resumes pulling of the given folder, without
+// pausing scanning.
+func (m *model) SetPullPaused(folder string, paused bool) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	runner.SetPullPaused(paused)
+	return nil
+}
+
+// PullPaused returns whether pulling of the given folder is paused.
+func (m *model) PullPaused(folder string) bool {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return false
+	}
+	return runner.PullPaused()
+}
+
+// PullConcurrency returns the amount of block requests the given folder
+// keeps pending while pulling.
+func (m *model) PullConcurrency(folder string) (PullConcurrency, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return PullConcurrency{}, err
+	}
+
+	return runner.PullConcurrency(), nil
+}
+
+// PullRetries returns the state of the automatic retries of failed pulls of
+// the given folder.
+func (m *model) PullRetries(folder string) (PullRetries, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return PullRetries{}, err
+	}
+
+	return runner.PullRetries(), nil
+}
+
+// ResumePulling resets the failed pull retries of the given folder and
+// pulls, also if pulling was halted due to too many failed retries.
+func (m *model) ResumePulling(folder string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return runner.ResumePulling()
+}
+
+// RehashFolder starts rehashing all files of the given folder.
+func (m *model) RehashFolder(folder string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return runner.Rehash()
+}
+
+// CancelRehash stops rehashing the given folder.
+func (m *model) CancelRehash(folder string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return runner.CancelRehash()
+}
+
+// RehashStatus returns the progress of rehashing the given folder.
+func (m *model) RehashStatus(folder string) (RehashStatus, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return RehashStatus{}, err
+	}
+
+	return runner.RehashStatus(), nil
+}
+
+// ChronicConflicts returns the files of the given folder that conflict
+// repeatedly.
+func (m *model) ChronicConflicts(folder string) ([]ChronicConflict, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return runner.ChronicConflicts()
+}
+
+// AuditEntries returns the matching changes applied to the given folder from
+// other devices, if the folder keeps an audit log.
+func (m *model) AuditEntries(folder string, q AuditQuery) ([]AuditEntry, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return runner.AuditEntries(q)
+}
+
+// PullPlan returns what a pull of the given folder would do right now,
+// without pulling anything.
+func (m *model) PullPlan(folder string) (PullPlan, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return PullPlan{}, err
+	}
+
+	return runner.PullPlan()
+}
+
+// TempFiles returns the temporary files of partial transfers currently
+// present in the given folder.
+func (m *model) TempFiles(folder string) ([]TempFile, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return runner.TempFiles()
+}
+
 type TreeEntry struct {
 	Name     string                `json:"name"`
 	ModTime  time.Time             `json:"modTime"`
@@ -2767,7 +3499,29 @@ func (m *model) availabilityInSnapshotPRlocked(cfg config.FolderConfiguration, s
 		}
 	}
 
-	return availabilities
+	return filterAllowedSources(cfg, availabilities)
+}
+
+// filterAllowedSources removes the devices which aren't allowed sources,
+// unless none is and the folder falls back to any device.
+func filterAllowedSources(cfg config.FolderConfiguration, availabilities []Availability) []Availability {
+	sources := cfg.AllowedSources()
+	if len(sources) == 0 {
+		return availabilities
+	}
+	var allowed []Availability
+	for _, av := range availabilities {
+		for _, dev := range sources {
+			if av.ID == dev {
+				allowed = append(allowed, av)
+				break
+			}
+		}
+	}
+	if len(allowed) == 0 && cfg.SourceFallback == config.SourceFallbackAny {
+		return availabilities
+	}
+	return allowed
 }
 
 // BringToFront bumps the given files priority in the job queue.
@@ -2849,6 +3603,15 @@ func (m *model) CommitConfiguration(from, to config.Configuration) bool {
 			clusterConfigDevices.add(toCfg.DeviceIDs())
 		}
 
+		if live, restart := fromCfg.ChangedFields(toCfg); len(live) > 0 || len(restart) > 0 {
+			m.evLogger.Log(events.FolderConfigApplied, map[string]interface{}{
+				"id":      toCfg.ID,
+				"label":   toCfg.Label,
+				"live":    live,
+				"restart": restart,
+			})
+		}
+
 		// Emit the folder pause/resume event
 		if fromCfg.Paused != toCfg.Paused {
 			eventType := events.FolderResumed
@@ -2928,6 +3691,7 @@ func (m *model) CommitConfiguration(from, to config.Configuration) bool {
 
 	m.globalRequestLimiter.setCapacity(1024 * to.Options.MaxConcurrentIncomingRequestKiB())
 	m.folderIOLimiter.setCapacity(to.Options.MaxFolderConcurrency())
+	m.folderScanLimiter.setCapacity(to.Options.MaxScanningFolders)
 
 	// Some options don't require restart as those components handle it fine
 	// by themselves. Compare the options structs containing only the
@@ -16,6 +16,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"runtime/pprof"
 	"sort"
@@ -3286,6 +3287,154 @@ func TestDevicePause(t *testing.T) {
 	}
 }
 
+func TestFolderConfigAppliedEvent(t *testing.T) {
+	m, _, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	sub := m.evLogger.Subscribe(events.FolderConfigApplied)
+	defer sub.Unsubscribe()
+
+	fcfg.Label = "new label"
+	fcfg.RescanIntervalS++
+	setFolder(t, m.cfg, fcfg)
+
+	select {
+	case ev := <-sub.C():
+		data := ev.Data.(map[string]interface{})
+		if live := data["live"].([]string); !reflect.DeepEqual(live, []string{"label"}) {
+			t.Errorf("Expected label to be applied live, got %v", live)
+		}
+		if restart := data["restart"].([]string); !reflect.DeepEqual(restart, []string{"rescanIntervalS"}) {
+			t.Errorf("Expected rescan interval to require a restart, got %v", restart)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out before config was applied")
+	}
+}
+
+func TestDelayScanWithReason(t *testing.T) {
+	m, _, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	sub := m.evLogger.Subscribe(events.ScanDelayed)
+	defer sub.Unsubscribe()
+
+	m.DelayScanWithReason(fcfg.ID, time.Hour, "maintenance")
+
+	select {
+	case ev := <-sub.C():
+		data := ev.Data.(map[string]interface{})
+		if data["reason"] != "maintenance" {
+			t.Errorf("Expected reason maintenance, got %v", data["reason"])
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out before scan was delayed")
+	}
+
+	reason, until := m.ScanDelay(fcfg.ID)
+	if reason != "maintenance" {
+		t.Errorf("Expected reason maintenance, got %q", reason)
+	}
+	if d := time.Until(until); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("Expected scan to be delayed by an hour, got %v", d)
+	}
+}
+
+func TestIgnoreAndRemoveLocally(t *testing.T) {
+	m, _, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+	ffs := fcfg.Filesystem()
+
+	must(t, ffs.MkdirAll("gone", 0755))
+	for _, name := range []string{filepath.Join("gone", "a"), filepath.Join("gone", "b"), "kept"} {
+		must(t, writeFile(ffs, name, []byte("content"), 0644))
+	}
+	must(t, m.ScanFolder(fcfg.ID))
+
+	res, err := m.IgnoreAndRemoveLocally(fcfg.ID, []string{"gone"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res.Removed, []string{"gone"}) || res.Files != 2 || res.Directories != 1 || res.Bytes != 2*int64(len("content")) {
+		t.Errorf("Unexpected removal summary %+v", res)
+	}
+	if _, err := ffs.Lstat("gone"); !fs.IsNotExist(err) {
+		t.Error("Expected gone to be removed, got", err)
+	}
+	if _, err := ffs.Lstat("kept"); err != nil {
+		t.Error("Expected kept to remain, got", err)
+	}
+	lines, _, err := m.LoadIgnores(fcfg.ID)
+	must(t, err)
+	if !reflect.DeepEqual(lines, []string{"/gone"}) {
+		t.Errorf("Expected gone to be ignored, got %v", lines)
+	}
+
+	// The removal must not turn into deletions on the next scan.
+	must(t, m.ScanFolder(fcfg.ID))
+	for _, name := range []string{"gone", filepath.Join("gone", "a"), filepath.Join("gone", "b")} {
+		file, ok, err := m.CurrentFolderFile(fcfg.ID, name)
+		must(t, err)
+		if !ok {
+			t.Errorf("Expected %v in the database", name)
+			continue
+		}
+		if file.IsDeleted() || !file.IsIgnored() {
+			t.Errorf("Expected %v to be ignored and not deleted, got %v", name, file)
+		}
+	}
+}
+
+func TestIndexExchangeStatus(t *testing.T) {
+	m, _, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	getStatus := func() IndexExchangeStatus {
+		t.Helper()
+		status, err := m.IndexExchangeStatus(fcfg.ID)
+		must(t, err)
+		if _, ok := status[myID]; ok {
+			t.Error("Expected no status for ourselves")
+		}
+		st, ok := status[device1]
+		if !ok {
+			t.Fatal("Expected status for device1")
+		}
+		return st
+	}
+
+	// The index sender runs in the background.
+	var st IndexExchangeStatus
+	for i := 0; i < 100; i++ {
+		if st = getStatus(); st.InitialIndexSent {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !st.Connected || !st.InitialIndexSent || st.SentSequence != st.LocalSequence {
+		t.Errorf("Expected our index to be sent, got %+v", st)
+	}
+	if !st.InitialIndexReceived || !st.LastReceived.IsZero() {
+		t.Errorf("Expected nothing to be received yet, got %+v", st)
+	}
+
+	file := protocol.FileInfo{
+		Name:     "foo",
+		Sequence: 1,
+		Version:  protocol.Vector{}.Update(device1.Short()),
+	}
+	must(t, m.IndexUpdate(device1, fcfg.ID, []protocol.FileInfo{file}))
+
+	st = getStatus()
+	if st.ReceivedSequence != 1 || st.LastReceived.IsZero() {
+		t.Errorf("Expected their index update to be recorded, got %+v", st)
+	}
+}
+
 func TestDeviceWasSeen(t *testing.T) {
 	m, _, fcfg, wcfgCancel := setupModelWithConnection(t)
 	defer wcfgCancel()
@@ -3695,6 +3844,54 @@ func TestScanRenameCaseOnly(t *testing.T) {
 	})
 }
 
+func TestScanWatcherDeleteGrace(t *testing.T) {
+	wcfg, fcfg, wcfgCancel := tmpDefaultWrapper()
+	defer wcfgCancel()
+	m := setupModel(t, wcfg)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	ffs := fcfg.Filesystem()
+	name := "foo"
+	must(t, writeFile(ffs, name, []byte("contents"), 0644))
+	m.ScanFolders()
+
+	m.fmut.RLock()
+	f := m.folderRunners[fcfg.ID].(*sendReceiveFolder)
+	m.fmut.RUnlock()
+	scan := func() {
+		t.Helper()
+		must(t, f.doInSync(func() error {
+			return f.scanSubdirsWithOptions([]string{name}, scanOptions{deleteGrace: time.Second})
+		}))
+	}
+
+	// The file is replaced while the scan waits for the grace period, so
+	// it must not be marked as deleted.
+	must(t, ffs.Remove(name))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		time.Sleep(100 * time.Millisecond)
+		must(t, writeFile(ffs, name, []byte("new contents"), 0644))
+	}()
+	scan()
+	<-done
+
+	if fi, ok := m.testCurrentFolderFile(fcfg.ID, name); !ok || fi.IsDeleted() {
+		t.Fatal("replaced file was marked as deleted")
+	} else if fi.Size != int64(len("new contents")) {
+		t.Errorf("replaced file has size %v, expected %v", fi.Size, len("new contents"))
+	}
+
+	// Without the file coming back it must be deleted after the grace period.
+	must(t, ffs.Remove(name))
+	scan()
+
+	if fi, ok := m.testCurrentFolderFile(fcfg.ID, name); !ok || !fi.IsDeleted() {
+		t.Error("file was not marked as deleted")
+	}
+}
+
 func TestClusterConfigOnFolderAdd(t *testing.T) {
 	testConfigChangeTriggersClusterConfigs(t, false, true, nil, func(wrapper config.Wrapper) {
 		fcfg := testFolderConfigTmp()
@@ -4237,6 +4434,200 @@ func TestPendingFolder(t *testing.T) {
 	}
 }
 
+func TestCompareFolders(t *testing.T) {
+	m := newModel(t, defaultCfgWrapper, myID, "syncthing", "dev", nil)
+	defer cleanupModel(m)
+
+	file := func(name string, size int64, hash byte) protocol.FileInfo {
+		return protocol.FileInfo{
+			Name:    name,
+			Type:    protocol.FileInfoTypeFile,
+			Size:    size,
+			Version: protocol.Vector{}.Update(myID.Short()),
+			Blocks:  []protocol.BlockInfo{{Size: int(size), Hash: []byte{hash}}},
+		}
+	}
+	deleted := file("deleted", 0, 0)
+	deleted.Deleted = true
+
+	first := newFileSet(t, "first", fs.NewFilesystem(fs.FilesystemTypeFake, "first"), m.db)
+	first.Update(protocol.LocalDeviceID, []protocol.FileInfo{
+		file("both", 10, 1),
+		file("content", 10, 1),
+		file("size", 10, 1),
+		file("onlyFirst", 10, 1),
+		deleted,
+	})
+	second := newFileSet(t, "second", fs.NewFilesystem(fs.FilesystemTypeFake, "second"), m.db)
+	second.Update(protocol.LocalDeviceID, []protocol.FileInfo{
+		file("both", 10, 1),
+		file("content", 10, 2),
+		file("size", 20, 1),
+		file("onlySecond", 20, 1),
+		file("deleted", 20, 1),
+	})
+	m.fmut.Lock()
+	m.folderFiles["first"] = first
+	m.folderFiles["second"] = second
+	m.fmut.Unlock()
+
+	expected := []FolderDifference{
+		{Name: "content", Type: FolderDifferenceContent, FirstSize: 10, SecondSize: 10},
+		{Name: "onlyFirst", Type: FolderDifferenceOnlyInFirst, FirstSize: 10},
+		{Name: "size", Type: FolderDifferenceContent, FirstSize: 10, SecondSize: 20},
+		{Name: "deleted", Type: FolderDifferenceOnlyInSecond, SecondSize: 20},
+		{Name: "onlySecond", Type: FolderDifferenceOnlyInSecond, SecondSize: 20},
+	}
+
+	diffs, err := m.CompareFolders("first", "second", 1, 10)
+	must(t, err)
+	if !reflect.DeepEqual(diffs, expected) {
+		t.Errorf("Got differences %v, expected %v", diffs, expected)
+	}
+
+	diffs, err = m.CompareFolders("first", "second", 2, 2)
+	must(t, err)
+	if !reflect.DeepEqual(diffs, expected[2:4]) {
+		t.Errorf("Got differences %v on second page, expected %v", diffs, expected[2:4])
+	}
+
+	if _, err := m.CompareFolders("first", "nonexistent", 1, 10); err != ErrFolderMissing {
+		t.Errorf("Expected ErrFolderMissing, got %v", err)
+	}
+}
+
+func TestLocalChangesSince(t *testing.T) {
+	m := newModel(t, defaultCfgWrapper, myID, "syncthing", "dev", nil)
+	defer cleanupModel(m)
+
+	fset := newFileSet(t, "changes", fs.NewFilesystem(fs.FilesystemTypeFake, "changes"), m.db)
+	m.fmut.Lock()
+	m.folderFiles["changes"] = fset
+	m.fmut.Unlock()
+
+	var files []protocol.FileInfo
+	for _, name := range []string{"a", "b", "c"} {
+		files = append(files, protocol.FileInfo{Name: name, Version: protocol.Vector{}.Update(myID.Short())})
+	}
+	fset.Update(protocol.LocalDeviceID, files)
+	// Changing a file again removes its old sequence entry.
+	files[0].Version = files[0].Version.Update(myID.Short())
+	fset.Update(protocol.LocalDeviceID, files[:1])
+
+	names := func(changes LocalChanges) []string {
+		var res []string
+		for _, f := range changes.Files {
+			res = append(res, f.Name)
+		}
+		return res
+	}
+
+	changes, err := m.LocalChangesSince("changes", 0, 0)
+	must(t, err)
+	if changes.Sequence != 4 || changes.Reset || changes.IndexID != fset.IndexID(protocol.LocalDeviceID) {
+		t.Errorf("Unexpected changes metadata: %+v", changes)
+	}
+	if got := names(changes); !reflect.DeepEqual(got, []string{"b", "c", "a"}) {
+		t.Errorf("Got files %v, expected [b c a]", got)
+	}
+
+	changes, err = m.LocalChangesSince("changes", 2, 1)
+	must(t, err)
+	if got := names(changes); !reflect.DeepEqual(got, []string{"c"}) {
+		t.Errorf("Got files %v, expected [c]", got)
+	}
+
+	changes, err = m.LocalChangesSince("changes", 10, 0)
+	must(t, err)
+	if !changes.Reset {
+		t.Error("Expected a reset when asking for a sequence in the future")
+	}
+	if got := names(changes); !reflect.DeepEqual(got, []string{"b", "c", "a"}) {
+		t.Errorf("Got files %v after reset, expected [b c a]", got)
+	}
+}
+
+func TestFilesModifiedBy(t *testing.T) {
+	m := newModel(t, defaultCfgWrapper, myID, "syncthing", "dev", nil)
+	defer cleanupModel(m)
+
+	fset := newFileSet(t, "modifiedby", fs.NewFilesystem(fs.FilesystemTypeFake, "modifiedby"), m.db)
+	m.fmut.Lock()
+	m.folderFiles["modifiedby"] = fset
+	m.fmut.Unlock()
+
+	files := []protocol.FileInfo{
+		{Name: "a", ModifiedBy: device1.Short(), Version: protocol.Vector{}.Update(device1.Short())},
+		{Name: "dir", Type: protocol.FileInfoTypeDirectory, ModifiedBy: device1.Short(), Version: protocol.Vector{}.Update(device1.Short())},
+		{Name: filepath.Join("dir", "b"), ModifiedBy: device1.Short(), Version: protocol.Vector{}.Update(device1.Short())},
+		{Name: filepath.Join("dir", "c"), ModifiedBy: device2.Short(), Version: protocol.Vector{}.Update(device2.Short())},
+		{Name: "dirx", ModifiedBy: device1.Short(), Version: protocol.Vector{}.Update(device1.Short())},
+	}
+	fset.Update(protocol.LocalDeviceID, files)
+
+	modifiedBy := func(by, prefix string) []string {
+		t.Helper()
+		var res []string
+		must(t, m.FilesModifiedBy("modifiedby", by, prefix, func(f db.FileInfoTruncated) bool {
+			res = append(res, f.Name)
+			return true
+		}))
+		return res
+	}
+
+	if got, expected := modifiedBy(device1.Short().String(), ""), []string{"a", "dir", filepath.Join("dir", "b"), "dirx"}; !equalStringsInAnyOrder(got, expected) {
+		t.Errorf("Got %v, expected %v", got, expected)
+	}
+	if got, expected := modifiedBy(device1.Short().String(), "dir"), []string{"dir", filepath.Join("dir", "b")}; !equalStringsInAnyOrder(got, expected) {
+		t.Errorf("Got %v with prefix, expected %v", got, expected)
+	}
+	if got, expected := modifiedBy(device2.Short().String(), ""), []string{filepath.Join("dir", "c")}; !equalStringsInAnyOrder(got, expected) {
+		t.Errorf("Got %v for other device, expected %v", got, expected)
+	}
+
+	if err := m.FilesModifiedBy("nonexistent", device1.Short().String(), "", func(db.FileInfoTruncated) bool { return true }); err != ErrFolderMissing {
+		t.Errorf("Expected ErrFolderMissing, got %v", err)
+	}
+}
+
+func TestFolderHealth(t *testing.T) {
+	now := time.Now()
+	healthy := FolderHealthFactors{
+		State:           FolderIdle.String(),
+		LastScan:        now.Add(-time.Minute),
+		RescanIntervalS: 3600,
+		WatcherEnabled:  true,
+		CompletionPct:   100,
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*FolderHealthFactors)
+		status FolderHealthStatus
+		score  int
+	}{
+		{"healthy", func(*FolderHealthFactors) {}, FolderHealthy, 100},
+		{"error", func(f *FolderHealthFactors) { f.State, f.Error = FolderError.String(), "folder path missing" }, FolderUnhealthy, 0},
+		{"stale scan", func(f *FolderHealthFactors) { f.LastScan = now.Add(-3 * time.Hour) }, FolderDegraded, 75},
+		{"stale scan without rescans", func(f *FolderHealthFactors) { f.RescanIntervalS, f.LastScan = 0, now.Add(-48*time.Hour) }, FolderDegraded, 75},
+		{"stale scan while scanning", func(f *FolderHealthFactors) { f.State, f.LastScan = FolderScanning.String(), time.Time{} }, FolderHealthy, 100},
+		{"stale scan and watch error", func(f *FolderHealthFactors) { f.LastScan, f.WatchError = time.Time{}, "too many files" }, FolderDegraded, 50},
+		{"backoff and item errors", func(f *FolderHealthFactors) { f.PullBackoffLevel, f.ItemErrors = 6, 3 }, FolderUnhealthy, 40},
+		{"idle incomplete", func(f *FolderHealthFactors) { f.CompletionPct = 50 }, FolderDegraded, 88},
+		{"syncing incomplete", func(f *FolderHealthFactors) { f.State, f.CompletionPct = FolderSyncing.String(), 50 }, FolderHealthy, 100},
+	} {
+		factors := healthy
+		tc.modify(&factors)
+		h := newFolderHealth(factors, now)
+		if h.Status != tc.status || h.Score != tc.score {
+			t.Errorf("%v: Expected %v with score %v, got %v with %v (%v)", tc.name, tc.status, tc.score, h.Status, h.Score, h.Reasons)
+		}
+		if (h.Status == FolderHealthy) != (len(h.Reasons) == 0) {
+			t.Errorf("%v: Unexpected reasons %v for %v", tc.name, h.Reasons, h.Status)
+		}
+	}
+}
+
 func equalStringsInAnyOrder(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -4250,3 +4641,226 @@ func equalStringsInAnyOrder(a, b []string) bool {
 	}
 	return true
 }
+
+func TestLocalIndexExportImport(t *testing.T) {
+	w, wCancel := createTmpWrapper(defaultCfgWrapper.RawCopy())
+	defer wCancel()
+	fcfg := testFolderConfigFake()
+	fcfg.ID = "localindex"
+	waiter, err := w.Modify(func(cfg *config.Configuration) {
+		cfg.SetFolder(fcfg)
+	})
+	must(t, err)
+	waiter.Wait()
+	ffs := fcfg.Filesystem()
+	for _, name := range []string{"a", "b", "c"} {
+		must(t, writeFile(ffs, name, []byte("content of "+name), 0644))
+	}
+
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+	exported, ok := m.testCurrentFolderFile(fcfg.ID, "b")
+	if !ok {
+		t.Fatal("File missing in database")
+	}
+
+	var buf bytes.Buffer
+	must(t, m.ExportLocalIndex(fcfg.ID, &buf))
+
+	resetLocal := func() {
+		m.fmut.RLock()
+		m.folderFiles[fcfg.ID].Drop(protocol.LocalDeviceID)
+		m.fmut.RUnlock()
+	}
+
+	resetLocal()
+	if _, err := m.ImportLocalIndex(fcfg.ID, bytes.NewReader([]byte("garbage")), 0); err != errLocalIndexFormat {
+		t.Error("Expected format error, got", err)
+	}
+
+	// Same size and modification time, but different content.
+	info, err := ffs.Lstat("b")
+	must(t, err)
+	must(t, writeFile(ffs, "b", []byte("CONTENT OF b"), 0644))
+	must(t, ffs.Chtimes("b", info.ModTime(), info.ModTime()))
+	if _, err := m.ImportLocalIndex(fcfg.ID, bytes.NewReader(buf.Bytes()), 10); !errors.Is(err, errLocalIndexMismatch) {
+		t.Fatal("Expected mismatch error, got", err)
+	}
+	if _, ok := m.testCurrentFolderFile(fcfg.ID, "a"); ok {
+		t.Fatal("Expected nothing to be imported on mismatch")
+	}
+
+	must(t, writeFile(ffs, "b", []byte("content of b"), 0644))
+	must(t, ffs.Chtimes("b", info.ModTime(), info.ModTime()))
+	imported, err := m.ImportLocalIndex(fcfg.ID, bytes.NewReader(buf.Bytes()), 10)
+	must(t, err)
+	if imported != 3 {
+		t.Errorf("Expected 3 imported files, got %v", imported)
+	}
+	if fi, ok := m.testCurrentFolderFile(fcfg.ID, "b"); !ok || !fi.Version.Equal(exported.Version) || !fi.BlocksEqual(exported) {
+		t.Errorf("Expected imported file to equal exported one, got %v", fi)
+	}
+}
+
+func TestLocalIndexImportAfterReset(t *testing.T) {
+	w, wCancel := createTmpWrapper(defaultCfgWrapper.RawCopy())
+	defer wCancel()
+	fcfg := testFolderConfigFake()
+	fcfg.ID = "localindex"
+	setFolder(t, w, fcfg)
+	ffs := fcfg.Filesystem()
+	for _, name := range []string{"a", "b", "c"} {
+		must(t, writeFile(ffs, name, []byte("content of "+name), 0644))
+	}
+
+	m := setupModel(t, w)
+	// Changed once more such that the exported versions differ from those
+	// of a scan from scratch.
+	for _, name := range []string{"a", "b", "c"} {
+		must(t, ffs.Chtimes(name, time.Unix(1234567890, 0), time.Unix(1234567890, 0)))
+	}
+	must(t, m.ScanFolder(fcfg.ID))
+	var buf bytes.Buffer
+	must(t, m.ExportLocalIndex(fcfg.ID, &buf))
+	exported := make(map[string]protocol.FileInfo)
+	for _, name := range []string{"a", "b", "c"} {
+		exported[name], _ = m.testCurrentFolderFile(fcfg.ID, name)
+	}
+	cleanupModel(m)
+
+	// c changes meanwhile, then the database is reset and the folder
+	// scanned anew on start.
+	must(t, writeFile(ffs, "c", []byte("changed content of c"), 0644))
+	m = setupModel(t, w)
+	defer cleanupModel(m)
+	scanned, _ := m.testCurrentFolderFile(fcfg.ID, "c")
+
+	imported, err := m.ImportLocalIndex(fcfg.ID, bytes.NewReader(buf.Bytes()), 0)
+	must(t, err)
+	if imported != 2 {
+		t.Errorf("Expected 2 imported files, got %v", imported)
+	}
+	for _, name := range []string{"a", "b"} {
+		if fi, _ := m.testCurrentFolderFile(fcfg.ID, name); !fi.Version.Equal(exported[name].Version) {
+			t.Errorf("Expected %v to get the exported version %v, got %v", name, exported[name].Version, fi.Version)
+		}
+	}
+	if fi, _ := m.testCurrentFolderFile(fcfg.ID, "c"); !fi.Version.Equal(scanned.Version) || fi.Version.Equal(exported["c"].Version) {
+		t.Errorf("Expected the changed file to be kept as scanned, got %v", fi)
+	}
+}
+
+func TestFilterAllowedSources(t *testing.T) {
+	fcfg := config.FolderConfiguration{
+		Devices: []config.FolderDeviceConfiguration{
+			{DeviceID: device1},
+			{DeviceID: device2},
+		},
+	}
+	avs := []Availability{{ID: device1}, {ID: device2, FromTemporary: true}}
+
+	// Without allowed sources every device is.
+	if res := filterAllowedSources(fcfg, avs); len(res) != 2 {
+		t.Errorf("Expected all devices, got %v", res)
+	}
+
+	fcfg.Devices[1].AllowedSource = true
+	if res := filterAllowedSources(fcfg, avs); len(res) != 1 || res[0].ID != device2 {
+		t.Errorf("Expected only device2, got %v", res)
+	}
+
+	// Fall back to any device if no allowed source has the block.
+	if res := filterAllowedSources(fcfg, avs[:1]); len(res) != 1 || res[0].ID != device1 {
+		t.Errorf("Expected fallback to device1, got %v", res)
+	}
+	fcfg.SourceFallback = config.SourceFallbackHold
+	if res := filterAllowedSources(fcfg, avs[:1]); len(res) != 0 {
+		t.Errorf("Expected no device when holding, got %v", res)
+	}
+}
+
+func TestSendOnlyOverride(t *testing.T) {
+	w, cancel := createTmpWrapper(defaultCfg)
+	defer cancel()
+	cfg := w.RawCopy()
+	fcfg := testFolderConfigFake()
+	fcfg.ID = "so"
+	fcfg.Type = config.FolderTypeSendOnly
+	cfg.Folders = []config.FolderConfiguration{fcfg}
+	replace(t, w, cfg)
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+	ffs := fcfg.Filesystem()
+
+	must(t, writeFile(ffs, "edited", []byte("local"), 0644))
+	must(t, m.ScanFolder("so"))
+	snap := dbSnapshot(t, m, "so")
+	local, _ := snap.Get(protocol.LocalDeviceID, "edited")
+	snap.Release()
+
+	// The remote has a newer version of our file and one we don't have.
+	remote := []protocol.FileInfo{
+		{Name: "edited", Type: protocol.FileInfoTypeFile, Size: 6, Version: local.Version.Update(device1.Short())},
+		{Name: "remoteOnly", Type: protocol.FileInfoTypeFile, Size: 6, Version: protocol.Vector{}.Update(device1.Short())},
+	}
+	must(t, m.Index(device1, "so", remote))
+	if size := needSize(t, m, "so"); size.Files != 2 {
+		t.Fatalf("Expected to need 2 files, got %+v", size)
+	}
+
+	m.Override("so")
+
+	if size := needSize(t, m, "so"); size.Files != 0 {
+		t.Errorf("Expected to need nothing after override, got %+v", size)
+	}
+	snap = dbSnapshot(t, m, "so")
+	defer snap.Release()
+	for _, fi := range remote {
+		global, ok := snap.GetGlobal(fi.Name)
+		if !ok || global.ModifiedBy != myID.Short() || !global.Version.GreaterEqual(fi.Version) {
+			t.Errorf("Expected our version of %v to win, got %v", fi.Name, global)
+		}
+	}
+	if global, _ := snap.GetGlobal("remoteOnly"); !global.IsDeleted() {
+		t.Error("Expected the file we don't have to be deleted")
+	}
+}
+
+func TestFollowRootSymlinkResolvedOnStart(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks need privileges on Windows")
+	}
+
+	dir := createTmpDir()
+	defer os.RemoveAll(dir)
+	first := filepath.Join(dir, "first")
+	second := filepath.Join(dir, "second")
+	for _, path := range []string{first, second} {
+		must(t, os.Mkdir(path, 0755))
+		must(t, ioutil.WriteFile(filepath.Join(path, filepath.Base(path)), []byte("data"), 0644))
+	}
+	link := filepath.Join(dir, "link")
+	must(t, os.Symlink(first, link))
+
+	fcfg := testFolderConfig(link)
+	fcfg.FollowRootSymlink = true
+	w, wCancel := createTmpWrapper(defaultCfgWrapper.RawCopy())
+	defer wCancel()
+	setFolder(t, w, fcfg)
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+
+	// Repointing the link while running doesn't change the root.
+	must(t, os.Remove(link))
+	must(t, os.Symlink(second, link))
+	must(t, m.ScanFolder(fcfg.ID))
+
+	snap := dbSnapshot(t, m, fcfg.ID)
+	defer snap.Release()
+	if _, ok := snap.Get(protocol.LocalDeviceID, "first"); !ok {
+		t.Error("Expected the file in the resolved root to be scanned")
+	}
+	if _, ok := snap.Get(protocol.LocalDeviceID, "second"); ok {
+		t.Error("Expected the file in the new link target not to be scanned")
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// ErrFolderNested is returned, wrapped, for folders whose path is within or
+// the same as the path of another folder. Both would scan and sync the
+// same items. Fix by removing one of them, or by ignoring the nested path
+// in the outer folder and moving the nested one elsewhere.
+var ErrFolderNested = errors.New("folder path is within another folder")
+
+// checkNestedFolder returns an error wrapping ErrFolderNested if the
+// folder's path is within or the same as that of another, unpaused folder.
+// Only the inner folder gets the error, such that the outer one keeps
+// syncing. Paths are compared with symlinks resolved.
+func (f *folder) checkNestedFolder() error {
+	own, ok := comparableFolderPath(f.FolderConfiguration)
+	if !ok {
+		return nil
+	}
+	for _, other := range f.model.cfg.FolderList() {
+		if other.ID == f.ID || other.Paused {
+			continue
+		}
+		path, ok := comparableFolderPath(other)
+		if !ok {
+			continue
+		}
+		switch {
+		case own == path:
+			return fmt.Errorf("%w: same path as folder %v", ErrFolderNested, other.Description())
+		case fs.IsParent(own, path):
+			return fmt.Errorf("%w: folder %v at %v", ErrFolderNested, other.Description(), other.Path)
+		}
+	}
+	return nil
+}
+
+// comparableFolderPath returns the absolute path of the folder with
+// symlinks resolved as far as they exist, and in lower case on platforms
+// that are usually case insensitive. It returns false for filesystems other
+// than basic.
+func comparableFolderPath(cfg config.FolderConfiguration) (string, bool) {
+	if cfg.FilesystemType != fs.FilesystemTypeBasic {
+		return "", false
+	}
+	path, err := fs.ExpandTilde(cfg.Path)
+	if err != nil {
+		return "", false
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+	path = resolveExistingSymlinks(path)
+	if runtime.GOOS == "windows" || runtime.GOOS == "darwin" {
+		path = strings.ToLower(path)
+	}
+	return path, true
+}
+
+// resolveExistingSymlinks resolves the symlinks in the given clean absolute
+// path, keeping the components that don't exist as they are.
+func resolveExistingSymlinks(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	dir, base := filepath.Split(path)
+	dir = filepath.Clean(dir)
+	if base == "" || dir == path {
+		return path
+	}
+	return filepath.Join(resolveExistingSymlinks(dir), base)
+}
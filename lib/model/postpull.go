@@ -0,0 +1,97 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/kballard/go-shellquote"
+
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// The post pull command is killed if it runs longer than this.
+const postPullCommandTimeout = 10 * time.Minute
+
+// schedulePostPullCommand makes sure the post pull command runs once more
+// after the current invocation, if any, finished.
+func (f *folder) schedulePostPullCommand() {
+	if f.PostPullCommand == "" {
+		return
+	}
+	select {
+	case f.postPullCommandScheduled <- struct{}{}:
+	default:
+		// A run is already pending, it will see the changes of this pull.
+	}
+}
+
+// postPullCommandRoutine runs the post pull command whenever scheduled, one
+// invocation at a time, such that the serve loop isn't blocked.
+func (f *folder) postPullCommandRoutine(ctx context.Context) {
+	for {
+		select {
+		case <-f.postPullCommandScheduled:
+			f.runPostPullCommand(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runPostPullCommand runs the post pull command and reports its output in
+// an event. Failures are only logged, they don't affect the folder.
+func (f *folder) runPostPullCommand(ctx context.Context) {
+	data := map[string]interface{}{
+		"folder":  f.ID,
+		"label":   f.Label,
+		"command": f.PostPullCommand,
+	}
+	defer f.evLogger.Log(events.FolderCommandOutput, data)
+
+	words, err := shellquote.Split(f.PostPullCommand)
+	if err == nil && len(words) == 0 {
+		err = errEmptyCommand
+	}
+	if err != nil {
+		l.Infof("Post pull command for folder %v is invalid: %v", f.Description(), err)
+		data["error"] = err.Error()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, postPullCommandTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, words[0], words[1:]...)
+	// Don't leak the GUI credentials to the command.
+	for _, env := range os.Environ() {
+		if !strings.HasPrefix(env, "STGUIAUTH=") && !strings.HasPrefix(env, "STGUIAPIKEY=") {
+			cmd.Env = append(cmd.Env, env)
+		}
+	}
+	cmd.Env = append(cmd.Env,
+		"STFOLDERID="+f.ID,
+		"STFOLDERLABEL="+f.Label,
+		"STFOLDERPATH="+f.Filesystem().URI(),
+	)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	l.Debugln(f, "running post pull command", f.PostPullCommand)
+	err = cmd.Run()
+	data["stdout"] = stdout.String()
+	data["stderr"] = stderr.String()
+	if err != nil {
+		l.Infof("Post pull command for folder %v failed: %v", f.Description(), err)
+		data["error"] = err.Error()
+	}
+}
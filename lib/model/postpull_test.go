@@ -0,0 +1,55 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+func TestPostPullCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.PostPullCommand = `sh -c 'echo $STFOLDERID; echo failing >&2; exit 3'`
+
+	// Runs are coalesced while one is pending.
+	f.schedulePostPullCommand()
+	f.schedulePostPullCommand()
+	if l := len(f.postPullCommandScheduled); l != 1 {
+		t.Fatalf("Expected one scheduled run, got %d", l)
+	}
+
+	sub := m.evLogger.Subscribe(events.FolderCommandOutput)
+	defer sub.Unsubscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go f.postPullCommandRoutine(ctx)
+
+	ev, err := sub.Poll(5 * time.Second)
+	if err != nil {
+		t.Fatal("Expected a command output event:", err)
+	}
+	data := ev.Data.(map[string]interface{})
+	if data["folder"] != f.ID || data["stdout"] != f.ID+"\n" || data["stderr"] != "failing\n" {
+		t.Errorf("Unexpected event data %v", data)
+	}
+	if _, ok := data["error"]; !ok {
+		t.Error("Expected the exit code to be reported as error")
+	}
+	if _, _, err := f.getState(); err != nil {
+		t.Error("Expected a failing command to not set a folder error, got", err)
+	}
+}
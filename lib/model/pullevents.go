@@ -0,0 +1,66 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// pullEventThrottle keeps track of the RemoteChangeDetected events emitted
+// within the current second and the changes that were left out. It's only
+// used while pulling, which never happens concurrently.
+type pullEventThrottle struct {
+	windowStart time.Time
+	emitted     int
+	modified    int
+	deleted     int
+}
+
+// emitThrottledPullEvents emits a RemoteChangeDetected event per change
+// until PullEventsPerS were emitted within a second, and counts the
+// remaining changes for a summary.
+func (f *folder) emitThrottledPullEvents(fs []protocol.FileInfo, now time.Time) {
+	t := &f.pullEvents
+	for _, file := range fs {
+		if file.IsInvalid() {
+			continue
+		}
+		if now.Sub(t.windowStart) >= time.Second {
+			f.flushPullEventSummary()
+			t.windowStart = now
+			t.emitted = 0
+		}
+		if t.emitted < f.PullEventsPerS {
+			t.emitted++
+			f.emitDiskChangeEvent(file, events.RemoteChangeDetected)
+		} else if file.IsDeleted() {
+			t.deleted++
+		} else {
+			t.modified++
+		}
+	}
+}
+
+// flushPullEventSummary emits a RemoteChangeSummary event for the changes
+// that didn't get an event of their own, if any.
+func (f *folder) flushPullEventSummary() {
+	t := &f.pullEvents
+	if t.modified+t.deleted == 0 {
+		return
+	}
+	f.evLogger.Log(events.RemoteChangeSummary, map[string]interface{}{
+		"folder":   f.ID,
+		"label":    f.Label,
+		"modified": t.modified,
+		"deleted":  t.deleted,
+	})
+	t.modified = 0
+	t.deleted = 0
+}
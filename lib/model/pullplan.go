@@ -0,0 +1,131 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"runtime"
+
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// PullPlan describes what a pull of a folder would do at this point.
+type PullPlan struct {
+	Items []PullPlanItem `json:"items"`
+	// Total size of the files that would be updated.
+	TotalBytes int64 `json:"totalBytes"`
+	// Amount of data that would be downloaded from other devices, i.e.
+	// excluding blocks that are already available locally.
+	DownloadBytes int64 `json:"downloadBytes"`
+}
+
+type PullPlanItem struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Action        string `json:"action"` // "update", "metadata" or "delete"
+	Size          int64  `json:"size"`
+	DownloadBytes int64  `json:"downloadBytes"`
+}
+
+// PullPlan returns what a pull would do, without changing anything.
+func (f *folder) PullPlan() (PullPlan, error) {
+	// Nothing is pulled in folders that aren't puller based.
+	return PullPlan{Items: make([]PullPlanItem, 0)}, nil
+}
+
+// PullPlan goes through the needed items like the puller does and returns
+// what would be pulled. Blocks that are present in our current version of
+// the file or anywhere else in the local folders, as well as blocks needed
+// more than once, count as downloaded only once or not at all.
+func (f *sendReceiveFolder) PullPlan() (PullPlan, error) {
+	plan := PullPlan{Items: make([]PullPlanItem, 0)}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return plan, err
+	}
+	defer snap.Release()
+
+	folders := []string{f.folderID}
+	for folder := range f.model.cfg.Folders() {
+		if folder != f.folderID {
+			folders = append(folders, folder)
+		}
+	}
+	planned := make(map[string]struct{})
+	ignoresHidden := f.hiddenIgnorer()
+
+	snap.WithNeed(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
+		if f.IgnoreDelete && intf.IsDeleted() {
+			return true
+		}
+		if f.PullDeletionsOnly && !intf.IsDeleted() {
+			return true
+		}
+
+		file := intf.(protocol.FileInfo)
+		switch {
+		case f.ignores.ShouldIgnore(file.Name), ignoresHidden(file.Name), file.IsInvalid():
+			return true
+		case runtime.GOOS == "windows" && fs.WindowsInvalidFilename(file.Name) != nil:
+			return true
+		}
+
+		item := PullPlanItem{
+			Name:   file.Name,
+			Type:   file.Type.String(),
+			Action: "update",
+		}
+
+		switch {
+		case file.IsDeleted():
+			item.Action = "delete"
+
+		case file.Type == protocol.FileInfoTypeFile:
+			if f.wasRejected(file) != nil {
+				return true
+			}
+			item.Size = file.Size
+			curFile, hasCurFile := snap.Get(protocol.LocalDeviceID, file.Name)
+			if hasCurFile && file.BlocksEqual(curFile) {
+				item.Action = "metadata"
+				break
+			}
+			item.DownloadBytes = f.plannedDownload(file, curFile, folders, planned)
+		}
+
+		plan.Items = append(plan.Items, item)
+		plan.TotalBytes += item.Size
+		plan.DownloadBytes += item.DownloadBytes
+		return true
+	})
+
+	return plan, nil
+}
+
+// plannedDownload returns how much of the given file would be downloaded,
+// i.e. the size of all blocks that are neither available locally nor
+// planned to be downloaded already. Planned blocks are added to the given
+// set.
+func (f *sendReceiveFolder) plannedDownload(file, curFile protocol.FileInfo, folders []string, planned map[string]struct{}) int64 {
+	_, need := blockDiff(curFile.Blocks, file.Blocks)
+	var size int64
+	for _, block := range need {
+		key := string(block.Hash)
+		if _, ok := planned[key]; ok {
+			continue
+		}
+		planned[key] = struct{}{}
+		found := f.model.finder.Iterate(folders, block.Hash, func(string, string, int32) bool {
+			return true
+		})
+		if !found {
+			size += int64(block.Size)
+		}
+	}
+	return size
+}
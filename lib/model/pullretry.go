@@ -0,0 +1,76 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+var errPullHalted = errors.New("stopped retrying to pull after too many failures, manual intervention required (resume pulling once the cause is fixed)")
+
+// PullRetries describes the automatic retries of failed pulls of a folder.
+type PullRetries struct {
+	// Consecutive failed pulls and how often the pause between retries
+	// was doubled due to them.
+	Failures     int `json:"failures"`
+	BackoffLevel int `json:"backoffLevel"`
+	// When the next retry is due, zero if none is scheduled.
+	NextRetry  time.Time `json:"nextRetry"`
+	MaxRetries int       `json:"maxRetries"`
+	// Halted is true once MaxRetries retries failed. Pulling then only
+	// resumes through the API or once there is nothing left to pull.
+	Halted bool `json:"halted"`
+	// ScanRetryPending is true if the folder has an error, which is
+	// checked again by the next scan.
+	ScanRetryPending bool `json:"scanRetryPending"`
+}
+
+func (f *folder) PullRetries() PullRetries {
+	r := PullRetries{
+		Failures:     int(atomic.LoadInt32(&f.pullFailures)),
+		BackoffLevel: f.PullBackoff(),
+		MaxRetries:   f.MaxPullRetries,
+		Halted:       atomic.LoadInt32(&f.pullHalted) == 1,
+	}
+	if at := atomic.LoadInt64(&f.pullRetryAt); at != 0 {
+		r.NextRetry = time.Unix(0, at)
+	}
+	_, _, err := f.getState()
+	r.ScanRetryPending = err != nil && err != errPullHalted
+	return r
+}
+
+// ResumePulling resets the failed pull retries, resuming pulling if it was
+// halted, and pulls right away.
+func (f *folder) ResumePulling() error {
+	return f.doInSync(func() error {
+		if atomic.LoadInt32(&f.pullHalted) == 1 {
+			l.Infof("Folder %v: Resuming pulling", f.Description())
+		}
+		f.pullPause = f.pullBasePause()
+		f.setPullBackoff(0)
+		f.resetPullRetries()
+		f.SchedulePull()
+		return nil
+	})
+}
+
+func (f *folder) resetPullRetries() {
+	atomic.StoreInt32(&f.pullFailures, 0)
+	atomic.StoreInt64(&f.pullRetryAt, 0)
+	atomic.StoreInt32(&f.pullHalted, 0)
+}
+
+// pullHaltedError returns errPullHalted if pulling is halted, nil otherwise.
+func (f *folder) pullHaltedError() error {
+	if atomic.LoadInt32(&f.pullHalted) == 1 {
+		return errPullHalted
+	}
+	return nil
+}
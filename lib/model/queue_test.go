@@ -402,6 +402,38 @@ func TestQueuePagination(t *testing.T) {
 	}
 }
 
+func TestQueuePaginationBoundaries(t *testing.T) {
+	q := newJobQueue()
+
+	for page := 1; page < 3; page++ {
+		progress, queued, skip := q.Jobs(page, 5)
+		if len(progress) != 0 || len(queued) != 0 || skip != 0 {
+			t.Errorf("Expected nothing for page %d of an empty queue, got %v, %v, %d", page, progress, queued, skip)
+		}
+	}
+
+	names := []string{"f0", "f1", "f2", "f3"}
+	for _, name := range names {
+		q.Push(name, 0, time.Time{})
+	}
+	q.Pop()
+	q.Pop()
+
+	// The in progress items fill the first page exactly.
+	progress, queued, skip := q.Jobs(1, 2)
+	if !equalStrings(progress, names[:2]) || len(queued) != 0 || skip != 0 {
+		t.Errorf("Wrong first page, got %v, %v, %d", progress, queued, skip)
+	}
+	progress, queued, skip = q.Jobs(2, 2)
+	if len(progress) != 0 || !equalStrings(queued, names[2:]) || skip != 2 {
+		t.Errorf("Wrong second page, got %v, %v, %d", progress, queued, skip)
+	}
+	progress, queued, skip = q.Jobs(3, 2)
+	if len(progress) != 0 || len(queued) != 0 || skip != 4 {
+		t.Errorf("Wrong page past the end, got %v, %v, %d", progress, queued, skip)
+	}
+}
+
 func equalStrings(first, second []string) bool {
 	if len(first) != len(second) {
 		return false
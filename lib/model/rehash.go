@@ -0,0 +1,236 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+const (
+	rehashBatchFiles = 1000
+	rehashBatchBytes = 256 << 20
+)
+
+type RehashState string
+
+const (
+	RehashIdle      RehashState = "idle"
+	RehashRunning   RehashState = "running"
+	RehashDone      RehashState = "done"
+	RehashCancelled RehashState = "cancelled"
+	RehashFailed    RehashState = "failed"
+)
+
+var (
+	errRehashRunning     = errors.New("folder is already being rehashed")
+	errRehashNotRunning  = errors.New("folder is not being rehashed")
+	errRehashUnsupported = errors.New("encrypted folders cannot be rehashed")
+)
+
+// RehashStatus describes the progress of rehashing all files of a folder.
+// Files that turn out unchanged keep their version.
+type RehashStatus struct {
+	State       RehashState `json:"state"`
+	Started     time.Time   `json:"started"`
+	Finished    time.Time   `json:"finished"`
+	TotalFiles  int         `json:"totalFiles"`
+	TotalBytes  int64       `json:"totalBytes"`
+	HashedFiles int         `json:"hashedFiles"`
+	HashedBytes int64       `json:"hashedBytes"`
+	Changed     int         `json:"changed"`
+	Error       string      `json:"error"`
+}
+
+// rehash tracks a running rehash. Files are rehashed in batches in order
+// of their sequence, up to the sequence when the rehash started, as every
+// file rehashed or otherwise changed since gets a higher one.
+type rehash struct {
+	status    RehashStatus
+	nextSeq   int64
+	maxSeq    int64
+	cancelled bool
+}
+
+// Rehash starts rehashing all files of the folder.
+func (f *folder) Rehash() error {
+	if f.Type == config.FolderTypeReceiveEncrypted {
+		return errRehashUnsupported
+	}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	counts := snap.LocalSize()
+	maxSeq := snap.Sequence(protocol.LocalDeviceID)
+	snap.Release()
+
+	f.rehashMut.Lock()
+	defer f.rehashMut.Unlock()
+	if f.rehash.status.State == RehashRunning {
+		return errRehashRunning
+	}
+	f.rehash = rehash{
+		status: RehashStatus{
+			State:      RehashRunning,
+			Started:    time.Now(),
+			TotalFiles: counts.Files,
+			TotalBytes: counts.Bytes,
+		},
+		nextSeq: 1,
+		maxSeq:  maxSeq,
+	}
+	l.Infof("Folder %v: Rehashing %d files (%d bytes)", f.Description(), counts.Files, counts.Bytes)
+	f.scheduleRehashBatch()
+	return nil
+}
+
+// CancelRehash stops rehashing the folder after the current batch.
+func (f *folder) CancelRehash() error {
+	f.rehashMut.Lock()
+	defer f.rehashMut.Unlock()
+	if f.rehash.status.State != RehashRunning {
+		return errRehashNotRunning
+	}
+	f.rehash.cancelled = true
+	return nil
+}
+
+// RehashStatus returns the status of the current or last rehash.
+func (f *folder) RehashStatus() RehashStatus {
+	f.rehashMut.Lock()
+	defer f.rehashMut.Unlock()
+	if f.rehash.status.State == "" {
+		return RehashStatus{State: RehashIdle}
+	}
+	return f.rehash.status
+}
+
+func (f *folder) scheduleRehashBatch() {
+	select {
+	case f.rehashBatchRequested <- struct{}{}:
+	default:
+	}
+}
+
+// rehashBatch marks the next batch of files for rescanning and rescans
+// them. Batches are handled one at a time from the serve loop, such that
+// scans and pulls aren't held up for the entire rehash.
+func (f *folder) rehashBatch() error {
+	f.rehashMut.Lock()
+	if f.rehash.status.State != RehashRunning {
+		f.rehashMut.Unlock()
+		return nil
+	}
+	if f.rehash.cancelled {
+		f.finishRehashLocked(RehashCancelled, nil)
+		f.rehashMut.Unlock()
+		return nil
+	}
+	nextSeq, maxSeq := f.rehash.nextSeq, f.rehash.maxSeq
+	f.rehashMut.Unlock()
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	var batch []protocol.FileInfo
+	var batchBytes int64
+	more := false
+	snap.WithHaveSequence(nextSeq, func(intf protocol.FileIntf) bool {
+		fi := intf.(protocol.FileInfo)
+		if fi.Sequence > maxSeq {
+			return false
+		}
+		nextSeq = fi.Sequence + 1
+		if fi.Type != protocol.FileInfoTypeFile || fi.IsDeleted() || (fi.IsInvalid() && !fi.MustRescan()) {
+			return true
+		}
+		batch = append(batch, fi)
+		batchBytes += fi.Size
+		if len(batch) >= rehashBatchFiles || batchBytes >= rehashBatchBytes {
+			more = true
+			return false
+		}
+		return true
+	})
+	snap.Release()
+
+	// Marking the files for rescanning drops their blocks, thus keep the
+	// original ones to compare against.
+	names := make([]string, len(batch))
+	marked := make([]protocol.FileInfo, len(batch))
+	rehashed := make(map[string]protocol.FileInfo, len(batch))
+	for i, fi := range batch {
+		names[i] = fi.Name
+		rehashed[fi.Name] = fi
+		fi.SetMustRescan()
+		marked[i] = fi
+	}
+	if len(marked) > 0 {
+		f.fset.Update(protocol.LocalDeviceID, marked)
+		err = f.scanSubdirsWithOptions(names, scanOptions{force: true, rehashed: rehashed})
+	}
+
+	changed := 0
+	if err == nil && len(batch) > 0 {
+		snap, err = f.dbSnapshot()
+		if err != nil {
+			return err
+		}
+		for _, fi := range batch {
+			if cur, ok := snap.Get(protocol.LocalDeviceID, fi.Name); !ok || !cur.Version.Equal(fi.Version) {
+				changed++
+			}
+		}
+		snap.Release()
+	}
+
+	f.rehashMut.Lock()
+	defer f.rehashMut.Unlock()
+	f.rehash.nextSeq = nextSeq
+	f.rehash.status.HashedFiles += len(batch)
+	f.rehash.status.HashedBytes += batchBytes
+	f.rehash.status.Changed += changed
+	switch {
+	case err != nil:
+		f.finishRehashLocked(RehashFailed, err)
+		return err
+	case more:
+		f.scheduleRehashBatch()
+	default:
+		f.finishRehashLocked(RehashDone, nil)
+	}
+	return nil
+}
+
+func (f *folder) finishRehashLocked(state RehashState, err error) {
+	f.rehash.status.State = state
+	f.rehash.status.Finished = time.Now()
+	if err != nil {
+		f.rehash.status.Error = err.Error()
+	}
+	l.Infof("Folder %v: Rehashing %v after %d files, %d of them changed", f.Description(), state, f.rehash.status.HashedFiles, f.rehash.status.Changed)
+}
+
+// keepVersionIfUnchanged keeps the version of a rehashed file, if it turns
+// out to have the same content as before.
+func (f *folder) keepVersionIfUnchanged(rehashed map[string]protocol.FileInfo, fi *protocol.FileInfo) {
+	orig, ok := rehashed[fi.Name]
+	if !ok || fi.Type != protocol.FileInfoTypeFile {
+		return
+	}
+	orig.LocalFlags = fi.LocalFlags
+	if orig.IsEquivalentOptional(*fi, f.modTimeWindow, f.IgnorePerms, false, 0) {
+		fi.Version = orig.Version
+		fi.ModifiedBy = orig.ModifiedBy
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// renameCache remembers the names of the rename candidates per blocks hash
+// during a scan, such that files with the same contents don't each need a
+// database lookup. A nil cache is valid and caches nothing.
+type renameCache struct {
+	*lru.Cache
+}
+
+func newRenameCache(size int) *renameCache {
+	if size <= 0 {
+		return nil
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		// Only happens for non-positive sizes.
+		panic(err)
+	}
+	return &renameCache{cache}
+}
+
+func (c *renameCache) get(blocksHash []byte) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	names, ok := c.Get(string(blocksHash))
+	if !ok {
+		return nil, false
+	}
+	return names.([]string), true
+}
+
+func (c *renameCache) add(blocksHash []byte, names []string) {
+	if c == nil {
+		return
+	}
+	c.Add(string(blocksHash), names)
+}
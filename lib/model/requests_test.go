@@ -1441,3 +1441,43 @@ func TestRequestIssue7474(t *testing.T) {
 		}
 	}
 }
+
+func TestRequestPullPaused(t *testing.T) {
+	m, fc, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	tfs := fcfg.Filesystem()
+	defer cleanupModelAndRemoveDir(m, tfs.URI())
+
+	done := make(chan struct{})
+	fc.setIndexFn(func(_ context.Context, folder string, fs []protocol.FileInfo) error {
+		for _, f := range fs {
+			if f.Name == "testfile" {
+				close(done)
+			}
+		}
+		return nil
+	})
+
+	must(t, m.SetPullPaused(fcfg.ID, true))
+	if !m.PullPaused(fcfg.ID) {
+		t.Fatal("Expected pulling to be paused")
+	}
+
+	fc.addFile("testfile", 0644, protocol.FileInfoTypeFile, []byte("test file contents\n"))
+	fc.sendIndexUpdate()
+	select {
+	case <-done:
+		t.Fatal("File was pulled while pulling is paused")
+	case <-time.After(time.Second):
+	}
+
+	// Scanning continues.
+	must(t, m.ScanFolder(fcfg.ID))
+
+	must(t, m.SetPullPaused(fcfg.ID, false))
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("File wasn't pulled after resuming")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// No single wait on the limiter may exceed its burst size.
+const scanReadBurst = 128 << 10
+
+func newScanReadLimiter(kibps int) *rate.Limiter {
+	if kibps <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(kibps)*1024, scanReadBurst)
+}
+
+// readLimitedFS limits reading the contents of files opened through it
+// by a limiter, which is shared by all hashers of a folder.
+type readLimitedFS struct {
+	fs.Filesystem
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+func (f *readLimitedFS) Open(name string) (fs.File, error) {
+	fd, err := f.Filesystem.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &readLimitedFile{File: fd, fs: f}, nil
+}
+
+type readLimitedFile struct {
+	fs.File
+	fs *readLimitedFS
+}
+
+func (f *readLimitedFile) Read(bs []byte) (int, error) {
+	n, err := f.File.Read(bs)
+	if waitErr := f.fs.take(n); err == nil {
+		err = waitErr
+	}
+	return n, err
+}
+
+func (f *readLimitedFile) ReadAt(bs []byte, off int64) (int, error) {
+	n, err := f.File.ReadAt(bs, off)
+	if waitErr := f.fs.take(n); err == nil {
+		err = waitErr
+	}
+	return n, err
+}
+
+// take waits until n bytes may be read, or the scan is cancelled.
+func (f *readLimitedFS) take(n int) error {
+	for n > 0 {
+		tokens := n
+		if tokens > scanReadBurst {
+			tokens = scanReadBurst
+		}
+		if err := f.limiter.WaitN(f.ctx, tokens); err != nil {
+			return err
+		}
+		n -= tokens
+	}
+	return nil
+}
+
+// scanFilesystem returns the filesystem to be walked and hashed by a scan
+// with the given context.
+func (f *folder) scanFilesystem(ctx context.Context) fs.Filesystem {
+	if f.scanReadLimiter == nil {
+		return f.mtimefs
+	}
+	return &readLimitedFS{
+		Filesystem: f.mtimefs,
+		ctx:        ctx,
+		limiter:    f.scanReadLimiter,
+	}
+}
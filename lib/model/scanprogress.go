@@ -0,0 +1,153 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// scanStats summarize what a scan found, for the FolderScanCompleted event.
+type scanStats struct {
+	files       int // changed or new items
+	hashedBytes int64
+	deleted     int
+	renamed     int
+}
+
+func (s *scanStats) scanned(file protocol.FileInfo, hashed bool) {
+	s.files++
+	if hashed && file.Type == protocol.FileInfoTypeFile && !file.IsDeleted() {
+		s.hashedBytes += file.Size
+	}
+}
+
+// scanProgress tracks how much of the in-flight scan has been hashed, in
+// bytes or, if the files aren't hashed, in files.
+type scanProgress struct {
+	running bool
+	byFiles bool
+	current int64
+	total   int64
+	started time.Time
+	mut     sync.Mutex
+}
+
+func newScanProgress() *scanProgress {
+	return &scanProgress{
+		mut: sync.NewMutex(),
+	}
+}
+
+func (p *scanProgress) start(byFiles bool, now time.Time) {
+	p.mut.Lock()
+	p.running = true
+	p.byFiles = byFiles
+	p.current = 0
+	p.total = 0
+	p.started = now
+	p.mut.Unlock()
+}
+
+func (p *scanProgress) stop() {
+	p.mut.Lock()
+	p.running = false
+	p.mut.Unlock()
+}
+
+// setTotal is called by the scanner once it knows how much is to be hashed.
+func (p *scanProgress) setTotal(files int, bytes int64) {
+	p.mut.Lock()
+	if p.byFiles {
+		p.total = int64(files)
+	} else {
+		p.total = bytes
+	}
+	p.mut.Unlock()
+}
+
+// scanned is called for every scan result.
+func (p *scanProgress) scanned(file protocol.FileInfo) {
+	if file.Type != protocol.FileInfoTypeFile || file.IsDeleted() {
+		return
+	}
+	p.mut.Lock()
+	if p.byFiles {
+		p.current++
+	} else {
+		p.current += file.Size
+	}
+	p.mut.Unlock()
+}
+
+// get returns the progress and the rate per second, all zero if no scan
+// is running. The total is zero until the scanner finished walking.
+func (p *scanProgress) get(now time.Time) (current, total int64, rate float64) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	if !p.running {
+		return 0, 0, 0
+	}
+	if elapsed := now.Sub(p.started).Seconds(); elapsed > 0 {
+		rate = float64(p.current) / elapsed
+	}
+	return p.current, p.total, rate
+}
+
+// Phases of a scan, as reported by FolderScanPhase events.
+const (
+	scanPhaseWalking          = "walking"
+	scanPhaseHashing          = "hashing"
+	scanPhaseDetectingDeletes = "detecting deletes"
+	scanPhaseFlushing         = "flushing"
+)
+
+// scanPhases emits a FolderScanPhase event when a scan enters a phase, at
+// most once per phase and scan.
+type scanPhases struct {
+	folder   string
+	evLogger events.Logger
+	enabled  bool
+	entered  map[string]struct{}
+	mut      sync.Mutex
+}
+
+func newScanPhases(folder string, evLogger events.Logger) *scanPhases {
+	return &scanPhases{
+		folder:   folder,
+		evLogger: evLogger,
+		mut:      sync.NewMutex(),
+	}
+}
+
+// reset is called at the start of each scan. Dry runs don't emit events.
+func (p *scanPhases) reset(enabled bool) {
+	p.mut.Lock()
+	p.enabled = enabled
+	p.entered = make(map[string]struct{})
+	p.mut.Unlock()
+}
+
+// enter is called when the scan enters the given phase, possibly by the
+// scanner.
+func (p *scanPhases) enter(phase string) {
+	p.mut.Lock()
+	if _, ok := p.entered[phase]; ok || !p.enabled {
+		p.mut.Unlock()
+		return
+	}
+	p.entered[phase] = struct{}{}
+	p.mut.Unlock()
+
+	p.evLogger.Log(events.FolderScanPhase, map[string]string{
+		"folder": p.folder,
+		"phase":  phase,
+	})
+}
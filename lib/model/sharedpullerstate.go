@@ -36,19 +36,20 @@ type sharedPullerState struct {
 	fsync       bool
 
 	// Mutable, must be locked for access
-	err               error           // The first error we hit
-	writer            *lockedWriterAt // Wraps fd to prevent fd closing at the same time as writing
-	copyTotal         int             // Total number of copy actions for the whole job
-	pullTotal         int             // Total number of pull actions for the whole job
-	copyOrigin        int             // Number of blocks copied from the original file
-	copyOriginShifted int             // Number of blocks copied from the original file but shifted
-	copyNeeded        int             // Number of copy actions still pending
-	pullNeeded        int             // Number of block pulls still pending
-	updated           time.Time       // Time when any of the counters above were last updated
-	closed            bool            // True if the file has been finalClosed.
-	available         []int           // Indexes of the blocks that are available in the temporary file
-	availableUpdated  time.Time       // Time when list of available blocks was last updated
-	mut               sync.RWMutex    // Protects the above
+	err               error               // The first error we hit
+	writer            *lockedWriterAt     // Wraps fd to prevent fd closing at the same time as writing
+	copyTotal         int                 // Total number of copy actions for the whole job
+	pullTotal         int                 // Total number of pull actions for the whole job
+	copyOrigin        int                 // Number of blocks copied from the original file
+	copyOriginShifted int                 // Number of blocks copied from the original file but shifted
+	copyNeeded        int                 // Number of copy actions still pending
+	pullNeeded        int                 // Number of block pulls still pending
+	updated           time.Time           // Time when any of the counters above were last updated
+	closed            bool                // True if the file has been finalClosed.
+	available         []int               // Indexes of the blocks that are available in the temporary file
+	availableUpdated  time.Time           // Time when list of available blocks was last updated
+	sources           []protocol.DeviceID // Devices blocks were pulled from
+	mut               sync.RWMutex        // Protects the above
 }
 
 func newSharedPullerState(file protocol.FileInfo, fs fs.Filesystem, folderID, tempName string, blocks []protocol.BlockInfo, reused []int, ignorePerms, hasCurFile bool, curFile protocol.FileInfo, sparse bool, fsync bool) *sharedPullerState {
@@ -85,6 +86,8 @@ type pullerProgress struct {
 	Pulling                 int   `json:"pulling"`
 	BytesDone               int64 `json:"bytesDone"`
 	BytesTotal              int64 `json:"bytesTotal"`
+	// The devices blocks were pulled from so far.
+	Sources []protocol.DeviceID `json:"sources,omitempty"`
 }
 
 // lockedWriterAt adds a lock to protect from closing the fd at the same time as writing.
@@ -251,6 +254,18 @@ func (s *sharedPullerState) failed() error {
 	return err
 }
 
+// pendingBytes returns the amount of data that is yet to be written to the
+// temporary file.
+func (s *sharedPullerState) pendingBytes() uint64 {
+	s.mut.RLock()
+	pending := int64(s.copyNeeded+s.pullNeeded) * int64(s.file.BlockSize())
+	s.mut.RUnlock()
+	if pending > s.file.Size {
+		pending = s.file.Size
+	}
+	return uint64(pending)
+}
+
 func (s *sharedPullerState) copyDone(block protocol.BlockInfo) {
 	s.mut.Lock()
 	s.copyNeeded--
@@ -297,6 +312,18 @@ func (s *sharedPullerState) pullDone(block protocol.BlockInfo) {
 	s.mut.Unlock()
 }
 
+// pulledFrom records that a block was pulled from the given device.
+func (s *sharedPullerState) pulledFrom(device protocol.DeviceID) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	for _, dev := range s.sources {
+		if dev == device {
+			return
+		}
+	}
+	s.sources = append(s.sources, device)
+}
+
 // finalClose atomically closes and returns closed status of a file. A true
 // first return value means the file was closed and should be finished, with
 // the error indicating the success or failure of the close. A false first
@@ -392,6 +419,7 @@ func (s *sharedPullerState) Progress() *pullerProgress {
 		Pulling:             s.pullNeeded,
 		BytesTotal:          blocksToSize(total, file, s.file.BlockSize(), s.file.Size),
 		BytesDone:           blocksToSize(done, file, s.file.BlockSize(), s.file.Size),
+		Sources:             append([]protocol.DeviceID(nil), s.sources...),
 	}
 }
 
@@ -0,0 +1,120 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"math/bits"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// SizeDistribution describes the sizes of the files in a folder.
+type SizeDistribution struct {
+	Files        int   `json:"files"`
+	Bytes        int64 `json:"bytes"`
+	AverageBytes int64 `json:"averageBytes"`
+	// The median is estimated, as the sizes aren't kept in memory.
+	MedianBytes int64        `json:"medianBytes"`
+	Buckets     []SizeBucket `json:"buckets"`
+}
+
+// SizeBucket counts the files with a size from MinBytes (inclusive) to
+// MaxBytes (exclusive), where a MaxBytes of zero means no upper limit.
+type SizeBucket struct {
+	MinBytes int64 `json:"minBytes"`
+	MaxBytes int64 `json:"maxBytes"`
+	Files    int   `json:"files"`
+	Bytes    int64 `json:"bytes"`
+}
+
+var sizeBucketLimits = []int64{
+	1 << 10,
+	100 << 10,
+	1 << 20,
+	100 << 20,
+}
+
+// sizeDistributionCounter gathers a SizeDistribution, one file at a time.
+// Besides the buckets it counts the files per power of two in size, which
+// is used to estimate the median.
+type sizeDistributionCounter struct {
+	dist  SizeDistribution
+	pow2s [64]int
+}
+
+func newSizeDistributionCounter() *sizeDistributionCounter {
+	c := &sizeDistributionCounter{}
+	var min int64
+	for _, max := range sizeBucketLimits {
+		c.dist.Buckets = append(c.dist.Buckets, SizeBucket{MinBytes: min, MaxBytes: max})
+		min = max
+	}
+	c.dist.Buckets = append(c.dist.Buckets, SizeBucket{MinBytes: min})
+	return c
+}
+
+func (c *sizeDistributionCounter) add(size int64) {
+	if size < 0 {
+		size = 0
+	}
+	c.dist.Files++
+	c.dist.Bytes += size
+	c.pow2s[bits.Len64(uint64(size))]++
+
+	i := 0
+	for i < len(sizeBucketLimits) && size >= sizeBucketLimits[i] {
+		i++
+	}
+	c.dist.Buckets[i].Files++
+	c.dist.Buckets[i].Bytes += size
+}
+
+func (c *sizeDistributionCounter) result() SizeDistribution {
+	dist := c.dist
+	if dist.Files == 0 {
+		return dist
+	}
+	dist.AverageBytes = dist.Bytes / int64(dist.Files)
+
+	// Find the power of two range containing the median and interpolate
+	// linearly within it.
+	middle := float64(dist.Files) / 2
+	seen := 0
+	for n, count := range c.pow2s {
+		if float64(seen+count) < middle {
+			seen += count
+			continue
+		}
+		if n == 0 {
+			break
+		}
+		low := float64(int64(1) << (n - 1))
+		dist.MedianBytes = int64(low + low*(middle-float64(seen))/float64(count))
+		break
+	}
+	return dist
+}
+
+// SizeDistribution returns the distribution of the sizes of the files
+// we have in the given folder.
+func (m *model) SizeDistribution(folder string) (SizeDistribution, error) {
+	snap, err := m.DBSnapshot(folder)
+	if err != nil {
+		return SizeDistribution{}, err
+	}
+	defer snap.Release()
+
+	c := newSizeDistributionCounter()
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(f protocol.FileIntf) bool {
+		if f.IsDeleted() || f.IsInvalid() || f.FileType() != protocol.FileInfoTypeFile {
+			return true
+		}
+		c.add(f.FileSize())
+		return true
+	})
+	return c.result(), nil
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"testing"
+)
+
+func TestSizeDistribution(t *testing.T) {
+	c := newSizeDistributionCounter()
+	if dist := c.result(); dist.Files != 0 || dist.AverageBytes != 0 || dist.MedianBytes != 0 {
+		t.Errorf("Expected an empty distribution, got %+v", dist)
+	}
+
+	sizes := []int64{0, 10, 1000, 1024, 3000, 3000, 200 << 10, 50 << 20, 1 << 30}
+	var total int64
+	for _, size := range sizes {
+		c.add(size)
+		total += size
+	}
+	dist := c.result()
+
+	if dist.Files != len(sizes) || dist.Bytes != total {
+		t.Errorf("Expected %v files with %v bytes, got %v with %v", len(sizes), total, dist.Files, dist.Bytes)
+	}
+	if exp := total / int64(len(sizes)); dist.AverageBytes != exp {
+		t.Errorf("Expected average %v, got %v", exp, dist.AverageBytes)
+	}
+	// The actual median is 3000, which is in the range from 2048 to 4096.
+	if dist.MedianBytes < 2048 || dist.MedianBytes >= 4096 {
+		t.Errorf("Expected median between 2048 and 4096, got %v", dist.MedianBytes)
+	}
+
+	expFiles := []int{3, 3, 1, 1, 1}
+	if len(dist.Buckets) != len(expFiles) {
+		t.Fatalf("Expected %v buckets, got %v", len(expFiles), len(dist.Buckets))
+	}
+	for i, bucket := range dist.Buckets {
+		if bucket.Files != expFiles[i] {
+			t.Errorf("Expected %v files in bucket %+v, got %v", expFiles[i], bucket, bucket.Files)
+		}
+	}
+	if last := dist.Buckets[len(dist.Buckets)-1]; last.MinBytes != 100<<20 || last.MaxBytes != 0 {
+		t.Errorf("Expected last bucket to be unbounded from 100 MiB, got %+v", last)
+	}
+}
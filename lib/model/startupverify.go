@@ -0,0 +1,80 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/scanner"
+)
+
+// verifyOnStartup compares files in the database with those on disk and
+// schedules the ones that differ for a forced rescan, i.e. rehashing. By
+// default a random sample of VerifyOnStartupSample files is checked for
+// size and modification time only. If VerifyOnStartupFull is set all files
+// are checked and additionally hashed, to catch changes a scan wouldn't
+// notice. It returns the number of discrepancies found.
+func (f *folder) verifyOnStartup() (int, error) {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return 0, err
+	}
+
+	var names []string
+	seen := 0
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
+		if intf.IsDeleted() || intf.IsInvalid() || intf.FileType() != protocol.FileInfoTypeFile {
+			return true
+		}
+		seen++
+		if f.VerifyOnStartupFull || len(names) < f.VerifyOnStartupSample {
+			names = append(names, intf.FileName())
+		} else if i := rand.Intn(seen); i < len(names) {
+			// Reservoir sampling, every file has the same chance.
+			names[i] = intf.FileName()
+		}
+		return f.ctx.Err() == nil
+	})
+
+	discrepancies := 0
+	for _, name := range names {
+		if f.ctx.Err() != nil {
+			break
+		}
+		file, ok := snap.Get(protocol.LocalDeviceID, name)
+		if !ok {
+			continue
+		}
+		info, err := f.mtimefs.Lstat(name)
+		if err == nil && info.IsRegular() && info.Size() == file.Size && protocol.ModTimeEqual(info.ModTime(), file.ModTime(), f.modTimeWindow) {
+			if !f.VerifyOnStartupFull {
+				continue
+			}
+			var blocks []protocol.BlockInfo
+			blocks, err = scanner.HashFile(f.ctx, f.mtimefs, name, file.BlockSize(), nil, false)
+			if err == nil && file.BlocksEqual(protocol.FileInfo{Blocks: blocks}) {
+				continue
+			}
+		}
+		if err != nil && !fs.IsNotExist(err) {
+			l.Debugf("%v verifying %v: %v", f, name, err)
+			continue
+		}
+		l.Debugln(f, "verification on startup found", name, "to differ")
+		discrepancies++
+		f.ScheduleForceRescan(name)
+	}
+	snap.Release()
+
+	if discrepancies > 0 {
+		l.Infof("Folder %v: %d of %d verified files differ from the database, rescanning them", f.Description(), discrepancies, len(names))
+	} else {
+		l.Infof("Folder %v: Verified %d files against the database", f.Description(), len(names))
+	}
+	return discrepancies, f.ctx.Err()
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// TempFile is a temporary file of a partial transfer in a folder.
+type TempFile struct {
+	Name    string    `json:"name"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+	AgeS    int64     `json:"ageS"`
+	// Expired temporary files are older than the configured lifetime of
+	// temporary files and thus removed by the next scan.
+	Expired bool `json:"expired"`
+}
+
+// TempFiles returns the temporary files currently present in the folder.
+// The files are only looked at, so this is safe while pulling.
+func (f *folder) TempFiles() ([]TempFile, error) {
+	if err := f.getHealthErrorWithoutIgnores(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	lifetime := f.tempLifetime()
+	files := make([]TempFile, 0)
+	err := f.Filesystem().Walk(".", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			// Files may come and go while pulling.
+			if path == "." {
+				return err
+			}
+			return nil
+		}
+		// Temporary files are created in .stfolder, see fs.TempName.
+		if info.IsDir() && path != ".stfolder" && fs.IsInternal(path) {
+			return fs.SkipDir
+		}
+		if !info.IsRegular() || !fs.IsTemporary(path) {
+			return nil
+		}
+		files = append(files, TempFile{
+			Name:    path,
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+			AgeS:    int64(now.Sub(info.ModTime()).Seconds()),
+			Expired: info.ModTime().Add(lifetime).Before(now),
+		})
+		return nil
+	})
+	return files, err
+}
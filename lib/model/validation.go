@@ -0,0 +1,124 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// The number of post pull validation commands that may run at the same
+// time per folder.
+const maxConcurrentValidations = 4
+
+var errValidationFailed = errors.New("rejected by validation command")
+
+type validatedState struct {
+	state *sharedPullerState
+	err   error
+}
+
+// validationRoutine validates the pulled files received from in and sends
+// the results to out. There are maxConcurrentValidations of these.
+func (f *sendReceiveFolder) validationRoutine(in <-chan *sharedPullerState, out chan<- validatedState) {
+	for state := range in {
+		out <- validatedState{state, f.validatePulledFile(state.file, state.tempName)}
+	}
+}
+
+// validatePulledFile runs the post pull validation command on the
+// temporary file of the given pulled file. If the command fails, the file
+// is quarantined and an error returned.
+func (f *sendReceiveFolder) validatePulledFile(file protocol.FileInfo, tempName string) error {
+	command := f.PostPullValidationCommand
+	if runtime.GOOS == "windows" {
+		command = strings.ReplaceAll(command, `\`, `\\`)
+	}
+	words, err := shellquote.Split(command)
+	if err != nil {
+		return fmt.Errorf("validation command is invalid: %w", err)
+	}
+	if len(words) == 0 {
+		return errors.New("validation command is empty")
+	}
+
+	ffs := f.Filesystem()
+	context := map[string]string{
+		"%FOLDER_FILESYSTEM%": ffs.Type().String(),
+		"%FOLDER_PATH%":       ffs.URI(),
+		"%FILE_PATH%":         tempName,
+		"%FILE_NAME%":         file.Name,
+	}
+	for i, word := range words {
+		for key, val := range context {
+			word = strings.ReplaceAll(word, key, val)
+		}
+		words[i] = word
+	}
+
+	cmd := exec.CommandContext(f.ctx, words[0], words[1:]...)
+	// filter STGUIAUTH and STGUIAPIKEY from environment variables
+	for _, x := range os.Environ() {
+		if !strings.HasPrefix(x, "STGUIAUTH=") && !strings.HasPrefix(x, "STGUIAPIKEY=") {
+			cmd.Env = append(cmd.Env, x)
+		}
+	}
+	output, err := cmd.CombinedOutput()
+	l.Debugln(f, "validation command output for", file.Name, string(output))
+	if err == nil {
+		return nil
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || f.ctx.Err() != nil {
+		// The command couldn't be run, which says nothing about the file.
+		return fmt.Errorf("running validation command: %w", err)
+	}
+	return f.quarantine(file, tempName, err)
+}
+
+// quarantine moves the temporary file of a file that failed validation
+// into the quarantine directory and remembers its content as rejected.
+func (f *sendReceiveFolder) quarantine(file protocol.FileInfo, tempName string, reason error) error {
+	name := filepath.Join(f.quarantineDir(), file.Name)
+	l.Infof("Folder %v: Quarantining %v as %v: %v: %v", f.Description(), file.Name, name, errValidationFailed, reason)
+
+	err := f.mtimefs.MkdirAll(filepath.Dir(name), 0755)
+	if err == nil {
+		f.mtimefs.Remove(name)
+		err = f.mtimefs.Rename(tempName, name)
+	}
+	if err != nil {
+		l.Infof("Folder %v: Removing %v after failing to quarantine it: %v", f.Description(), file.Name, err)
+		f.mtimefs.Remove(tempName)
+		name = ""
+	}
+
+	f.rejectContent(file, errValidationFailed)
+	f.evLogger.Log(events.ItemRejected, map[string]string{
+		"folder":     f.folderID,
+		"item":       file.Name,
+		"reason":     fmt.Sprintf("%v: %v", errValidationFailed, reason),
+		"quarantine": name,
+	})
+	return fmt.Errorf("%w: %v", errValidationFailed, reason)
+}
+
+// quarantineDir returns the directory within the folder marker where files
+// that failed validation are kept.
+func (f *sendReceiveFolder) quarantineDir() string {
+	return filepath.Join(f.MarkerName, "quarantine")
+}
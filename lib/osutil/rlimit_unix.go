@@ -58,3 +58,13 @@ func MaximizeOpenFileLimit() (int, error) {
 
 	return int(lim.Cur), nil
 }
+
+// OpenFileLimit returns the current resource limit RLIMIT_NOFILE (number of
+// open file descriptors).
+func OpenFileLimit() (int, error) {
+	var lim syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &lim); err != nil {
+		return 0, err
+	}
+	return int(lim.Cur), nil
+}
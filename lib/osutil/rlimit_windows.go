@@ -15,3 +15,7 @@ import (
 func MaximizeOpenFileLimit() (int, error) {
 	return 0, errors.New("not relevant on Windows")
 }
+
+func OpenFileLimit() (int, error) {
+	return 0, errors.New("not relevant on Windows")
+}
@@ -9,17 +9,26 @@ package scanner
 import (
 	"context"
 	"errors"
+	"fmt"
+	"syscall"
 
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/sync"
 )
 
+// ErrTooManyOpenFiles is returned when a file can't be opened for hashing
+// as the limit on open files of the process or system is exhausted.
+var ErrTooManyOpenFiles = errors.New("too many open files")
+
 // HashFile hashes the files and returns a list of blocks representing the file.
 func HashFile(ctx context.Context, fs fs.Filesystem, path string, blockSize int, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
 	fd, err := fs.Open(path)
 	if err != nil {
 		l.Debugln("open:", err)
+		if errors.Is(err, syscall.EMFILE) || errors.Is(err, syscall.ENFILE) {
+			return nil, fmt.Errorf("%w (consider lowering the folder's maxScanOpenFiles): %v", ErrTooManyOpenFiles, err)
+		}
 		return nil, err
 	}
 	defer fd.Close()
@@ -62,15 +71,16 @@ func HashFile(ctx context.Context, fs fs.Filesystem, path string, blockSize int,
 // workers are used in parallel. The outbox will become closed when the inbox
 // is closed and all items handled.
 type parallelHasher struct {
-	fs      fs.Filesystem
-	outbox  chan<- ScanResult
-	inbox   <-chan protocol.FileInfo
-	counter Counter
-	done    chan<- struct{}
-	wg      sync.WaitGroup
+	fs        fs.Filesystem
+	outbox    chan<- ScanResult
+	inbox     <-chan protocol.FileInfo
+	counter   Counter
+	done      chan<- struct{}
+	wg        sync.WaitGroup
+	openFiles chan struct{} // nil if not limited beyond the number of workers
 }
 
-func newParallelHasher(ctx context.Context, fs fs.Filesystem, workers int, outbox chan<- ScanResult, inbox <-chan protocol.FileInfo, counter Counter, done chan<- struct{}) {
+func newParallelHasher(ctx context.Context, fs fs.Filesystem, workers, maxOpenFiles int, outbox chan<- ScanResult, inbox <-chan protocol.FileInfo, counter Counter, done chan<- struct{}) {
 	ph := &parallelHasher{
 		fs:      fs,
 		outbox:  outbox,
@@ -79,6 +89,9 @@ func newParallelHasher(ctx context.Context, fs fs.Filesystem, workers int, outbo
 		done:    done,
 		wg:      sync.NewWaitGroup(),
 	}
+	if maxOpenFiles > 0 && maxOpenFiles < workers {
+		ph.openFiles = make(chan struct{}, maxOpenFiles)
+	}
 
 	ph.wg.Add(workers)
 	for i := 0; i < workers; i++ {
@@ -102,7 +115,11 @@ func (ph *parallelHasher) hashFiles(ctx context.Context) {
 				panic("Bug. Asked to hash a directory or a deleted file.")
 			}
 
+			if !ph.takeOpenFile(ctx) {
+				return
+			}
 			blocks, err := HashFile(ctx, ph.fs, f.Name, f.BlockSize(), ph.counter, true)
+			ph.giveOpenFile()
 			if err != nil {
 				handleError(ctx, "hashing", f.Name, err, ph.outbox)
 				continue
@@ -132,6 +149,26 @@ func (ph *parallelHasher) hashFiles(ctx context.Context) {
 	}
 }
 
+// takeOpenFile blocks until another file may be opened, returning false if
+// the context is cancelled in the meantime.
+func (ph *parallelHasher) takeOpenFile(ctx context.Context) bool {
+	if ph.openFiles == nil {
+		return true
+	}
+	select {
+	case ph.openFiles <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (ph *parallelHasher) giveOpenFile() {
+	if ph.openFiles != nil {
+		<-ph.openFiles
+	}
+}
+
 func (ph *parallelHasher) closeWhenDone() {
 	ph.wg.Wait()
 	// In case the hasher aborted on context, wait for filesystem
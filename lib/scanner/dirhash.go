@@ -0,0 +1,135 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sha256"
+)
+
+// handleItemUnlessUnchanged handles the item like handleItem, unless it's
+// in a directory whose listing is the same as at the last walk. Such items
+// are unchanged, but directories are still descended into as something
+// below them may have changed.
+func (w *walker) handleItemUnlessUnchanged(ctx context.Context, path string, info fs.FileInfo, toHashChan chan<- protocol.FileInfo, finishedChan chan<- ScanResult, skip error) error {
+	if w.DirHashes == nil {
+		return w.handleItem(ctx, path, info, toHashChan, finishedChan, skip)
+	}
+	if _, ok := w.unchangedDirs[filepath.Dir(path)]; !ok {
+		if err := w.handleItem(ctx, path, info, toHashChan, finishedChan, skip); err != nil {
+			return err
+		}
+	}
+	if !info.IsDir() || info.IsSymlink() || w.isSub(path) {
+		return nil
+	}
+
+	hash, err := w.dirListingHash(path)
+	if err != nil {
+		// Let the walk deal with whatever is wrong in there.
+		l.Debugln(w, "hashing listing of", path, err)
+		return nil
+	}
+	if old, ok := w.DirHashes.DirHash(path); ok && bytes.Equal(old, hash) {
+		l.Debugln("unchanged dir:", path)
+		if w.unchangedDirs == nil {
+			w.unchangedDirs = make(map[string]struct{})
+		}
+		w.unchangedDirs[path] = struct{}{}
+		return nil
+	}
+	if w.dirHashes == nil {
+		w.dirHashes = make(map[string][]byte)
+	}
+	w.dirHashes[path] = hash
+	return nil
+}
+
+// isSub returns true if the path is one of the explicitly requested
+// subdirectories, which are always walked.
+func (w *walker) isSub(path string) bool {
+	for _, sub := range w.Subs {
+		if filepath.Clean(sub) == path {
+			return true
+		}
+	}
+	return false
+}
+
+// dirListingHash hashes the names, sizes, modification times and modes of
+// the children of the given directory. The ignore patterns and whether
+// hidden items are ignored or mount points skipped are part of the hash, as
+// changing them may reveal previously skipped items.
+func (w *walker) dirListingHash(path string) ([]byte, error) {
+	names, err := w.Filesystem.DirNames(path)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00", w.Matcher.Hash())
+	if w.IgnoreHidden {
+		fmt.Fprint(h, "ignoreHidden\x00")
+	}
+	if w.OneFilesystem {
+		fmt.Fprint(h, "oneFilesystem\x00")
+	}
+	for _, name := range names {
+		info, err := w.Filesystem.Lstat(filepath.Join(path, name))
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00%d\x00%d\x00", name, info.Size(), info.ModTime().UnixNano(), info.Mode())
+	}
+	return h.Sum(nil), nil
+}
+
+// storeDirHashesWhenDone passes on the results and, once the walk
+// completed, passes the listing hashes of the walked directories to the
+// store, before closing the results. Hashes of
+// directories containing items that failed to scan aren't stored, so the
+// next walk will try again.
+func (w *walker) storeDirHashesWhenDone(ctx context.Context, finishedChan chan ScanResult) chan ScanResult {
+	if w.DirHashes == nil {
+		return finishedChan
+	}
+
+	resultChan := make(chan ScanResult)
+	go func() {
+		defer close(resultChan)
+
+		var failed []string
+		for res := range finishedChan {
+			if res.Err != nil {
+				failed = append(failed, res.Path)
+			}
+			resultChan <- res
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+	nextDir:
+		for name, hash := range w.dirHashes {
+			for _, path := range failed {
+				if path == name || fs.IsParent(path, name) {
+					continue nextDir
+				}
+			}
+			w.DirHashes.SetDirHash(name, hash)
+		}
+	}()
+	return resultChan
+}
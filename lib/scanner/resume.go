@@ -0,0 +1,93 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package scanner
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// walkOrderLess returns whether a is walked before b. Directories are
+// walked in lexical order, before their contents, thus that's comparing
+// the names with the path separator sorting before anything else.
+func walkOrderLess(a, b string) bool {
+	sep := string(fs.PathSeparator)
+	return strings.ReplaceAll(a, sep, "\x00") < strings.ReplaceAll(b, sep, "\x00")
+}
+
+// sortWalkOrder sorts the paths in the order they are walked.
+func sortWalkOrder(paths []string) {
+	sort.Slice(paths, func(i, j int) bool {
+		return walkOrderLess(paths[i], paths[j])
+	})
+}
+
+// walkedBefore returns whether the item was walked already by the walk
+// that is resumed. The parent directories of ResumeAt are walked again to
+// get to it, and resuming at the root walks everything.
+func (w *walker) walkedBefore(path string) bool {
+	if w.ResumeAt == "" || w.ResumeAt == "." || path == "." {
+		return false
+	}
+	return walkOrderLess(path, w.ResumeAt) && !fs.IsParent(w.ResumeAt, path)
+}
+
+// sendingOn records an item whose result is about to be sent on, to be
+// able to tell where to resume if it doesn't get there.
+func (w *walker) sendingOn(path string) {
+	if w.Stopped != nil {
+		w.sentOn = append(w.sentOn, path)
+	}
+}
+
+// stop records the first item that wasn't walked due to cancellation.
+func (w *walker) stop(path string) {
+	if w.stoppedAt == "" {
+		w.stoppedAt = path
+	}
+}
+
+// reportStopWhenDone passes on the results and, if the walk was cancelled
+// before all items were walked and their results passed on, calls Stopped
+// with the first item that didn't make it.
+func (w *walker) reportStopWhenDone(ctx context.Context, finishedChan chan ScanResult) chan ScanResult {
+	if w.Stopped == nil {
+		return finishedChan
+	}
+
+	resultChan := make(chan ScanResult)
+	go func() {
+		defer close(resultChan)
+
+		passedOn := make(map[string]struct{})
+		for res := range finishedChan {
+			resultChan <- res
+			if res.Err == nil {
+				passedOn[res.File.Name] = struct{}{}
+			}
+		}
+
+		if ctx.Err() == nil {
+			return
+		}
+		resumeAt := w.stoppedAt
+		for _, path := range w.sentOn {
+			if _, ok := passedOn[path]; !ok {
+				resumeAt = path
+				break
+			}
+		}
+		if resumeAt != "" {
+			l.Debugln(w, "stopped, resume at", resumeAt)
+			w.Stopped(resumeAt)
+		}
+	}()
+	return resultChan
+}
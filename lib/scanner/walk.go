@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -35,8 +36,21 @@ type Config struct {
 	Matcher *ignore.Matcher
 	// Number of hours to keep temporary files for
 	TempLifetime time.Duration
+	// If KeepTemporaries is true, temporary files are never removed,
+	// regardless of TempLifetime.
+	KeepTemporaries bool
+	// Names of items in the folder root that are never walked, thus not
+	// even stat'd, such as the folder marker. Stale temporary files in the
+	// marker directory are still removed on a full walk, by listing it
+	// directly.
+	Skip []string
 	// If CurrentFiler is not nil, it is queried for the current file before rescanning.
 	CurrentFiler CurrentFiler
+	// If DirHashes is not nil, the items in directories whose listing
+	// didn't change since the last walk are taken to be unchanged, without
+	// checking them against CurrentFiler. Subdirectories are still walked.
+	// Changes that leave a listing alone go unnoticed.
+	DirHashes DirHashStore
 	// The Filesystem provides an abstraction on top of the actual filesystem.
 	Filesystem fs.Filesystem
 	// If IgnorePerms is true, changes to permission bits will not be
@@ -48,6 +62,12 @@ type Config struct {
 	AutoNormalize bool
 	// Number of routines to use for hashing
 	Hashers int
+	// If HashNewestFirst is true, all files to hash are collected first and
+	// then hashed starting with the most recently modified one.
+	HashNewestFirst bool
+	// Maximum number of files open at the same time while hashing, or no
+	// limit beyond the number of hashers if zero.
+	MaxOpenFiles int
 	// Our vector clock id
 	ShortID protocol.ShortID
 	// Optional progress tick interval which defines how often FolderScanProgress
@@ -57,8 +77,49 @@ type Config struct {
 	LocalFlags uint32
 	// Modification time is to be considered unchanged if the difference is lower.
 	ModTimeWindow time.Duration
+	// If IgnoreSymlinks is true, symlinks are skipped. If ResolveSymlinks
+	// is true, symlinks to regular files are scanned as the file they
+	// point to and all others are skipped. Those pointing outside the
+	// folder or in a cycle are reported as errors.
+	IgnoreSymlinks  bool
+	ResolveSymlinks bool
+	// If SkippedSymlink is not nil, it is called with the path of each
+	// symlink skipped due to the above. It may be called concurrently.
+	SkippedSymlink func(path string)
+	// Changed regular files modified less than MinFileAge ago are skipped,
+	// as they are likely still being written. If SkippedRecentFile is not
+	// nil, it is called with the path and modification time of each.
+	MinFileAge        time.Duration
+	SkippedRecentFile func(path string, modTime time.Time)
 	// Event logger to which the scan progress events are sent
 	EventLogger events.Logger
+	// If HashTotal is not nil, it is called with the number of files and
+	// bytes to hash once walking is done, i.e. possibly after hashing
+	// started.
+	HashTotal func(files int, bytes int64)
+	// If Restrict is not nil, only items for which it returns true are
+	// scanned. Directories are descended into regardless, and new ones are
+	// scanned too if an item within them is.
+	Restrict func(path string) bool
+	// If IgnoreHidden is true, hidden items as per fs.IsHidden are
+	// skipped like ignored ones.
+	IgnoreHidden bool
+	// If OneFilesystem is true, directories on another filesystem than the
+	// folder root, i.e. mount points, are skipped. If SkippedMountPoint is
+	// not nil, it is called with the path of each, from the walking
+	// routine.
+	OneFilesystem     bool
+	SkippedMountPoint func(path string)
+	// If ResumeAt is not empty, the items walked before it are skipped,
+	// continuing an earlier walk that stopped there. Directories are
+	// walked in lexical order.
+	ResumeAt string
+	// If Stopped is not nil, it is called when the walk is cancelled
+	// before all items were walked and their results passed on, with the
+	// item to resume at. It's called before the results are closed. Files
+	// are then hashed while walking, without progress events and
+	// HashNewestFirst.
+	Stopped func(resumeAt string)
 }
 
 type CurrentFiler interface {
@@ -66,6 +127,15 @@ type CurrentFiler interface {
 	CurrentFile(name string) (protocol.FileInfo, bool)
 }
 
+// A DirHashStore keeps the listing hashes of directories between walks.
+// The hashes are set once the walk completed, before the results channel
+// is closed. A store should only keep them for the next walk once the
+// results were applied, or the items they cover would be skipped.
+type DirHashStore interface {
+	DirHash(name string) ([]byte, bool)
+	SetDirHash(name string, hash []byte)
+}
+
 type ScanResult struct {
 	File protocol.FileInfo
 	Err  error
@@ -81,7 +151,7 @@ func WalkWithoutHashing(ctx context.Context, cfg Config) chan ScanResult {
 }
 
 func newWalker(cfg Config) *walker {
-	w := &walker{cfg}
+	w := &walker{Config: cfg}
 
 	if w.CurrentFiler == nil {
 		w.CurrentFiler = noCurrentFiler{}
@@ -96,14 +166,51 @@ func newWalker(cfg Config) *walker {
 	return w
 }
 
+const (
+	// Empty temporary files are removed after this long, unless the
+	// configured TempLifetime is shorter.
+	emptyTempLifetime = 10 * time.Minute
+	// Temporary files are created in the folder marker directory, see
+	// fs.TempName.
+	tempDir = ".stfolder"
+)
+
 var (
 	errUTF8Invalid       = errors.New("item is not in UTF8 encoding")
 	errUTF8Normalization = errors.New("item is not in the correct UTF8 normalization form")
 	errUTF8Conflict      = errors.New("item has UTF8 encoding conflict with another item")
+	errSymlinkOutside    = errors.New("symlink points outside the folder")
+	errSymlinkCycle      = errors.New("symlink points to itself")
 )
 
 type walker struct {
 	Config
+
+	// Listing hashes of the directories walked, to be stored once done.
+	// Only accessed by the walking routine until the results are closed.
+	dirHashes map[string][]byte
+	// Directories whose listing didn't change since the last walk, only
+	// accessed by the walking routine.
+	unchangedDirs map[string]struct{}
+
+	// Files and bytes sent to be hashed, only accessed by the walking
+	// routine.
+	toHashFiles int
+	toHashBytes int64
+
+	// Filesystem of the folder root if OneFilesystem is set and it could
+	// be determined.
+	rootFilesystemID string
+
+	// If Stopped is set, the items whose results were sent on in walk
+	// order and the first item not walked due to cancellation. Only
+	// accessed by the walking routine until the results are closed.
+	sentOn    []string
+	stoppedAt string
+
+	// If Restrict is set, the new directories not scanned due to it, to
+	// scan them if they contain an item that is.
+	restrictedDirs map[string]fs.FileInfo
 }
 
 // Walk returns the list of files found in the local folder by scanning the
@@ -113,16 +220,23 @@ func (w *walker) walk(ctx context.Context) chan ScanResult {
 
 	toHashChan := make(chan protocol.FileInfo)
 	finishedChan := make(chan ScanResult)
+	resultChan := w.reportStopWhenDone(ctx, w.storeDirHashesWhenDone(ctx, finishedChan))
 
 	// A routine which walks the filesystem tree, and sends files which have
 	// been modified to the counter routine.
 	go w.scan(ctx, toHashChan, finishedChan)
 
 	// We're not required to emit scan progress events, just kick off hashers,
-	// and feed inputs directly from the walker.
-	if w.ProgressTickIntervalS < 0 {
-		newParallelHasher(ctx, w.Filesystem, w.Hashers, finishedChan, toHashChan, nil, nil)
-		return finishedChan
+	// and feed inputs directly from the walker. That's also the case if the
+	// walk may be resumed, as nothing would be hashed if it's stopped while
+	// walking otherwise.
+	if w.ProgressTickIntervalS < 0 || w.Stopped != nil {
+		var inbox <-chan protocol.FileInfo = toHashChan
+		if w.HashNewestFirst && w.Stopped == nil {
+			inbox = newestFirst(ctx, toHashChan)
+		}
+		newParallelHasher(ctx, w.Filesystem, w.Hashers, w.MaxOpenFiles, finishedChan, inbox, nil, nil)
+		return resultChan
 	}
 
 	// Defaults to every 2 seconds.
@@ -147,12 +261,15 @@ func (w *walker) walk(ctx context.Context) chan ScanResult {
 			filesToHash = append(filesToHash, file)
 			total += file.Size
 		}
+		if w.HashNewestFirst {
+			sortNewestFirst(filesToHash)
+		}
 
 		realToHashChan := make(chan protocol.FileInfo)
 		done := make(chan struct{})
 		progress := newByteCounter()
 
-		newParallelHasher(ctx, w.Filesystem, w.Hashers, finishedChan, realToHashChan, progress, done)
+		newParallelHasher(ctx, w.Filesystem, w.Hashers, w.MaxOpenFiles, finishedChan, realToHashChan, progress, done)
 
 		// A routine which actually emits the FolderScanProgress events
 		// every w.ProgressTicker ticks, until the hasher routines terminate.
@@ -194,7 +311,35 @@ func (w *walker) walk(ctx context.Context) chan ScanResult {
 		close(realToHashChan)
 	}()
 
-	return finishedChan
+	return resultChan
+}
+
+// newestFirst passes on all files from in once it is closed, starting with
+// the most recently modified one.
+func newestFirst(ctx context.Context, in <-chan protocol.FileInfo) <-chan protocol.FileInfo {
+	out := make(chan protocol.FileInfo)
+	go func() {
+		defer close(out)
+		var files []protocol.FileInfo
+		for file := range in {
+			files = append(files, file)
+		}
+		sortNewestFirst(files)
+		for _, file := range files {
+			select {
+			case out <- file:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func sortNewestFirst(files []protocol.FileInfo) {
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].ModTime().After(files[j].ModTime())
+	})
 }
 
 func (w *walker) walkWithoutHashing(ctx context.Context) chan ScanResult {
@@ -202,6 +347,7 @@ func (w *walker) walkWithoutHashing(ctx context.Context) chan ScanResult {
 
 	toHashChan := make(chan protocol.FileInfo)
 	finishedChan := make(chan ScanResult)
+	resultChan := w.reportStopWhenDone(ctx, w.storeDirHashesWhenDone(ctx, finishedChan))
 
 	// A routine which walks the filesystem tree, and sends files which have
 	// been modified to the counter routine.
@@ -214,22 +360,49 @@ func (w *walker) walkWithoutHashing(ctx context.Context) chan ScanResult {
 		close(finishedChan)
 	}()
 
-	return finishedChan
+	return resultChan
 }
 
 func (w *walker) scan(ctx context.Context, toHashChan chan<- protocol.FileInfo, finishedChan chan<- ScanResult) {
 	hashFiles := w.walkAndHashFiles(ctx, toHashChan, finishedChan)
+	if w.OneFilesystem {
+		if id, err := fs.FilesystemID(w.Filesystem, "."); err != nil {
+			l.Debugln("Not skipping other filesystems:", err)
+		} else {
+			w.rootFilesystemID = id
+		}
+	}
 	if len(w.Subs) == 0 {
-		w.Filesystem.Walk(".", hashFiles)
+		w.walkRoot(ctx, hashFiles)
+		if w.skipped(tempDir) && !w.KeepTemporaries {
+			w.removeStaleTemporaries(tempDir, time.Now())
+		}
 	} else {
-		for _, sub := range w.Subs {
+		subs := append([]string(nil), w.Subs...)
+		sortWalkOrder(subs)
+		for _, sub := range subs {
+			if w.walkedBefore(sub) {
+				continue
+			}
+			if w.skipped(sub) {
+				l.Debugf("Skip walking %v as it is excluded", sub)
+				continue
+			}
 			if err := osutil.TraversesSymlink(w.Filesystem, filepath.Dir(sub)); err != nil {
 				l.Debugf("Skip walking %v as it is below a symlink", sub)
 				continue
 			}
+			if mountPoint, ok := w.mountPointAbove(sub); ok {
+				l.Debugf("Skip walking %v as it is below a mount point", sub)
+				w.skipMountPoint(mountPoint)
+				continue
+			}
 			w.Filesystem.Walk(sub, hashFiles)
 		}
 	}
+	if w.HashTotal != nil {
+		w.HashTotal(w.toHashFiles, w.toHashBytes)
+	}
 	close(toHashChan)
 }
 
@@ -240,6 +413,7 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 	return func(path string, info fs.FileInfo, err error) error {
 		select {
 		case <-ctx.Done():
+			w.stop(path)
 			return ctx.Err()
 		default:
 		}
@@ -252,6 +426,10 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 			skip = fs.SkipDir
 		}
 
+		if w.walkedBefore(path) {
+			return skip
+		}
+
 		if !utf8.ValidString(path) {
 			handleError(ctx, "scan", path, errUTF8Invalid, finishedChan)
 			return skip
@@ -259,22 +437,29 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 
 		if fs.IsTemporary(path) {
 			l.Debugln("temporary:", path, "err:", err)
-			if err == nil && info.IsRegular() && info.ModTime().Add(w.TempLifetime).Before(now) {
-				w.Filesystem.Remove(path)
-				l.Debugln("removing temporary:", path, info.ModTime())
+			if err == nil {
+				w.removeStaleTemporary(path, info, now)
 			}
 			return nil
 		}
 
 		if fs.IsInternal(path) {
 			l.Debugln("ignored (internal):", path)
+			if path == tempDir && err == nil && info.IsDir() && !w.KeepTemporaries {
+				w.removeStaleTemporaries(path, now)
+			}
+			return skip
+		}
+
+		if w.IgnoreHidden && w.isHidden(path) {
+			l.Debugln("ignored (hidden):", path)
 			return skip
 		}
 
 		if w.Matcher.Match(path).IsIgnored() {
 			l.Debugln("ignored (patterns):", path)
 			// Only descend if matcher says so and the current file is not a symlink.
-			if err != nil || w.Matcher.SkipIgnoredDirs() || info.IsSymlink() {
+			if err != nil || !info.IsDir() || info.IsSymlink() || w.Matcher.CanSkipDir(path) {
 				return skip
 			}
 			// If the parent wasn't ignored already, set this path as the "highest" ignored parent
@@ -293,9 +478,14 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 			return nil
 		}
 
+		if info.IsDir() && !info.IsSymlink() && w.onOtherFilesystem(path) {
+			w.skipMountPoint(path)
+			return fs.SkipDir
+		}
+
 		if ignoredParent == "" {
 			// parent isn't ignored, nothing special
-			return w.handleItem(ctx, path, info, toHashChan, finishedChan, skip)
+			return w.handleItemUnlessUnchanged(ctx, path, info, toHashChan, finishedChan, skip)
 		}
 
 		// Part of current path below the ignored (potential) parent
@@ -304,7 +494,7 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 		// ignored path isn't actually a parent of the current path
 		if rel == path {
 			ignoredParent = ""
-			return w.handleItem(ctx, path, info, toHashChan, finishedChan, skip)
+			return w.handleItemUnlessUnchanged(ctx, path, info, toHashChan, finishedChan, skip)
 		}
 
 		// The previously ignored parent directories of the current, not
@@ -329,7 +519,142 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 	}
 }
 
+// walkRoot walks the entire folder like Walk(".", walkFn) would, except for
+// the items in Skip, which aren't even stat'd.
+func (w *walker) walkRoot(ctx context.Context, walkFn fs.WalkFunc) {
+	if len(w.Skip) == 0 {
+		w.Filesystem.Walk(".", walkFn)
+		return
+	}
+	names, err := w.Filesystem.DirNames(".")
+	if err != nil {
+		walkFn(".", nil, err)
+		return
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if ctx.Err() != nil {
+			w.stop(name)
+			return
+		}
+		if w.skipped(name) {
+			l.Debugln("skipped:", name)
+			continue
+		}
+		if w.walkedBefore(name) {
+			continue
+		}
+		w.Filesystem.Walk(name, walkFn)
+	}
+}
+
+// isHidden is like fs.IsHidden, but only checks the item itself unless the
+// walk starts there, as hidden directories aren't descended into.
+func (w *walker) isHidden(path string) bool {
+	if w.isSub(path) {
+		return fs.IsHidden(w.Filesystem, path)
+	}
+	return fs.IsHiddenItem(w.Filesystem, path)
+}
+
+// skipped returns true if path is or is below one of the items in Skip.
+func (w *walker) skipped(path string) bool {
+	for _, skip := range w.Skip {
+		if path == skip || fs.IsParent(path, skip) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeStaleTemporaries removes the stale temporary files in dir, which is
+// otherwise skipped as internal.
+func (w *walker) removeStaleTemporaries(dir string, now time.Time) {
+	names, err := w.Filesystem.DirNames(dir)
+	if err != nil {
+		l.Debugln("listing temporaries:", dir, err)
+		return
+	}
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if !fs.IsTemporary(path) {
+			continue
+		}
+		if info, err := w.Filesystem.Lstat(path); err == nil {
+			w.removeStaleTemporary(path, info, now)
+		}
+	}
+}
+
+// removeStaleTemporary removes the given temporary file if it is older than
+// TempLifetime. Empty ones don't hold anything worth reusing, but are kept
+// for a short while as they are created empty when pulling starts.
+func (w *walker) removeStaleTemporary(path string, info fs.FileInfo, now time.Time) {
+	if w.KeepTemporaries || !info.IsRegular() {
+		return
+	}
+	lifetime := w.TempLifetime
+	if info.Size() == 0 && lifetime > emptyTempLifetime {
+		lifetime = emptyTempLifetime
+	}
+	if info.ModTime().Add(lifetime).Before(now) {
+		w.Filesystem.Remove(path)
+		l.Debugln("removing temporary:", path, info.ModTime())
+	}
+}
+
+// restrictNewDir keeps the directory not scanned due to Restrict, if it's
+// not known yet, as an item within it may need it.
+func (w *walker) restrictNewDir(path string, info fs.FileInfo) {
+	if !info.IsDir() || info.IsSymlink() {
+		return
+	}
+	if _, ok := w.CurrentFiler.CurrentFile(path); ok {
+		return
+	}
+	if w.restrictedDirs == nil {
+		w.restrictedDirs = make(map[string]fs.FileInfo)
+	}
+	w.restrictedDirs[path] = info
+}
+
+// handleRestrictedParents scans the new parent directories of the item that
+// weren't scanned due to Restrict, such that it isn't added without them.
+func (w *walker) handleRestrictedParents(ctx context.Context, path string, toHashChan chan<- protocol.FileInfo, finishedChan chan<- ScanResult) error {
+	if len(w.restrictedDirs) == 0 {
+		return nil
+	}
+	parent := ""
+	for _, name := range fs.PathComponents(filepath.Dir(path)) {
+		parent = filepath.Join(parent, name)
+		info, ok := w.restrictedDirs[parent]
+		if !ok {
+			continue
+		}
+		delete(w.restrictedDirs, parent)
+		if err := w.handleUnrestrictedItem(ctx, parent, info, toHashChan, finishedChan, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (w *walker) handleItem(ctx context.Context, path string, info fs.FileInfo, toHashChan chan<- protocol.FileInfo, finishedChan chan<- ScanResult, skip error) error {
+	if w.Restrict != nil {
+		if !w.Restrict(path) {
+			l.Debugln("restricted:", path)
+			w.restrictNewDir(path, info)
+			return nil
+		}
+		if err := w.handleRestrictedParents(ctx, path, toHashChan, finishedChan); err != nil {
+			return err
+		}
+	}
+	return w.handleUnrestrictedItem(ctx, path, info, toHashChan, finishedChan, skip)
+}
+
+// handleUnrestrictedItem scans the item regardless of Restrict.
+func (w *walker) handleUnrestrictedItem(ctx context.Context, path string, info fs.FileInfo, toHashChan chan<- protocol.FileInfo, finishedChan chan<- ScanResult, skip error) error {
 	oldPath := path
 	path, err := w.normalizePath(path, info)
 	if err != nil {
@@ -338,6 +663,22 @@ func (w *walker) handleItem(ctx context.Context, path string, info fs.FileInfo,
 	}
 
 	switch {
+	case info.IsSymlink() && w.ResolveSymlinks:
+		target, err := w.resolveSymlink(path)
+		switch {
+		case err == nil && target.IsRegular():
+			return w.walkRegular(ctx, path, target, toHashChan)
+		case err != nil && !fs.IsNotExist(err):
+			handleError(ctx, "resolving symlink", path, err, finishedChan)
+		default:
+			w.skipSymlink(path)
+		}
+		return nil
+
+	case info.IsSymlink() && w.IgnoreSymlinks:
+		w.skipSymlink(path)
+		return nil
+
 	case info.IsSymlink():
 		if err := w.walkSymlink(ctx, path, info, finishedChan); err != nil {
 			return err
@@ -397,13 +738,26 @@ func (w *walker) walkRegular(ctx context.Context, relPath string, info fs.FileIn
 		l.Debugln("rescan:", curFile, info.ModTime().Unix(), info.Mode()&fs.ModePerm)
 	}
 
+	// Modification times in the future are not considered recent, such
+	// that files aren't skipped forever due to clock skew.
+	if age := time.Since(info.ModTime()); age >= 0 && age < w.MinFileAge {
+		l.Debugln("skipping recently modified:", relPath, age)
+		if w.SkippedRecentFile != nil {
+			w.SkippedRecentFile(relPath, info.ModTime())
+		}
+		return nil
+	}
+
 	l.Debugln("to hash:", relPath, f)
 
+	w.sendingOn(relPath)
 	select {
 	case toHashChan <- f:
 	case <-ctx.Done():
 		return ctx.Err()
 	}
+	w.toHashFiles++
+	w.toHashBytes += f.Size
 
 	return nil
 }
@@ -431,6 +785,7 @@ func (w *walker) walkDir(ctx context.Context, relPath string, info fs.FileInfo,
 
 	l.Debugln("dir:", relPath, f)
 
+	w.sendingOn(relPath)
 	select {
 	case finishedChan <- ScanResult{File: f}:
 	case <-ctx.Done():
@@ -440,6 +795,92 @@ func (w *walker) walkDir(ctx context.Context, relPath string, info fs.FileInfo,
 	return nil
 }
 
+// resolveSymlink returns the info of the item the symlink at path points
+// to, following further symlinks. It's an error if any of them points
+// outside the folder, or back to one of the others.
+func (w *walker) resolveSymlink(path string) (fs.FileInfo, error) {
+	seen := make(map[string]struct{})
+	for {
+		if _, ok := seen[path]; ok {
+			return nil, errSymlinkCycle
+		}
+		seen[path] = struct{}{}
+
+		target, err := w.Filesystem.ReadSymlink(path)
+		if err != nil {
+			return nil, err
+		}
+		if filepath.IsAbs(target) {
+			if target, err = filepath.Rel(w.Filesystem.URI(), target); err != nil {
+				return nil, errSymlinkOutside
+			}
+		} else {
+			target = filepath.Join(filepath.Dir(path), target)
+		}
+		if target == ".." || strings.HasPrefix(target, ".."+string(fs.PathSeparator)) {
+			return nil, errSymlinkOutside
+		}
+		// A symlinked directory on the way could lead anywhere.
+		if err := osutil.TraversesSymlink(w.Filesystem, filepath.Dir(target)); err != nil {
+			return nil, errSymlinkOutside
+		}
+
+		info, err := w.Filesystem.Lstat(target)
+		if err != nil || !info.IsSymlink() {
+			return info, err
+		}
+		path = target
+	}
+}
+
+func (w *walker) skipSymlink(path string) {
+	l.Debugln("skipping symlink:", path)
+	if w.SkippedSymlink != nil {
+		w.SkippedSymlink(path)
+	}
+}
+
+// onOtherFilesystem returns whether the given directory is on another
+// filesystem than the folder root, if that's to be checked. Directories
+// whose filesystem can't be determined are walked as usual.
+func (w *walker) onOtherFilesystem(path string) bool {
+	if w.rootFilesystemID == "" {
+		return false
+	}
+	id, err := fs.FilesystemID(w.Filesystem, path)
+	if err != nil {
+		l.Debugln("Failed to determine filesystem of", path, err)
+		return false
+	}
+	return id != w.rootFilesystemID
+}
+
+// mountPointAbove returns the topmost parent directory of the given path
+// that is on another filesystem than the folder root, if any.
+func (w *walker) mountPointAbove(path string) (string, bool) {
+	if w.rootFilesystemID == "" {
+		return "", false
+	}
+	dir := filepath.Dir(path)
+	if dir == "." {
+		return "", false
+	}
+	parts := fs.PathComponents(dir)
+	for i := range parts {
+		if parent := filepath.Join(parts[:i+1]...); w.onOtherFilesystem(parent) {
+			return parent, true
+		}
+	}
+	return "", false
+}
+
+func (w *walker) skipMountPoint(path string) {
+	l.Debugln("skipping mount point:", path)
+	if w.SkippedMountPoint != nil {
+		w.SkippedMountPoint(path)
+	}
+}
+
 // walkSymlink returns nil or an error, if the error is of the nature that
 // it should stop the entire walk.
 func (w *walker) walkSymlink(ctx context.Context, relPath string, info fs.FileInfo, finishedChan chan<- ScanResult) error {
@@ -475,6 +916,7 @@ func (w *walker) walkSymlink(ctx context.Context, relPath string, info fs.FileIn
 
 	l.Debugln("symlink changedb:", relPath, f)
 
+	w.sendingOn(relPath)
 	select {
 	case finishedChan <- ScanResult{File: f}:
 	case <-ctx.Done():
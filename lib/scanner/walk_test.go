@@ -16,11 +16,14 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	rdebug "runtime/debug"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/d4l3k/messagediff"
 	"github.com/syncthing/syncthing/lib/events"
@@ -291,6 +294,156 @@ func TestWalkSymlinkUnix(t *testing.T) {
 	}
 }
 
+func TestWalkSymlinkPolicy(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping unsupported symlink test")
+	}
+
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	if err := ioutil.WriteFile(filepath.Join(tmp, "target"), []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(tmp, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("target", filepath.Join(tmp, "filelink")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("dir", filepath.Join(tmp, "dirlink")); err != nil {
+		t.Fatal(err)
+	}
+	testFs := fs.NewFilesystem(testFsType, tmp)
+
+	walk := func(ignore, resolve bool) ([]protocol.FileInfo, []string) {
+		cfg, cancel := testConfig()
+		defer cancel()
+		cfg.Filesystem = testFs
+		cfg.IgnoreSymlinks = ignore
+		cfg.ResolveSymlinks = resolve
+		var mut sync.Mutex
+		var skipped []string
+		cfg.SkippedSymlink = func(path string) {
+			mut.Lock()
+			skipped = append(skipped, path)
+			mut.Unlock()
+		}
+		var files []protocol.FileInfo
+		for res := range Walk(context.TODO(), cfg) {
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			files = append(files, res.File)
+		}
+		sort.Sort(fileList(files))
+		sort.Strings(skipped)
+		return files, skipped
+	}
+
+	files, skipped := walk(true, false)
+	if len(files) != 2 || files[0].Name != "dir" || files[1].Name != "target" {
+		t.Errorf("Expected only dir and target when ignoring symlinks, got %v", files)
+	}
+	if !reflect.DeepEqual(skipped, []string{"dirlink", "filelink"}) {
+		t.Errorf("Expected both symlinks to be skipped, got %v", skipped)
+	}
+
+	files, skipped = walk(false, true)
+	if len(files) != 3 || files[1].Name != "filelink" || files[1].Type != protocol.FileInfoTypeFile || !files[1].BlocksEqual(files[2]) || files[1].Size != int64(len("content")) {
+		t.Errorf("Expected filelink to be scanned as a copy of target, got %v", files)
+	}
+	if !reflect.DeepEqual(skipped, []string{"dirlink"}) {
+		t.Errorf("Expected the directory symlink to be skipped, got %v", skipped)
+	}
+}
+
+func TestWalkOneFilesystem(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("skipping test relying on /proc being mounted")
+	}
+	testFs := fs.NewFilesystem(testFsType, "/")
+	root, err := fs.FilesystemID(testFs, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proc, err := fs.FilesystemID(testFs, "proc"); err != nil || proc == root {
+		t.Skip("/proc isn't a separate filesystem")
+	}
+
+	cfg, cancel := testConfig()
+	defer cancel()
+	cfg.Filesystem = testFs
+	cfg.Subs = []string{"proc", filepath.Join("proc", "sys", "kernel")}
+	cfg.OneFilesystem = true
+	var skipped []string
+	cfg.SkippedMountPoint = func(path string) {
+		skipped = append(skipped, path)
+	}
+	for res := range Walk(context.TODO(), cfg) {
+		t.Errorf("Expected nothing to be scanned on another filesystem, got %v", res)
+	}
+	if !reflect.DeepEqual(skipped, []string{"proc", "proc"}) {
+		t.Errorf("Expected /proc to be skipped for both subs, got %v", skipped)
+	}
+}
+
+func TestWalkResolveSymlinkGuards(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping unsupported symlink test")
+	}
+
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	outside := filepath.Join(tmp, "outside")
+	root := filepath.Join(tmp, "root")
+	if err := ioutil.WriteFile(outside, []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(root, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for link, target := range map[string]string{
+		"relative": "../outside",
+		"absolute": outside,
+		"chained":  "relative",
+		"cycle1":   "cycle2",
+		"cycle2":   "cycle1",
+	} {
+		if err := os.Symlink(target, filepath.Join(root, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg, cancel := testConfig()
+	defer cancel()
+	cfg.Filesystem = fs.NewFilesystem(testFsType, root)
+	cfg.ResolveSymlinks = true
+	errs := make(map[string]error)
+	for res := range Walk(context.TODO(), cfg) {
+		if res.Err == nil {
+			t.Errorf("Expected %v not to be scanned", res.File.Name)
+			continue
+		}
+		errs[res.Path] = res.Err
+	}
+	for _, link := range []string{"relative", "absolute", "chained"} {
+		if !errors.Is(errs[link], errSymlinkOutside) {
+			t.Errorf("Expected %v to point outside, got %v", link, errs[link])
+		}
+	}
+	for _, link := range []string{"cycle1", "cycle2"} {
+		if !errors.Is(errs[link], errSymlinkCycle) {
+			t.Errorf("Expected %v to be a cycle, got %v", link, errs[link])
+		}
+	}
+}
+
 func TestWalkSymlinkWindows(t *testing.T) {
 	if runtime.GOOS != "windows" {
 		t.Skip("skipping unsupported symlink test")
@@ -858,6 +1011,327 @@ func TestIncludedSubdir(t *testing.T) {
 	}
 }
 
+func TestDescendIgnoredDirs(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, "")
+
+	for _, dir := range []string{"foo/bar", "foo/baz", "qux"} {
+		if err := fss.MkdirAll(dir, 0777); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pats := ignore.New(fss, ignore.WithCache(true))
+
+	stignore := `
+	!/foo/bar
+	*
+	`
+	if err := pats.Parse(bytes.NewBufferString(stignore), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+
+	w := &walker{Config: Config{Matcher: pats}}
+	fn := w.walkAndHashFiles(context.Background(), nil, nil)
+
+	// Only directories that may contain the included one are descended
+	// into.
+	tcs := []struct {
+		name     string
+		expected error
+	}{
+		{"foo", nil},
+		{"foo/baz", fs.SkipDir},
+		{"qux", fs.SkipDir},
+	}
+	for _, tc := range tcs {
+		name := filepath.FromSlash(tc.name)
+		stat, err := fss.Lstat(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := fn(name, stat, nil); err != tc.expected {
+			t.Errorf("%v: expected %v, got %v", tc.name, tc.expected, err)
+		}
+	}
+}
+
+// openCountingFS keeps track of the maximum number of files open at the same
+// time.
+type openCountingFS struct {
+	fs.Filesystem
+	open, max int32
+}
+
+type openCountingFile struct {
+	fs.File
+	fs *openCountingFS
+}
+
+func (c *openCountingFS) Open(name string) (fs.File, error) {
+	fd, err := c.Filesystem.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	open := atomic.AddInt32(&c.open, 1)
+	for {
+		max := atomic.LoadInt32(&c.max)
+		if open <= max || atomic.CompareAndSwapInt32(&c.max, max, open) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	return openCountingFile{fd, c}, nil
+}
+
+func (f openCountingFile) Close() error {
+	atomic.AddInt32(&f.fs.open, -1)
+	return f.File.Close()
+}
+
+func TestWalkMaxOpenFiles(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	for i := 0; i < 32; i++ {
+		fd, err := fss.Create(fmt.Sprintf("file%d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte("some data"))
+		fd.Close()
+	}
+
+	cfs := &openCountingFS{Filesystem: fss}
+	fchan := Walk(context.TODO(), Config{
+		CurrentFiler: make(fakeCurrentFiler),
+		Filesystem:   cfs,
+		Hashers:      8,
+		MaxOpenFiles: 2,
+	})
+	n := 0
+	for f := range fchan {
+		if f.Err != nil {
+			t.Fatalf("Error while scanning %v: %v", f.Err, f.Path)
+		}
+		n++
+	}
+
+	if n != 32 {
+		t.Errorf("Expected 32 scanned files, got %v", n)
+	}
+	if max := atomic.LoadInt32(&cfs.max); max > 2 {
+		t.Errorf("Expected at most 2 files open at once, got %v", max)
+	}
+}
+
+func TestWalkSkipUnchangedDirs(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	for _, dir := range []string{"a", "b"} {
+		if err := fss.Mkdir(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		fd, err := fss.Create(filepath.Join(dir, "file"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte("some data"))
+		fd.Close()
+	}
+
+	hashes := make(fakeDirHashStore)
+	walk := func(subs ...string) []string {
+		t.Helper()
+		fchan := Walk(context.TODO(), Config{
+			Subs:       subs,
+			Filesystem: fss,
+			Hashers:    2,
+			DirHashes:  hashes,
+		})
+		var names []string
+		for f := range fchan {
+			if f.Err != nil {
+				t.Fatalf("Error while scanning %v: %v", f.Err, f.Path)
+			}
+			names = append(names, f.File.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+	expect := func(got []string, exp ...string) {
+		t.Helper()
+		for i := range exp {
+			exp[i] = filepath.FromSlash(exp[i])
+		}
+		if diff, equal := messagediff.PrettyDiff(exp, got); !equal {
+			t.Errorf("Unexpected scan results. Diff:\n%s", diff)
+		}
+	}
+
+	expect(walk(), "a", "a/file", "b", "b/file")
+	if len(hashes) != 2 {
+		t.Fatal("Expected two stored directory hashes, got", len(hashes))
+	}
+
+	// Nothing changed, the items in either directory are skipped.
+	expect(walk(), "a", "b")
+
+	fd, err := fss.Create(filepath.Join("b", "file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Write([]byte("some other data"))
+	fd.Close()
+
+	expect(walk(), "a", "b", "b/file")
+
+	// Explicitly requested directories are always walked.
+	expect(walk("a"), "a", "a/file")
+}
+
+func TestWalkSkipUnchangedDirsNested(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	name := filepath.Join("a", "b", "file")
+	if err := fss.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFakeFile := func(data string) {
+		t.Helper()
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte(data))
+		fd.Close()
+	}
+	writeFakeFile("some data")
+
+	hashes := make(fakeDirHashStore)
+	walk := func() []string {
+		t.Helper()
+		fchan := Walk(context.TODO(), Config{
+			Filesystem: fss,
+			Hashers:    2,
+			DirHashes:  hashes,
+		})
+		var names []string
+		for f := range fchan {
+			if f.Err != nil {
+				t.Fatalf("Error while scanning %v: %v", f.Err, f.Path)
+			}
+			names = append(names, filepath.ToSlash(f.File.Name))
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	if names := walk(); len(names) != 3 {
+		t.Fatal("Expected three scanned items, got", names)
+	}
+
+	// The listing of a doesn't change when a file two levels down does.
+	writeFakeFile("some other data")
+	exp := []string{"a", "a/b/file"}
+	if diff, equal := messagediff.PrettyDiff(exp, walk()); !equal {
+		t.Errorf("Unexpected scan results. Diff:\n%s", diff)
+	}
+}
+
+func TestWalkResume(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	if err := fss.Mkdir("b", 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"-a", "a", "b/c", "b/d", "b.e", "f"} {
+		name = filepath.FromSlash(name)
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte("data"))
+		fd.Close()
+	}
+	all := []string{"-a", "a", "b", "b/c", "b/d", "b.e", "f"}
+
+	walk := func(ctx context.Context, cfg Config) []string {
+		t.Helper()
+		cfg.Filesystem = fss
+		cfg.Hashers = 2
+		var names []string
+		for f := range Walk(ctx, cfg) {
+			if f.Err != nil {
+				if errors.Is(f.Err, context.Canceled) {
+					continue
+				}
+				t.Fatalf("Error while scanning %v: %v", f.Err, f.Path)
+			}
+			names = append(names, filepath.ToSlash(f.File.Name))
+		}
+		sort.Slice(names, func(i, j int) bool {
+			return walkOrderLess(names[i], names[j])
+		})
+		return names
+	}
+
+	// The parent directory of the item to resume at is walked again.
+	exp := []string{"b", "b/d", "b.e", "f"}
+	if got := walk(context.Background(), Config{ResumeAt: filepath.FromSlash("b/d")}); !reflect.DeepEqual(got, exp) {
+		t.Errorf("Expected %v when resuming, got %v", exp, got)
+	}
+
+	// Stopping before anything was walked resumes at the root.
+	if got := walk(context.Background(), Config{ResumeAt: "."}); !reflect.DeepEqual(got, all) {
+		t.Errorf("Expected %v when resuming at the root, got %v", all, got)
+	}
+
+	// Whenever the walk is cancelled, everything before the item to resume
+	// at was passed on.
+	for _, cancelAt := range all {
+		ctx, cancel := context.WithCancel(context.Background())
+		resumeAt := ""
+		got := walk(ctx, Config{
+			CurrentFiler: cancellingFiler{filepath.FromSlash(cancelAt), cancel},
+			Stopped:      func(path string) { resumeAt = filepath.ToSlash(path) },
+		})
+		cancel()
+		if cancelAt == "-a" && resumeAt == "" {
+			t.Error("Expected the walk to stop")
+			continue
+		}
+		for _, name := range all {
+			if resumeAt != "" && !walkOrderLess(name, resumeAt) {
+				break
+			}
+			if len(got) == 0 || got[0] != name {
+				t.Errorf("Cancelled at %v, resuming at %q: expected %v to be passed on, got %v", cancelAt, resumeAt, name, got)
+				break
+			}
+			got = got[1:]
+		}
+	}
+}
+
+// cancellingFiler cancels the walk when asked for the given item.
+type cancellingFiler struct {
+	name   string
+	cancel context.CancelFunc
+}
+
+func (f cancellingFiler) CurrentFile(name string) (protocol.FileInfo, bool) {
+	if name == f.name {
+		f.cancel()
+	}
+	return protocol.FileInfo{}, false
+}
+
+type fakeDirHashStore map[string][]byte
+
+func (s fakeDirHashStore) DirHash(name string) ([]byte, bool) {
+	hash, ok := s[name]
+	return hash, ok
+}
+
+func (s fakeDirHashStore) SetDirHash(name string, hash []byte) {
+	s[name] = hash
+}
+
 // Verify returns nil or an error describing the mismatch between the block
 // list and actual reader contents
 func verify(r io.Reader, blocksize int, blocks []protocol.BlockInfo) error {
@@ -907,3 +1381,231 @@ func testConfig() (Config, context.CancelFunc) {
 		EventLogger: evLogger,
 	}, cancel
 }
+
+func TestWalkHashNewestFirst(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	if err := fss.Mkdir("dir", 0755); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().Truncate(time.Second)
+	mtimes := map[string]time.Duration{
+		"a":       -3 * time.Hour,
+		"dir/b":   -time.Minute,
+		"dir/c":   -2 * time.Hour,
+		"d":       -time.Hour,
+		"dir/e/f": -time.Second,
+	}
+	if err := fss.Mkdir(filepath.FromSlash("dir/e"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, age := range mtimes {
+		name = filepath.FromSlash(name)
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte("some data"))
+		fd.Close()
+		if err := fss.Chtimes(name, now.Add(age), now.Add(age)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tick := range []int{-1, 0} {
+		fchan := Walk(context.TODO(), Config{
+			Filesystem:            fss,
+			Hashers:               1,
+			HashNewestFirst:       true,
+			ProgressTickIntervalS: tick,
+			EventLogger:           events.NoopLogger,
+		})
+		var names []string
+		for f := range fchan {
+			if f.Err != nil {
+				t.Fatalf("Error while scanning %v: %v", f.Err, f.Path)
+			}
+			if f.File.Type == protocol.FileInfoTypeFile {
+				names = append(names, filepath.ToSlash(f.File.Name))
+			}
+		}
+		expected := []string{"dir/e/f", "dir/b", "d", "dir/c", "a"}
+		if diff, equal := messagediff.PrettyDiff(expected, names); !equal {
+			t.Errorf("Unexpected hashing order with progress interval %v. Diff:\n%s", tick, diff)
+		}
+	}
+}
+
+func TestWalkRemovesEmptyTemporaries(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	old := time.Now().Add(-2 * emptyTempLifetime)
+	files := []struct {
+		name string
+		data string
+		old  bool
+		kept bool
+	}{
+		{fs.TempName("empty-old"), "", true, false},
+		{fs.TempName("empty-new"), "", false, true},
+		{fs.TempName("data-old"), "some data", true, true},
+	}
+	for _, f := range files {
+		fd, err := fss.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte(f.data))
+		fd.Close()
+		if f.old {
+			if err := fss.Chtimes(f.name, old, old); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for range Walk(context.TODO(), Config{
+		Filesystem:   fss,
+		Hashers:      2,
+		TempLifetime: 24 * time.Hour,
+	}) {
+	}
+
+	for _, f := range files {
+		if _, err := fss.Lstat(f.name); f.kept && err != nil {
+			t.Errorf("Expected %v to be kept, got %v", f.name, err)
+		} else if !f.kept && !fs.IsNotExist(err) {
+			t.Errorf("Expected %v to be removed, got %v", f.name, err)
+		}
+	}
+}
+
+type lstatRecordingFS struct {
+	fs.Filesystem
+	mut   sync.Mutex
+	names []string
+}
+
+func (r *lstatRecordingFS) Lstat(name string) (fs.FileInfo, error) {
+	r.mut.Lock()
+	r.names = append(r.names, name)
+	r.mut.Unlock()
+	return r.Filesystem.Lstat(name)
+}
+
+func TestWalkSkip(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	old := time.Now().Add(-48 * time.Hour)
+	stale := fs.TempName("stale")
+	for _, name := range []string{"file", filepath.Join(tempDir, "file"), stale} {
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte("some data"))
+		fd.Close()
+	}
+	if err := fss.Chtimes(stale, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	rfs := &lstatRecordingFS{Filesystem: fss}
+	var files []string
+	for res := range Walk(context.TODO(), Config{
+		Filesystem:   fs.NewWalkFilesystem(rfs),
+		Hashers:      2,
+		TempLifetime: 24 * time.Hour,
+		Skip:         []string{tempDir},
+	}) {
+		files = append(files, res.File.Name)
+	}
+
+	if len(files) != 1 || files[0] != "file" {
+		t.Errorf("Expected only file to be scanned, got %v", files)
+	}
+	for _, name := range rfs.names {
+		if (name == tempDir || fs.IsParent(name, tempDir)) && !fs.IsTemporary(name) {
+			t.Errorf("Expected %v not to be stat'd", name)
+		}
+	}
+	if _, err := fss.Lstat(stale); !fs.IsNotExist(err) {
+		t.Errorf("Expected the stale temporary file to be removed, got %v", err)
+	}
+}
+
+func TestWalkHashTotal(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	if err := fss.Mkdir("dir", 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a", filepath.Join("dir", "b")} {
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte("some data"))
+		fd.Close()
+	}
+
+	var files int
+	var bytes int64
+	fchan := Walk(context.TODO(), Config{
+		Filesystem:  fss,
+		Hashers:     1,
+		EventLogger: events.NoopLogger,
+		HashTotal: func(f int, b int64) {
+			files, bytes = f, b
+		},
+	})
+	for f := range fchan {
+		if f.Err != nil {
+			t.Fatalf("Error while scanning %v: %v", f.Err, f.Path)
+		}
+	}
+	if files != 2 || bytes != 18 {
+		t.Errorf("Expected 2 files and 18 bytes to hash, got %v and %v", files, bytes)
+	}
+}
+
+func TestWalkMinFileAge(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, t.Name())
+	if err := fss.Mkdir("dir", 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"old", "new"} {
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Write([]byte("some data"))
+		fd.Close()
+	}
+	hourAgo := time.Now().Add(-time.Hour)
+	if err := fss.Chtimes("old", hourAgo, hourAgo); err != nil {
+		t.Fatal(err)
+	}
+
+	var skipped []string
+	fchan := Walk(context.TODO(), Config{
+		Filesystem:  fss,
+		Hashers:     1,
+		EventLogger: events.NoopLogger,
+		MinFileAge:  time.Minute,
+		SkippedRecentFile: func(path string, _ time.Time) {
+			skipped = append(skipped, path)
+		},
+	})
+	var files []protocol.FileInfo
+	for f := range fchan {
+		if f.Err != nil {
+			t.Fatalf("Error while scanning %v: %v", f.Err, f.Path)
+		}
+		files = append(files, f.File)
+	}
+	sort.Sort(fileList(files))
+	// Directories are never skipped, only regular files.
+	if len(files) != 2 || files[0].Name != "dir" || files[1].Name != "old" {
+		t.Errorf("Expected dir and old to be scanned, got %v", files)
+	}
+	if len(skipped) != 1 || skipped[0] != "new" {
+		t.Errorf("Expected new to be skipped, got %v", skipped)
+	}
+}
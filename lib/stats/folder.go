@@ -10,16 +10,44 @@ import (
 	"time"
 
 	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// Successful pulls are persisted at most this often, as they may happen in
+// quick succession. The latest one is kept in memory regardless.
+const lastPullPersistInterval = time.Minute
+
+const (
+	transferBytesPulledKey  = "transferBytesPulled"
+	transferFilesPulledKey  = "transferFilesPulled"
+	transferFilesDeletedKey = "transferFilesDeleted"
 )
 
 type FolderStatistics struct {
-	LastFile LastFile  `json:"lastFile"`
-	LastScan time.Time `json:"lastScan"`
+	LastFile  LastFile       `json:"lastFile"`
+	LastScan  time.Time      `json:"lastScan"`
+	LastPull  time.Time      `json:"lastPull"`
+	Transfers TransferTotals `json:"transfers"`
+}
+
+// TransferTotals are the lifetime totals of the changes pulled into a
+// folder, as opposed to those found by scanning. Bytes are the sizes of the
+// files pulled, regardless of how much of them had to be transferred.
+type TransferTotals struct {
+	BytesPulled  int64 `json:"bytesPulled"`
+	FilesPulled  int64 `json:"filesPulled"`  // regular files only
+	FilesDeleted int64 `json:"filesDeleted"` // items of any type
 }
 
 type FolderStatisticsReference struct {
 	ns     *db.NamespacedKV
 	folder string
+
+	lastPullMut       sync.Mutex
+	lastPull          time.Time // zero until a pull completed since start
+	lastPullPersisted time.Time
+
+	transfersMut sync.Mutex
 }
 
 type LastFile struct {
@@ -30,8 +58,10 @@ type LastFile struct {
 
 func NewFolderStatisticsReference(ldb *db.Lowlevel, folder string) *FolderStatisticsReference {
 	return &FolderStatisticsReference{
-		ns:     db.NewFolderStatisticsNamespace(ldb, folder),
-		folder: folder,
+		ns:           db.NewFolderStatisticsNamespace(ldb, folder),
+		folder:       folder,
+		lastPullMut:  sync.NewMutex(),
+		transfersMut: sync.NewMutex(),
 	}
 }
 
@@ -77,7 +107,9 @@ func (s *FolderStatisticsReference) ScanCompleted() error {
 	return s.ns.PutTime("lastScan", time.Now().Truncate(time.Second))
 }
 
-func (s *FolderStatisticsReference) GetLastScanTime() (time.Time, error) {
+// LastScanCompleted returns when the last scan completed, or the zero time
+// if none did yet.
+func (s *FolderStatisticsReference) LastScanCompleted() (time.Time, error) {
 	lastScan, ok, err := s.ns.Time("lastScan")
 	if err != nil {
 		return time.Time{}, err
@@ -87,17 +119,112 @@ func (s *FolderStatisticsReference) GetLastScanTime() (time.Time, error) {
 	return lastScan, nil
 }
 
+// PullCompleted records a successful pull. It's persisted unless the
+// previous one was persisted less than lastPullPersistInterval ago, thus the
+// persisted time may lag behind by that much after a restart.
+func (s *FolderStatisticsReference) PullCompleted() error {
+	now := time.Now().Truncate(time.Second)
+	s.lastPullMut.Lock()
+	defer s.lastPullMut.Unlock()
+	s.lastPull = now
+	if now.Sub(s.lastPullPersisted) < lastPullPersistInterval {
+		return nil
+	}
+	if err := s.ns.PutTime("lastPull", now); err != nil {
+		return err
+	}
+	s.lastPullPersisted = now
+	return nil
+}
+
+// LastPullCompleted returns when the last successful pull completed, or the
+// zero time if none did yet.
+func (s *FolderStatisticsReference) LastPullCompleted() (time.Time, error) {
+	s.lastPullMut.Lock()
+	lastPull := s.lastPull
+	s.lastPullMut.Unlock()
+	if !lastPull.IsZero() {
+		return lastPull, nil
+	}
+	lastPull, ok, err := s.ns.Time("lastPull")
+	if err != nil {
+		return time.Time{}, err
+	} else if !ok {
+		return time.Time{}, nil
+	}
+	return lastPull, nil
+}
+
+// Pulled adds to the transfer totals, which are persisted right away. Negative
+// amounts are ignored, such that the totals only ever grow until reset.
+func (s *FolderStatisticsReference) Pulled(bytes int64, files, deleted int) error {
+	s.transfersMut.Lock()
+	defer s.transfersMut.Unlock()
+	for key, n := range map[string]int64{
+		transferBytesPulledKey:  bytes,
+		transferFilesPulledKey:  int64(files),
+		transferFilesDeletedKey: int64(deleted),
+	} {
+		if n <= 0 {
+			continue
+		}
+		total, _, err := s.ns.Int64(key)
+		if err != nil {
+			return err
+		}
+		if err := s.ns.PutInt64(key, total+n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *FolderStatisticsReference) GetTransferTotals() (TransferTotals, error) {
+	s.transfersMut.Lock()
+	defer s.transfersMut.Unlock()
+	var totals TransferTotals
+	for key, total := range map[string]*int64{
+		transferBytesPulledKey:  &totals.BytesPulled,
+		transferFilesPulledKey:  &totals.FilesPulled,
+		transferFilesDeletedKey: &totals.FilesDeleted,
+	} {
+		n, _, err := s.ns.Int64(key)
+		if err != nil {
+			return TransferTotals{}, err
+		}
+		*total = n
+	}
+	return totals, nil
+}
+
+// ResetTransferTotals sets the transfer totals back to zero.
+func (s *FolderStatisticsReference) ResetTransferTotals() error {
+	s.transfersMut.Lock()
+	defer s.transfersMut.Unlock()
+	return s.ns.DeleteMany([]string{transferBytesPulledKey, transferFilesPulledKey, transferFilesDeletedKey})
+}
+
 func (s *FolderStatisticsReference) GetStatistics() (FolderStatistics, error) {
 	lastFile, err := s.GetLastFile()
 	if err != nil {
 		return FolderStatistics{}, err
 	}
-	lastScanTime, err := s.GetLastScanTime()
+	lastScanTime, err := s.LastScanCompleted()
+	if err != nil {
+		return FolderStatistics{}, err
+	}
+	lastPullTime, err := s.LastPullCompleted()
+	if err != nil {
+		return FolderStatistics{}, err
+	}
+	transfers, err := s.GetTransferTotals()
 	if err != nil {
 		return FolderStatistics{}, err
 	}
 	return FolderStatistics{
-		LastFile: lastFile,
-		LastScan: lastScanTime,
+		LastFile:  lastFile,
+		LastScan:  lastScanTime,
+		LastPull:  lastPullTime,
+		Transfers: transfers,
 	}, nil
 }
@@ -13,7 +13,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/protocol"
 )
 
@@ -41,3 +43,72 @@ func TestDeviceStat(t *testing.T) {
 		t.Error("Bad last duration:", d)
 	}
 }
+
+func TestFolderStatLastPull(t *testing.T) {
+	ldb, err := db.NewLowlevel(backend.OpenLevelDBMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+
+	sr := NewFolderStatisticsReference(ldb, "default")
+	if err := sr.PullCompleted(); err != nil {
+		t.Fatal(err)
+	}
+	stat, err := sr.GetStatistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(stat.LastPull); d > 5*time.Second {
+		t.Error("Last pull far in the past:", d)
+	}
+	if persisted, _ := NewFolderStatisticsReference(ldb, "default").LastPullCompleted(); !persisted.Equal(stat.LastPull) {
+		t.Errorf("Expected the last pull %v to be persisted, got %v", stat.LastPull, persisted)
+	}
+
+	// Pulls in quick succession aren't persisted each time.
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := sr.ns.PutTime("lastPull", past); err != nil {
+		t.Fatal(err)
+	}
+	if err := sr.PullCompleted(); err != nil {
+		t.Fatal(err)
+	}
+	if persisted, _ := NewFolderStatisticsReference(ldb, "default").LastPullCompleted(); !persisted.Equal(past) {
+		t.Errorf("Expected the last pull not to be persisted again, got %v", persisted)
+	}
+	if last, _ := sr.LastPullCompleted(); time.Since(last) > 5*time.Second {
+		t.Error("Expected the latest pull in memory, got", last)
+	}
+}
+
+func TestFolderStatTransfers(t *testing.T) {
+	ldb, err := db.NewLowlevel(backend.OpenLevelDBMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+
+	sr := NewFolderStatisticsReference(ldb, "default")
+	if err := sr.Pulled(100, 2, 1); err != nil {
+		t.Fatal(err)
+	}
+	// Negative amounts don't decrease the totals.
+	if err := sr.Pulled(-50, 1, -1); err != nil {
+		t.Fatal(err)
+	}
+	expected := TransferTotals{BytesPulled: 100, FilesPulled: 3, FilesDeleted: 1}
+	if totals, err := sr.GetTransferTotals(); err != nil || totals != expected {
+		t.Errorf("Expected totals %+v, got %+v (%v)", expected, totals, err)
+	}
+	if stat, err := NewFolderStatisticsReference(ldb, "default").GetStatistics(); err != nil || stat.Transfers != expected {
+		t.Errorf("Expected persisted totals %+v, got %+v (%v)", expected, stat.Transfers, err)
+	}
+
+	if err := sr.ResetTransferTotals(); err != nil {
+		t.Fatal(err)
+	}
+	if totals, err := sr.GetTransferTotals(); err != nil || totals != (TransferTotals{}) {
+		t.Errorf("Expected zero totals after reset, got %+v (%v)", totals, err)
+	}
+}
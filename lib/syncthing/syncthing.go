@@ -78,6 +78,7 @@ type App struct {
 	stopOnce          sync.Once
 	mainServiceCancel context.CancelFunc
 	stopped           chan struct{}
+	model             model.Model
 }
 
 func New(cfg config.Wrapper, dbBackend backend.Backend, evLogger events.Logger, cert tls.Certificate, opts Options) (*App, error) {
@@ -251,6 +252,7 @@ func (a *App) startup() error {
 	}
 
 	a.mainService.Add(m)
+	a.model = m
 
 	// The TLS configuration is used for both the listening socket and outgoing
 	// connections.
@@ -394,6 +396,13 @@ func (a *App) stopWithErr(stopReason svcutil.ExitStatus, err error) svcutil.Exit
 			l.Debugln("Services before stop:")
 			printServiceTree(os.Stdout, a.mainService, 0)
 		}
+		if a.model != nil {
+			// Let folders finish what they are writing to the database
+			// before cancelling them.
+			if timeout := a.cfg.Options().ShutdownTimeoutS; timeout > 0 {
+				a.model.Drain(time.Duration(timeout) * time.Second)
+			}
+		}
 		a.mainServiceCancel()
 	})
 	<-a.stopped
@@ -78,6 +78,29 @@ func (s *verboseService) formatEvent(ev events.Event) string {
 		data := ev.Data.(map[string]string)
 		return fmt.Sprintf("Remote change detected in folder %q: %s %s %s", data["folder"], data["action"], data["type"], data["path"])
 
+	case events.LocalRenameDetected:
+		data := ev.Data.(map[string]string)
+		return fmt.Sprintf("Local rename detected in folder %q: %s to %s", data["folder"], data["oldPath"], data["path"])
+
+	case events.LocalChangesReverted:
+		data := ev.Data.(map[string]interface{})
+		return fmt.Sprintf("Reverted %d locally changed items in folder %q", data["items"], data["folder"])
+
+	case events.FolderCommandOutput:
+		data := ev.Data.(map[string]interface{})
+		if err, ok := data["error"]; ok {
+			return fmt.Sprintf("Command %q for folder %q failed: %v", data["command"], data["folder"], err)
+		}
+		return fmt.Sprintf("Command %q for folder %q succeeded", data["command"], data["folder"])
+
+	case events.FolderScanCompleted:
+		data := ev.Data.(map[string]interface{})
+		return fmt.Sprintf("Scanned folder %q in %.1fs: %d changed, %d deleted, %d renamed", data["folder"], data["duration"], data["files"], data["deleted"], data["renamed"])
+
+	case events.RemoteChangeSummary:
+		data := ev.Data.(map[string]interface{})
+		return fmt.Sprintf("Remote changes in folder %q without individual events: %d modified, %d deleted", data["folder"], data["modified"], data["deleted"])
+
 	case events.RemoteIndexUpdated:
 		data := ev.Data.(map[string]interface{})
 		return fmt.Sprintf("Device %v sent an index update for %q with %d items", data["device"], data["folder"], data["items"])
@@ -104,6 +127,14 @@ func (s *verboseService) formatEvent(ev events.Event) string {
 		}
 		return fmt.Sprintf("Finished syncing %q / %q (%v %v): Success", data["folder"], data["item"], data["action"], data["type"])
 
+	case events.ItemRejected:
+		data := ev.Data.(map[string]string)
+		return fmt.Sprintf("Rejected %q / %q: %v", data["folder"], data["item"], data["reason"])
+
+	case events.ChronicConflictDetected:
+		data := ev.Data.(map[string]interface{})
+		return fmt.Sprintf("Chronic conflict on %q / %q: %v conflicts (%v)", data["folder"], data["item"], data["conflicts"], data["action"])
+
 	case events.ConfigSaved:
 		return "Configuration was saved"
 
@@ -156,6 +187,25 @@ func (s *verboseService) formatEvent(ev events.Event) string {
 		label := data["label"]
 		return fmt.Sprintf("Folder %v (%v) was resumed", id, label)
 
+	case events.FolderErrorChanged:
+		data := ev.Data.(map[string]string)
+		if data["error"] == "" {
+			return fmt.Sprintf("Error on folder %v (%v) cleared: %q", data["folder"], data["label"], data["previous"])
+		}
+		return fmt.Sprintf("Error on folder %v (%v) changed: %q -> %q", data["folder"], data["label"], data["previous"], data["error"])
+
+	case events.FolderScanPhase:
+		data := ev.Data.(map[string]string)
+		return fmt.Sprintf("Scanning folder %v: %v", data["folder"], data["phase"])
+
+	case events.ScanDelayed:
+		data := ev.Data.(map[string]interface{})
+		return fmt.Sprintf("Next scan of folder %v delayed until %v: %v", data["folder"], data["until"], data["reason"])
+
+	case events.FolderConfigApplied:
+		data := ev.Data.(map[string]interface{})
+		return fmt.Sprintf("Configuration of folder %v (%v) was applied (live: %v, by restart: %v)", data["id"], data["label"], data["live"], data["restart"])
+
 	case events.ListenAddressesChanged:
 		data := ev.Data.(map[string]interface{})
 		address := data["address"]
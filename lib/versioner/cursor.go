@@ -0,0 +1,142 @@
+// Copyright (C) 2021 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// CleanupCursor persists how far Clean got, such that a cleanup interrupted
+// e.g. by shutdown resumes there instead of starting over. The empty
+// string means there is nothing to resume.
+type CleanupCursor interface {
+	Get() string
+	Set(dir string)
+}
+
+type noCleanupCursor struct{}
+
+func (noCleanupCursor) Get() string { return "" }
+func (noCleanupCursor) Set(string)  {}
+
+// cleanDirs calls fn with the files of every directory in versionsFs, one
+// directory after the other in walk order. The cursor is set to each
+// directory once fn returned for it, and directories up to the one the
+// cursor points at are skipped. Once all directories are done, the cursor
+// is cleared. fn marks the files it keeps in the returned tracker, such
+// that afterwards the remaining empty directories can be deleted.
+func cleanDirs(ctx context.Context, versionsFs fs.Filesystem, cursor CleanupCursor, fn func(dir string, files []fs.FileInfo, dirTracker emptyDirTracker) error) (emptyDirTracker, error) {
+	if cursor == nil {
+		cursor = noCleanupCursor{}
+	}
+	c := dirCleaner{
+		ctx:        ctx,
+		versionsFs: versionsFs,
+		cursor:     cursor,
+		resumeAt:   cursor.Get(),
+		fn:         fn,
+		dirTracker: make(emptyDirTracker),
+	}
+	if c.resumeAt != "" {
+		l.Debugf("Versioner: Resuming cleanup of %v after %v", versionsFs, c.resumeAt)
+	}
+	if err := c.clean("."); err != nil {
+		return nil, err
+	}
+	cursor.Set("")
+	return c.dirTracker, nil
+}
+
+type dirCleaner struct {
+	ctx        context.Context
+	versionsFs fs.Filesystem
+	cursor     CleanupCursor
+	resumeAt   string
+	fn         func(dir string, files []fs.FileInfo, dirTracker emptyDirTracker) error
+	dirTracker emptyDirTracker
+}
+
+func (c *dirCleaner) clean(dir string) error {
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	default:
+	}
+
+	names, err := c.versionsFs.DirNames(dir)
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	var files []fs.FileInfo
+	var subdirs []string
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		info, err := c.versionsFs.Lstat(path)
+		if fs.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if info.IsDir() && !info.IsSymlink() {
+			c.dirTracker.addDir(path)
+			subdirs = append(subdirs, path)
+		} else {
+			files = append(files, info)
+		}
+	}
+
+	// The files of the directory the cursor points at and those of its
+	// parents were done before the interruption, its subdirectories not.
+	if c.resumeAt == "" || (dir != c.resumeAt && !fs.IsParent(c.resumeAt, dir)) {
+		if err := c.fn(dir, files, c.dirTracker); err != nil {
+			return err
+		}
+		c.cursor.Set(dir)
+	} else {
+		for _, info := range files {
+			c.dirTracker.addFile(filepath.Join(dir, info.Name()))
+		}
+	}
+
+	for _, sub := range subdirs {
+		if c.resumeAt != "" && walkOrderBefore(sub, c.resumeAt) && !fs.IsParent(c.resumeAt, sub) {
+			// Done entirely before the interruption.
+			c.dirTracker.keepDir(sub)
+			continue
+		}
+		if err := c.clean(sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkOrderBefore returns true if a is walked before b, i.e. a sorts before
+// b component by component, or is a parent of b.
+func walkOrderBefore(a, b string) bool {
+	if b == "." {
+		return false
+	}
+	if a == "." {
+		return true
+	}
+	as := strings.Split(a, string(filepath.Separator))
+	bs := strings.Split(b, string(filepath.Separator))
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] != bs[i] {
+			return as[i] < bs[i]
+		}
+	}
+	return len(as) < len(bs)
+}
@@ -31,6 +31,14 @@ func (t emptyDirTracker) addFile(path string) {
 	}
 }
 
+// Remove the dir and all dirs on the path to it
+func (t emptyDirTracker) keepDir(path string) {
+	for path != "." {
+		delete(t, path)
+		path = filepath.Dir(path)
+	}
+}
+
 func (t emptyDirTracker) emptyDirs() []string {
 	empty := []string{}
 	for dir := range t {
@@ -121,6 +121,6 @@ func (v external) Restore(filePath string, versionTime time.Time) error {
 	return ErrRestorationNotSupported
 }
 
-func (v external) Clean(_ context.Context) error {
+func (v external) Clean(_ context.Context, _ SpacePressure, _ CleanupCursor) error {
 	return nil
 }
@@ -80,6 +80,16 @@ func (v simple) Restore(filepath string, versionTime time.Time) error {
 	return restoreFile(v.copyRangeMethod, v.versionsFs, v.folderFs, filepath, versionTime, TagFilename)
 }
 
-func (v simple) Clean(ctx context.Context) error {
-	return cleanByDay(ctx, v.versionsFs, v.cleanoutDays)
+// Clean removes versions older than the configured number of days. Under
+// high space pressure, only the newest version of each file is kept.
+func (v simple) Clean(ctx context.Context, pressure SpacePressure, cursor CleanupCursor) error {
+	switch pressure {
+	case SpacePressureNone:
+		return nil
+	case SpacePressureHigh:
+		if err := keepNewestVersions(ctx, v.versionsFs); err != nil {
+			return err
+		}
+	}
+	return cleanByDay(ctx, v.versionsFs, v.cleanoutDays, cursor)
 }
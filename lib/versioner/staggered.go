@@ -9,6 +9,7 @@ package versioner
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"time"
@@ -59,7 +60,13 @@ func newStaggered(cfg config.FolderConfiguration) Versioner {
 	return s
 }
 
-func (v *staggered) Clean(ctx context.Context) error {
+// Clean thins out versions according to the staggered intervals. Under high
+// space pressure, only the newest version of each file is kept.
+func (v *staggered) Clean(ctx context.Context, pressure SpacePressure, cursor CleanupCursor) error {
+	if pressure == SpacePressureNone {
+		return nil
+	}
+
 	l.Debugln("Versioner clean: Cleaning", v.versionsFs)
 
 	if _, err := v.versionsFs.Stat("."); fs.IsNotExist(err) {
@@ -67,51 +74,44 @@ func (v *staggered) Clean(ctx context.Context) error {
 		return nil
 	}
 
-	versionsPerFile := make(map[string][]string)
-	dirTracker := make(emptyDirTracker)
-
-	walkFn := func(path string, f fs.FileInfo, err error) error {
-		if err != nil {
+	if pressure == SpacePressureHigh {
+		if err := keepNewestVersions(ctx, v.versionsFs); err != nil {
 			return err
 		}
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
-
-		if f.IsDir() && !f.IsSymlink() {
-			dirTracker.addDir(path)
-			return nil
-		}
+	}
 
-		// Regular file, or possibly a symlink.
-		dirTracker.addFile(path)
+	// All versions of a file are in the same directory, thus directories
+	// can be expired one by one.
+	dirTracker, err := cleanDirs(ctx, v.versionsFs, cursor, func(dir string, files []fs.FileInfo, dirTracker emptyDirTracker) error {
+		versionsPerFile := make(map[string][]string)
+		for _, f := range files {
+			// Regular file, or possibly a symlink.
+			path := filepath.Join(dir, f.Name())
+			dirTracker.addFile(path)
+
+			name, _ := UntagFilename(path)
+			if name == "" {
+				continue
+			}
 
-		name, _ := UntagFilename(path)
-		if name == "" {
-			return nil
+			versionsPerFile[name] = append(versionsPerFile[name], path)
 		}
 
-		versionsPerFile[name] = append(versionsPerFile[name], path)
-
+		for _, versionList := range versionsPerFile {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			v.expire(versionList)
+		}
 		return nil
-	}
-
-	if err := v.versionsFs.Walk(".", walkFn); err != nil {
-		l.Warnln("Versioner: error scanning versions dir", err)
+	})
+	if err != nil {
+		l.Warnln("Versioner: error cleaning versions dir", err)
 		return err
 	}
 
-	for _, versionList := range versionsPerFile {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
-		v.expire(versionList)
-	}
-
 	dirTracker.deleteEmptyDirs(v.versionsFs)
 
 	l.Debugln("Cleaner: Finished cleaning", v.versionsFs)
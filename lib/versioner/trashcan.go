@@ -55,8 +55,16 @@ func (t *trashcan) String() string {
 	return fmt.Sprintf("trashcan@%p", t)
 }
 
-func (t *trashcan) Clean(ctx context.Context) error {
-	return cleanByDay(ctx, t.versionsFs, t.cleanoutDays)
+// Clean removes versions older than the configured number of days, or half
+// of that under high space pressure. There is only one version per file.
+func (t *trashcan) Clean(ctx context.Context, pressure SpacePressure, cursor CleanupCursor) error {
+	switch pressure {
+	case SpacePressureNone:
+		return nil
+	case SpacePressureHigh:
+		return cleanByDay(ctx, t.versionsFs, (t.cleanoutDays+1)/2, cursor)
+	}
+	return cleanByDay(ctx, t.versionsFs, t.cleanoutDays, cursor)
 }
 
 func (t *trashcan) GetVersions() (map[string][]FileVersion, error) {
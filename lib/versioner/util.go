@@ -287,46 +287,83 @@ func findAllVersions(fs fs.Filesystem, filePath string) []string {
 	return versions
 }
 
-func cleanByDay(ctx context.Context, versionsFs fs.Filesystem, cleanoutDays int) error {
-	if cleanoutDays <= 0 {
-		return nil
-	}
-
+// keepNewestVersions removes all but the newest version of each file.
+func keepNewestVersions(ctx context.Context, versionsFs fs.Filesystem) error {
 	if _, err := versionsFs.Lstat("."); fs.IsNotExist(err) {
 		return nil
 	}
 
-	cutoff := time.Now().Add(time.Duration(-24*cleanoutDays) * time.Hour)
-	dirTracker := make(emptyDirTracker)
-
-	walkFn := func(path string, info fs.FileInfo, err error) error {
+	newest := make(map[string]string)
+	var remove []string
+	err := versionsFs.Walk(".", func(path string, info fs.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
 		}
-
-		if info.IsDir() && !info.IsSymlink() {
-			dirTracker.addDir(path)
+		if info.IsDir() {
 			return nil
 		}
-
-		if info.ModTime().Before(cutoff) {
-			// The file is too old; remove it.
-			err = versionsFs.Remove(path)
-		} else {
-			// Keep this file, and remember it so we don't unnecessarily try
-			// to remove this directory.
-			dirTracker.addFile(path)
+		name, tag := UntagFilename(path)
+		if name == "" {
+			return nil
+		}
+		prev, ok := newest[name]
+		if !ok {
+			newest[name] = path
+			return nil
+		}
+		// Tags sort chronologically.
+		if _, prevTag := UntagFilename(prev); prevTag < tag {
+			newest[name] = path
+			path = prev
 		}
+		remove = append(remove, path)
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
-	if err := versionsFs.Walk(".", walkFn); err != nil {
+	for _, path := range remove {
+		if err := versionsFs.Remove(path); err != nil {
+			l.Warnf("Versioner: can't remove %q: %v", path, err)
+		}
+	}
+	return nil
+}
+
+func cleanByDay(ctx context.Context, versionsFs fs.Filesystem, cleanoutDays int, cursor CleanupCursor) error {
+	if cleanoutDays <= 0 {
+		return nil
+	}
+
+	if _, err := versionsFs.Lstat("."); fs.IsNotExist(err) {
+		return nil
+	}
+
+	cutoff := time.Now().Add(time.Duration(-24*cleanoutDays) * time.Hour)
+
+	dirTracker, err := cleanDirs(ctx, versionsFs, cursor, func(dir string, files []fs.FileInfo, dirTracker emptyDirTracker) error {
+		for _, info := range files {
+			path := filepath.Join(dir, info.Name())
+			if info.ModTime().Before(cutoff) {
+				// The file is too old; remove it.
+				if err := versionsFs.Remove(path); err != nil {
+					return err
+				}
+			} else {
+				// Keep this file, and remember it so we don't unnecessarily
+				// try to remove this directory.
+				dirTracker.addFile(path)
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
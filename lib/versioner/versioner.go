@@ -21,7 +21,56 @@ type Versioner interface {
 	Archive(filePath string) error
 	GetVersions() (map[string][]FileVersion, error)
 	Restore(filePath string, versionTime time.Time) error
-	Clean(context.Context) error
+	Clean(ctx context.Context, pressure SpacePressure, cursor CleanupCursor) error
+}
+
+// SpacePressure tells Clean how urgently space on the versions filesystem
+// is needed.
+type SpacePressure int
+
+const (
+	// Versions are cleaned up as configured.
+	SpacePressureNormal SpacePressure = iota
+	// There is plenty of free space, versions are kept.
+	SpacePressureNone
+	// Free space is short, versions are pruned aggressively.
+	SpacePressureHigh
+)
+
+func (p SpacePressure) String() string {
+	switch p {
+	case SpacePressureNormal:
+		return "normal"
+	case SpacePressureNone:
+		return "none"
+	case SpacePressureHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// CleanupPressure returns the space pressure on the versions filesystem of
+// the given folder, according to the free space water marks of its
+// versioning configuration.
+func CleanupPressure(cfg config.FolderConfiguration) (SpacePressure, error) {
+	skipAbove := cfg.Versioning.CleanupSkipAboveFree
+	aggressiveBelow := cfg.Versioning.CleanupAggressiveBelowFree
+	if skipAbove.BaseValue() <= 0 && aggressiveBelow.BaseValue() <= 0 {
+		return SpacePressureNormal, nil
+	}
+
+	usage, err := versionerFsFromFolderCfg(cfg).Usage(".")
+	if err != nil {
+		return SpacePressureNormal, err
+	}
+	if config.CheckFreeSpace(aggressiveBelow, usage) != nil {
+		return SpacePressureHigh, nil
+	}
+	if skipAbove.BaseValue() > 0 && config.CheckFreeSpace(skipAbove, usage) == nil {
+		return SpacePressureNone, nil
+	}
+	return SpacePressureNormal, nil
 }
 
 type FileVersion struct {
@@ -78,6 +127,6 @@ func (v *versionerWithErrorContext) Restore(filePath string, versionTime time.Ti
 	return v.wrapError(v.Versioner.Restore(filePath, versionTime), "restore")
 }
 
-func (v *versionerWithErrorContext) Clean(ctx context.Context) error {
-	return v.wrapError(v.Versioner.Clean(ctx), "clean")
+func (v *versionerWithErrorContext) Clean(ctx context.Context, pressure SpacePressure, cursor CleanupCursor) error {
+	return v.wrapError(v.Versioner.Clean(ctx, pressure, cursor), "clean")
 }
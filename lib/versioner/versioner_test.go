@@ -66,7 +66,7 @@ func TestVersionerCleanOut(t *testing.T) {
 				}
 			}
 
-			if err := versioner.Clean(context.Background()); err != nil {
+			if err := versioner.Clean(context.Background(), SpacePressureNormal, nil); err != nil {
 				t.Fatal(err)
 			}
 
@@ -89,3 +89,219 @@ func TestVersionerCleanOut(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionerCleanSpacePressure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           dir,
+		Versioning: config.VersioningConfiguration{
+			Params: map[string]string{
+				"keep": "5",
+			},
+		},
+	}
+	versionsFs := versionerFsFromFolderCfg(cfg)
+	older := TagFilename("file", "20200101-000000")
+	newer := TagFilename("file", "20200102-000000")
+	other := TagFilename("other", "20200101-000000")
+	if err := versionsFs.MkdirAll(".", 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{older, newer, other} {
+		writeFile(t, versionsFs, name, "data")
+	}
+
+	v := newSimple(cfg)
+	exists := func(name string) bool {
+		_, err := versionsFs.Lstat(name)
+		return err == nil
+	}
+
+	for _, pressure := range []SpacePressure{SpacePressureNone, SpacePressureNormal} {
+		if err := v.Clean(context.Background(), pressure, nil); err != nil {
+			t.Fatal(err)
+		}
+		if !exists(older) || !exists(newer) || !exists(other) {
+			t.Fatalf("Expected all versions to be kept with %v pressure", pressure)
+		}
+	}
+
+	if err := v.Clean(context.Background(), SpacePressureHigh, nil); err != nil {
+		t.Fatal(err)
+	}
+	if exists(older) {
+		t.Error("Expected older version to be removed under high pressure")
+	}
+	if !exists(newer) || !exists(other) {
+		t.Error("Expected newest versions to be kept under high pressure")
+	}
+}
+
+func TestCleanupPressure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           dir,
+		Versioning: config.VersioningConfiguration{
+			FSPath: ".",
+			FSType: fs.FilesystemTypeBasic,
+		},
+	}
+	cases := []struct {
+		skipAbove, aggressiveBelow config.Size
+		expected                   SpacePressure
+	}{
+		{config.Size{}, config.Size{}, SpacePressureNormal},
+		{config.Size{Value: 1, Unit: "B"}, config.Size{}, SpacePressureNone},
+		{config.Size{Value: 1, Unit: "B"}, config.Size{Value: 100, Unit: "%"}, SpacePressureHigh},
+		{config.Size{Value: 100, Unit: "%"}, config.Size{Value: 1, Unit: "B"}, SpacePressureNormal},
+	}
+	for _, tc := range cases {
+		cfg.Versioning.CleanupSkipAboveFree = tc.skipAbove
+		cfg.Versioning.CleanupAggressiveBelowFree = tc.aggressiveBelow
+		pressure, err := CleanupPressure(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pressure != tc.expected {
+			t.Errorf("Expected %v pressure with %v/%v, got %v", tc.expected, tc.skipAbove, tc.aggressiveBelow, pressure)
+		}
+	}
+}
+
+type testCleanupCursor struct {
+	dir      string
+	onSet    func(dir string)
+	resumeAt string
+}
+
+func (c *testCleanupCursor) Get() string {
+	c.resumeAt = c.dir
+	return c.dir
+}
+
+func (c *testCleanupCursor) Set(dir string) {
+	c.dir = dir
+	if c.onSet != nil {
+		c.onSet(dir)
+	}
+}
+
+func TestVersionerCleanResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           dir,
+		Versioning: config.VersioningConfiguration{
+			Params: map[string]string{
+				"cleanoutDays": "7",
+			},
+		},
+	}
+	versionsFs := versionerFsFromFolderCfg(cfg)
+	old := []string{"file", "a/file", "a/b/file", "a-b/file", "c/file"}
+	oldTime := time.Now().Add(-8 * 24 * time.Hour)
+	for _, name := range old {
+		name = filepath.FromSlash(name)
+		if err := versionsFs.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		writeFile(t, versionsFs, name, "data")
+		if err := versionsFs.Chtimes(name, oldTime, oldTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exists := func(name string) bool {
+		_, err := versionsFs.Lstat(filepath.FromSlash(name))
+		return err == nil
+	}
+
+	// Interrupt the cleanup once it's done with a/b.
+	v := newTrashcan(cfg)
+	ctx, cancel := context.WithCancel(context.Background())
+	cursor := &testCleanupCursor{onSet: func(dir string) {
+		if dir == filepath.FromSlash("a/b") {
+			cancel()
+		}
+	}}
+	if err := v.Clean(ctx, SpacePressureNormal, cursor); err != context.Canceled {
+		t.Fatalf("Expected the cleanup to be cancelled, got %v", err)
+	}
+	if cursor.dir != filepath.FromSlash("a/b") {
+		t.Fatalf("Expected the cursor at a/b, got %q", cursor.dir)
+	}
+	for _, name := range []string{"file", "a/file", "a/b/file"} {
+		if exists(name) {
+			t.Errorf("Expected %v to be removed before the interruption", name)
+		}
+	}
+
+	// Versions in what was already done are kept when resuming, as they
+	// are the next time around.
+	writeFile(t, versionsFs, "file", "data")
+	if err := versionsFs.Chtimes("file", oldTime, oldTime); err != nil {
+		t.Fatal(err)
+	}
+	cursor.onSet = nil
+	if err := v.Clean(context.Background(), SpacePressureNormal, cursor); err != nil {
+		t.Fatal(err)
+	}
+	if cursor.resumeAt != filepath.FromSlash("a/b") || cursor.dir != "" {
+		t.Errorf("Expected to resume at a/b and clear the cursor, resumed at %q with cursor %q", cursor.resumeAt, cursor.dir)
+	}
+	for _, name := range []string{"a-b/file", "c/file"} {
+		if exists(name) {
+			t.Errorf("Expected %v to be removed after resuming", name)
+		}
+	}
+	if !exists("file") {
+		t.Error("Expected file to be kept when resuming")
+	}
+	if exists("c") {
+		t.Error("Expected empty directory to be removed")
+	}
+
+	if err := v.Clean(context.Background(), SpacePressureNormal, cursor); err != nil {
+		t.Fatal(err)
+	}
+	if cursor.resumeAt != "" || exists("file") {
+		t.Error("Expected a complete cleanup after finishing")
+	}
+}
+
+func TestWalkOrderBefore(t *testing.T) {
+	for _, tc := range []struct {
+		a, b   string
+		before bool
+	}{
+		{".", "a", true},
+		{"a", ".", false},
+		{"a", "a", false},
+		{"a", "a/b", true},
+		{"a/b", "a", false},
+		{"a/b", "a-b", true},
+		{"a-b", "a/b", false},
+		{"a/c", "b", true},
+		{"b", "a/c", false},
+	} {
+		if before := walkOrderBefore(filepath.FromSlash(tc.a), filepath.FromSlash(tc.b)); before != tc.before {
+			t.Errorf("walkOrderBefore(%q, %q) = %v, expected %v", tc.a, tc.b, before, tc.before)
+		}
+	}
+}